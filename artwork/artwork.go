@@ -0,0 +1,296 @@
+// Package artwork caches the album art media players report and serves it at
+// standard sizes, so clients that cannot reach a player's art URL, such as a
+// file:// path on this machine, can still show it.
+package artwork
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// URLPrefix is the path artwork is served under, followed by its hash
+const URLPrefix = "/api/media/artwork/"
+
+// Sizes are the sizes in pixels artwork is resized to fit
+var Sizes = []int{128, 256, 512}
+
+const (
+	// maxArtworkBytes is the largest artwork that is cached
+	maxArtworkBytes = 10 * 1024 * 1024
+	// fetchTimeout is how long fetching remote artwork can take
+	fetchTimeout = 10 * time.Second
+	// maxEntries is how many artworks are kept, removing the least recently
+	// cached first
+	maxEntries = 256
+	// originalName is the file name of the artwork as fetched
+	originalName = "original"
+)
+
+var (
+	// ErrNotFound is returned for a hash with no cached or registered artwork
+	ErrNotFound = errors.New("artwork not found")
+	// ErrNotImage is returned when a source is not an image
+	ErrNotImage = errors.New("artwork is not an image")
+)
+
+// hashPattern matches the hashes artwork is cached under
+var hashPattern = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// Cache fetches artwork on first use and keeps it on disk
+type Cache struct {
+	dir    string
+	client *http.Client
+
+	mu sync.RWMutex
+	// sources are the registered sources by hash, keeping the most recently
+	// registered up to maxEntries
+	sources map[string]registration
+	seq     uint64
+
+	group singleflight.Group
+}
+
+// NewCache creates a cache that keeps artwork in a directory
+func NewCache(dir string) *Cache {
+	return &Cache{
+		dir:     dir,
+		client:  &http.Client{Timeout: fetchTimeout},
+		sources: make(map[string]registration),
+	}
+}
+
+// registration is a registered source, and when it was last registered
+type registration struct {
+	source string
+	seq    uint64
+}
+
+// Artwork is a cached artwork file
+type Artwork struct {
+	Path        string
+	ContentType string
+	// ETag is a strong entity tag, as the artwork for a hash never changes
+	ETag    string
+	ModTime time.Time
+}
+
+// URL registers a source and returns the path the cache serves it at.
+// Sources can be http, https, file or data URLs. It returns false for other
+// sources, which clients should use as they are.
+func (c *Cache) URL(source string) (string, bool) {
+	hash, ok := sourceHash(source)
+	if !ok {
+		return "", false
+	}
+
+	c.mu.Lock()
+	c.seq++
+	c.sources[hash] = registration{source: source, seq: c.seq}
+	if len(c.sources) > maxEntries {
+		oldest := hash
+		for h, r := range c.sources {
+			if r.seq < c.sources[oldest].seq {
+				oldest = h
+			}
+		}
+		delete(c.sources, oldest)
+	}
+	c.mu.Unlock()
+
+	return URLPrefix + hash, true
+}
+
+// sourceHash returns the hash a source is cached under. Local files include
+// their size and modification time, as players reuse paths for new artwork.
+func sourceHash(source string) (string, bool) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", false
+	}
+
+	key := source
+	switch u.Scheme {
+	case "http", "https", "data":
+	case "file":
+		info, err := os.Stat(filePath(u))
+		if err != nil {
+			return "", false
+		}
+		key = fmt.Sprintf("%s\x00%d\x00%d", source, info.Size(), info.ModTime().UnixNano())
+	default:
+		return "", false
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:16]), true
+}
+
+// Get returns the artwork for a hash resized to fit a size, or as fetched for
+// a size of 0. Sizes are rounded up to a standard size, and artwork is never
+// enlarged.
+func (c *Cache) Get(ctx context.Context, hash string, size int) (Artwork, error) {
+	if !hashPattern.MatchString(hash) {
+		return Artwork{}, ErrNotFound
+	}
+
+	original, err := c.original(ctx, hash)
+	if err != nil {
+		return Artwork{}, err
+	}
+
+	size = StandardSize(size)
+	if size == 0 {
+		return c.artwork(original, hash, 0)
+	}
+
+	resized := filepath.Join(c.dir, hash, fmt.Sprint(size))
+	if _, err := os.Stat(resized); err != nil {
+		if _, err, _ := c.group.Do(resized, func() (any, error) {
+			return nil, resize(original, resized, size)
+		}); err != nil {
+			return Artwork{}, err
+		}
+	}
+	return c.artwork(resized, hash, size)
+}
+
+// StandardSize rounds a size up to a standard size, returning 0 for 0 and
+// the largest size for anything larger
+func StandardSize(size int) int {
+	if size <= 0 {
+		return 0
+	}
+	for _, standard := range Sizes {
+		if size <= standard {
+			return standard
+		}
+	}
+	return Sizes[len(Sizes)-1]
+}
+
+// original returns the path of the artwork as fetched, fetching it if it is
+// not cached
+func (c *Cache) original(ctx context.Context, hash string) (string, error) {
+	path := filepath.Join(c.dir, hash, originalName)
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	c.mu.RLock()
+	registered, ok := c.sources[hash]
+	c.mu.RUnlock()
+	if !ok {
+		return "", ErrNotFound
+	}
+	source := registered.source
+
+	_, err, _ := c.group.Do(path, func() (any, error) {
+		// Other requests may be waiting on this fetch, so it outlives the
+		// request that started it
+		data, err := c.fetch(context.WithoutCancel(ctx), source)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeFile(path, data); err != nil {
+			return nil, err
+		}
+		c.prune()
+		return nil, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// artwork describes a cached file
+func (c *Cache) artwork(path, hash string, size int) (Artwork, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Artwork{}, err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return Artwork{}, err
+	}
+	head := make([]byte, 512)
+	n, _ := f.Read(head)
+
+	return Artwork{
+		Path:        path,
+		ContentType: http.DetectContentType(head[:n]),
+		ETag:        fmt.Sprintf(`"%s-%d"`, hash, size),
+		ModTime:     info.ModTime(),
+	}, nil
+}
+
+// writeFile writes a file through a temporary file, so readers never see it
+// partly written
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create artwork directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to cache artwork: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to cache artwork: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to cache artwork: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// prune removes the least recently cached artwork beyond the limit
+func (c *Cache) prune() {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil || len(entries) <= maxEntries {
+		return
+	}
+
+	type cached struct {
+		name    string
+		modTime time.Time
+	}
+	artworks := make([]cached, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !hashPattern.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		artworks = append(artworks, cached{entry.Name(), info.ModTime()})
+	}
+	sort.Slice(artworks, func(i, j int) bool {
+		return artworks[i].modTime.Before(artworks[j].modTime)
+	})
+
+	for _, artwork := range artworks[:max(len(artworks)-maxEntries, 0)] {
+		if err := os.RemoveAll(filepath.Join(c.dir, artwork.name)); err != nil {
+			slog.Warn("Failed to remove cached artwork", "hash", artwork.name, "error", err)
+		}
+	}
+}
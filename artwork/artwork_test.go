@@ -0,0 +1,214 @@
+package artwork
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testImage returns a PNG of a size, transparent or opaque
+func testImage(t *testing.T, width, height int, opaque bool) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	alpha := uint8(128)
+	if opaque {
+		alpha = 255
+	}
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 100, A: alpha})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func decodeConfig(t *testing.T, path string) (image.Config, string) {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() { _ = f.Close() }()
+	config, format, err := image.DecodeConfig(f)
+	require.NoError(t, err)
+	return config, format
+}
+
+func hashOf(t *testing.T, url string) string {
+	t.Helper()
+	hash, ok := strings.CutPrefix(url, URLPrefix)
+	require.True(t, ok, url)
+	return hash
+}
+
+func TestStandardSize(t *testing.T) {
+	assert.Equal(t, 0, StandardSize(0))
+	assert.Equal(t, 128, StandardSize(1))
+	assert.Equal(t, 128, StandardSize(128))
+	assert.Equal(t, 256, StandardSize(200))
+	assert.Equal(t, 512, StandardSize(4000))
+}
+
+func TestURL(t *testing.T) {
+	cache := NewCache(t.TempDir())
+
+	remote, ok := cache.URL("https://i.scdn.co/image/1")
+	require.True(t, ok)
+	again, _ := cache.URL("https://i.scdn.co/image/1")
+	assert.Equal(t, remote, again)
+
+	_, ok = cache.URL("spotify:track:1")
+	assert.False(t, ok)
+	_, ok = cache.URL("file:///does/not/exist.png")
+	assert.False(t, ok)
+
+	// A file rewritten at the same path gets a new URL
+	path := filepath.Join(t.TempDir(), "cover.png")
+	require.NoError(t, os.WriteFile(path, testImage(t, 8, 8, true), 0o644))
+	first, ok := cache.URL("file://" + path)
+	require.True(t, ok)
+	require.NoError(t, os.WriteFile(path, testImage(t, 16, 16, true), 0o644))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+	second, _ := cache.URL("file://" + path)
+	assert.NotEqual(t, first, second)
+}
+
+func TestURLLimit(t *testing.T) {
+	cache := NewCache(t.TempDir())
+
+	// Registering a source again keeps it, and the least recently registered
+	// sources are forgotten
+	first, _ := cache.URL("https://example.com/0")
+	for i := 1; i < maxEntries+10; i++ {
+		_, _ = cache.URL(fmt.Sprintf("https://example.com/%d", i))
+		if i%100 == 0 {
+			_, _ = cache.URL("https://example.com/0")
+		}
+	}
+	assert.Len(t, cache.sources, maxEntries)
+	assert.Contains(t, cache.sources, hashOf(t, first))
+	forgotten, _ := sourceHash("https://example.com/1")
+	_, err := cache.Get(context.Background(), forgotten, 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestGetRemote(t *testing.T) {
+	var requests atomic.Int32
+	cover := testImage(t, 1000, 500, true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write(cover)
+	}))
+	t.Cleanup(server.Close)
+
+	cache := NewCache(t.TempDir())
+	url, ok := cache.URL(server.URL + "/cover.png")
+	require.True(t, ok)
+	hash := hashOf(t, url)
+
+	original, err := cache.Get(context.Background(), hash, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", original.ContentType)
+	assert.Equal(t, `"`+hash+`-0"`, original.ETag)
+	data, err := os.ReadFile(original.Path)
+	require.NoError(t, err)
+	assert.Equal(t, cover, data)
+
+	resized, err := cache.Get(context.Background(), hash, 200)
+	require.NoError(t, err)
+	assert.Equal(t, "image/jpeg", resized.ContentType)
+	assert.Equal(t, `"`+hash+`-256"`, resized.ETag)
+	config, _ := decodeConfig(t, resized.Path)
+	assert.Equal(t, 256, config.Width)
+	assert.Equal(t, 128, config.Height)
+
+	_, err = cache.Get(context.Background(), hash, 256)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	// Cached artwork is served without its source being registered
+	restarted := NewCache(cache.dir)
+	_, err = restarted.Get(context.Background(), hash, 512)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestGetLocal(t *testing.T) {
+	cache := NewCache(t.TempDir())
+
+	path := filepath.Join(t.TempDir(), "cover.png")
+	require.NoError(t, os.WriteFile(path, testImage(t, 600, 600, false), 0o644))
+	url, ok := cache.URL("file://" + path)
+	require.True(t, ok)
+
+	// Transparent artwork stays PNG
+	resized, err := cache.Get(context.Background(), hashOf(t, url), 128)
+	require.NoError(t, err)
+	config, format := decodeConfig(t, resized.Path)
+	assert.Equal(t, "png", format)
+	assert.Equal(t, 128, config.Width)
+
+	// Artwork is never enlarged
+	small := "data:image/png;base64," + base64.StdEncoding.EncodeToString(testImage(t, 64, 64, true))
+	url, ok = cache.URL(small)
+	require.True(t, ok)
+	resized, err = cache.Get(context.Background(), hashOf(t, url), 512)
+	require.NoError(t, err)
+	config, _ = decodeConfig(t, resized.Path)
+	assert.Equal(t, 64, config.Width)
+}
+
+func TestGetErrors(t *testing.T) {
+	cache := NewCache(t.TempDir())
+
+	_, err := cache.Get(context.Background(), "../../etc", 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = cache.Get(context.Background(), strings.Repeat("a", 32), 0)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("not an image"), 0o644))
+	url, ok := cache.URL("file://" + path)
+	require.True(t, ok)
+	_, err = cache.Get(context.Background(), hashOf(t, url), 0)
+	assert.ErrorIs(t, err, ErrNotImage)
+}
+
+func TestGetTooLarge(t *testing.T) {
+	// A PNG header for an image too large to decode, without its pixels
+	header := make([]byte, 13)
+	binary.BigEndian.PutUint32(header[0:], 20000)
+	binary.BigEndian.PutUint32(header[4:], 20000)
+	header[8], header[9] = 8, 2 // 8-bit RGB
+	chunk := append([]byte("IHDR"), header...)
+	data := append([]byte("\x89PNG\r\n\x1a\n"), binary.BigEndian.AppendUint32(nil, uint32(len(header)))...)
+	data = append(data, chunk...)
+	data = binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+
+	path := filepath.Join(t.TempDir(), "huge.png")
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+
+	cache := NewCache(t.TempDir())
+	url, ok := cache.URL("file://" + path)
+	require.True(t, ok)
+	_, err := cache.Get(context.Background(), hashOf(t, url), 256)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "larger than 8192x8192 pixels")
+}
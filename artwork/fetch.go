@@ -0,0 +1,111 @@
+package artwork
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// fetch reads the artwork at a source, checking it is an image
+func (c *Cache) fetch(ctx context.Context, source string) ([]byte, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artwork URL: %w", err)
+	}
+
+	var data []byte
+	switch u.Scheme {
+	case "http", "https":
+		data, err = c.fetchHTTP(ctx, source)
+	case "file":
+		data, err = readFile(filePath(u))
+	case "data":
+		data, err = decodeDataURL(source)
+	default:
+		return nil, fmt.Errorf("unsupported artwork URL scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(http.DetectContentType(data), "image/") {
+		return nil, ErrNotImage
+	}
+	return data, nil
+}
+
+func (c *Cache) fetchHTTP(ctx context.Context, source string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artwork URL: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch artwork: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch artwork: %s", resp.Status)
+	}
+	return readLimited(resp.Body)
+}
+
+func readFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artwork: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	return readLimited(f)
+}
+
+// readLimited reads artwork, failing if it is too large to cache
+func readLimited(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxArtworkBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artwork: %w", err)
+	}
+	if len(data) > maxArtworkBytes {
+		return nil, fmt.Errorf("artwork is larger than %d bytes", maxArtworkBytes)
+	}
+	return data, nil
+}
+
+// decodeDataURL decodes a data URL, such as data:image/png;base64,...
+func decodeDataURL(source string) ([]byte, error) {
+	header, payload, ok := strings.Cut(strings.TrimPrefix(source, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid artwork data URL")
+	}
+	if strings.HasSuffix(header, ";base64") {
+		data, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, fmt.Errorf("invalid artwork data URL: %w", err)
+		}
+		return readLimited(bytes.NewReader(data))
+	}
+	data, err := url.PathUnescape(payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid artwork data URL: %w", err)
+	}
+	return readLimited(strings.NewReader(data))
+}
+
+// filePath returns the local path of a file URL
+func filePath(u *url.URL) string {
+	path := u.Path
+	// file:///C:/Music/cover.jpg has the path /C:/Music/cover.jpg
+	if runtime.GOOS == "windows" && len(path) > 2 && path[0] == '/' && path[2] == ':' {
+		path = path[1:]
+	}
+	return filepath.FromSlash(path)
+}
@@ -0,0 +1,24 @@
+package artwork
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *Cache
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global artwork cache instance
+func GetInstance() *Cache {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global artwork cache instance
+func SetInstance(instance *Cache) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
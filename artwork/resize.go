@@ -0,0 +1,72 @@
+package artwork
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+
+	// Decoders for the formats players use for artwork
+	_ "image/gif"
+
+	_ "golang.org/x/image/bmp"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// jpegQuality is the quality resized artwork is encoded at
+	jpegQuality = 85
+	// maxDimension is the widest or tallest artwork that is resized, as
+	// decoding allocates memory for every pixel
+	maxDimension = 8192
+)
+
+// resize writes the artwork at a path resized to fit a size. Artwork that
+// already fits is copied as it is. Opaque artwork is encoded as JPEG, and
+// artwork with transparency as PNG.
+func resize(originalPath, path string, size int) error {
+	original, err := os.ReadFile(originalPath)
+	if err != nil {
+		return fmt.Errorf("failed to read artwork: %w", err)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(original))
+	if err != nil {
+		return fmt.Errorf("failed to decode artwork: %w", err)
+	}
+	width, height := config.Width, config.Height
+	if width <= size && height <= size {
+		return writeFile(path, original)
+	}
+	if width > maxDimension || height > maxDimension {
+		return fmt.Errorf("artwork is larger than %dx%d pixels", maxDimension, maxDimension)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(original))
+	if err != nil {
+		return fmt.Errorf("failed to decode artwork: %w", err)
+	}
+	bounds := src.Bounds()
+
+	if width >= height {
+		width, height = size, max(height*size/width, 1)
+	} else {
+		width, height = max(width*size/height, 1), size
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+
+	var buf bytes.Buffer
+	if opaque, ok := src.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality})
+	} else {
+		err = png.Encode(&buf, dst)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode artwork: %w", err)
+	}
+	return writeFile(path, buf.Bytes())
+}
@@ -6,23 +6,33 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/fs"
+	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"log/slog"
 
+	"github.com/timmo001/system-bridge/artwork"
+	"github.com/timmo001/system-bridge/backend/graphql"
 	api_http "github.com/timmo001/system-bridge/backend/http"
 	"github.com/timmo001/system-bridge/backend/mcp"
+	"github.com/timmo001/system-bridge/backend/rpc"
 	"github.com/timmo001/system-bridge/backend/websocket"
 	"github.com/timmo001/system-bridge/bus"
 	"github.com/timmo001/system-bridge/data"
 	"github.com/timmo001/system-bridge/discovery"
 	"github.com/timmo001/system-bridge/event"
 	event_handler "github.com/timmo001/system-bridge/event/handler"
+	"github.com/timmo001/system-bridge/hotkey"
+	"github.com/timmo001/system-bridge/macro"
+	"github.com/timmo001/system-bridge/medialibrary"
+	"github.com/timmo001/system-bridge/scheduler"
 	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/utils"
 	"github.com/timmo001/system-bridge/utils/handlers/command"
+	"github.com/timmo001/system-bridge/utils/mediaurl"
 	"github.com/timmo001/system-bridge/version"
 )
 
@@ -88,6 +98,31 @@ func (b *Backend) Run(ctx context.Context) error {
 	// Setup event handlers
 	event_handler.RegisterHandlers(b.eventRouter, b.dataStore)
 
+	// Start the scheduler, which sends events through the router
+	sched := scheduler.NewScheduler(b.eventRouter)
+	scheduler.SetInstance(sched)
+	go sched.Run(ctx)
+
+	// Set up the macro runner, which sends events through the router
+	macro.SetInstance(macro.NewRunner(b.eventRouter, b.dataStore))
+
+	// Set up the artwork cache, which media thumbnails are rewritten to, and
+	// the media library index, which are both kept in the data directory
+	if dataPath, err := utils.GetDataPath(); err != nil {
+		slog.Warn("Failed to get data path, media artwork will not be cached and the media library will not be indexed", "error", err)
+	} else {
+		artwork.SetInstance(artwork.NewCache(filepath.Join(dataPath, "artwork")))
+
+		library := medialibrary.NewLibrary(filepath.Join(dataPath, "media-library.json"))
+		medialibrary.SetInstance(library)
+		go library.Run(ctx)
+	}
+
+	// Bind the global hotkeys, which run their actions through the router
+	hotkeys := hotkey.NewManager(b.eventRouter)
+	hotkey.SetInstance(hotkeys)
+	go hotkeys.Run(ctx)
+
 	// Create a new HTTP server mux
 	mux := http.NewServeMux()
 
@@ -105,13 +140,8 @@ func (b *Backend) Run(ctx context.Context) error {
 		}
 	})
 
-	// Set up MCP WebSocket endpoint
-	mcpServer := mcp.NewMCPServer(b.token, b.eventRouter, b.dataStore)
-	mux.HandleFunc("/api/mcp", func(w http.ResponseWriter, r *http.Request) {
-		if err := mcpServer.HandleConnection(w, r); err != nil {
-			slog.Error("MCP connection error", "error", err)
-		}
-	})
+	// Set up MCP endpoint, serving both the WebSocket and Streamable HTTP transports
+	mux.Handle("/api/mcp", mcp.NewMCPServer(b.token, b.eventRouter, b.dataStore))
 
 	// Set up API endpoint
 	mux.HandleFunc("/api", api_http.HandleAPI)
@@ -119,6 +149,21 @@ func (b *Backend) Run(ctx context.Context) error {
 	mux.HandleFunc("/api/data/", api_http.GetModuleDataHandler(
 		b.dataStore,
 	))
+	// Set up command execution endpoints
+	mux.HandleFunc("POST /api/commands/{id}/execute", api_http.ExecuteCommandHandler(b.token))
+	mux.HandleFunc("GET /api/commands/jobs/{jobID}", api_http.GetCommandJobHandler(b.token))
+	// Set up macro endpoint
+	mux.HandleFunc("POST /api/macros/{id}/run", api_http.RunMacroHandler(b.token))
+	// Set up connected clients endpoint
+	mux.HandleFunc("/api/clients", api_http.GetClientsHandler(b.token))
+	// Set up Server-Sent Events stream for module data updates
+	mux.Handle("/api/events", api_http.NewEventStream(b.token, b.dataStore))
+	// Set up GraphQL endpoint for module data queries, mutations and graphql-ws subscriptions
+	if graphqlHandler, err := graphql.NewHandler(b.token, b.eventRouter, b.dataStore); err != nil {
+		slog.Error("Failed to create GraphQL handler", "error", err)
+	} else {
+		mux.Handle("/api/graphql", graphqlHandler)
+	}
 
 	// Set up health check endpoint
 	mux.HandleFunc("/api/health", func(w http.ResponseWriter, r *http.Request) {
@@ -142,7 +187,11 @@ func (b *Backend) Run(ctx context.Context) error {
 		}
 	})
 
-	mux.HandleFunc("/api/media/file/data", api_http.ServeMediaFileDataHandler)
+	mux.HandleFunc(mediaurl.Path, api_http.ServeMediaFileDataHandler)
+	mux.HandleFunc("GET "+artwork.URLPrefix+"{hash}", api_http.ServeArtworkHandler)
+	mux.HandleFunc("GET /api/media/library", api_http.SearchMediaLibraryHandler(b.token))
+	mux.HandleFunc("GET /api/media/library/artists", api_http.GetMediaLibraryArtistsHandler(b.token))
+	mux.HandleFunc("GET /api/media/library/albums", api_http.GetMediaLibraryAlbumsHandler(b.token))
 
 	// Set up SPA file server (must be last to avoid catching API routes)
 	subFS, err := fs.Sub(b.webClientContent, "web-client/dist")
@@ -160,6 +209,38 @@ func (b *Backend) Run(ctx context.Context) error {
 		Addr:    fmt.Sprintf("0.0.0.0:%d", port),
 		Handler: mux,
 	}
+
+	// Set up optional gRPC API, either on its own port or as h2c on the HTTP listener
+	if b.settings.GRPC.Enabled {
+		grpcServer := rpc.NewServer(b.token, b.eventRouter, b.dataStore)
+		defer grpcServer.Stop()
+
+		if b.settings.GRPC.Port == 0 {
+			server.Protocols = new(http.Protocols)
+			server.Protocols.SetHTTP1(true)
+			server.Protocols.SetUnencryptedHTTP2(true)
+			server.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+					grpcServer.ServeHTTP(w, r)
+					return
+				}
+				mux.ServeHTTP(w, r)
+			})
+			slog.Info("gRPC server is running on the HTTP listener", "address", server.Addr)
+		} else {
+			listener, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", b.settings.GRPC.Port))
+			if err != nil {
+				slog.Error("Failed to start gRPC listener", "port", b.settings.GRPC.Port, "error", err)
+			} else {
+				go func() {
+					slog.Info("gRPC server is running on", "address", listener.Addr().String())
+					if err := grpcServer.Serve(listener); err != nil {
+						slog.Error("gRPC server error", "error", err)
+					}
+				}()
+			}
+		}
+	}
 	defer func() {
 		err = server.Shutdown(ctx)
 		if err != nil {
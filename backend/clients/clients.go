@@ -0,0 +1,38 @@
+package clients
+
+import (
+	"sort"
+
+	"github.com/timmo001/system-bridge/backend/mcp"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+)
+
+// List returns every connected WebSocket and MCP session, oldest first
+func List() []event.ClientSession {
+	sessions := make([]event.ClientSession, 0)
+	if ws := websocket.GetInstance(); ws != nil {
+		sessions = append(sessions, ws.Sessions()...)
+	}
+	if mcpServer := mcp.GetInstance(); mcpServer != nil {
+		sessions = append(sessions, mcpServer.Sessions()...)
+	}
+
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].ConnectedAt.Before(sessions[j].ConnectedAt)
+	})
+
+	return sessions
+}
+
+// Disconnect closes the session with the given ID, returning false if no
+// session matches
+func Disconnect(sessionID string) bool {
+	if ws := websocket.GetInstance(); ws != nil && ws.Disconnect(sessionID) {
+		return true
+	}
+	if mcpServer := mcp.GetInstance(); mcpServer != nil && mcpServer.Disconnect(sessionID) {
+		return true
+	}
+	return false
+}
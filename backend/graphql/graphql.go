@@ -0,0 +1,161 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	gql "github.com/graphql-go/graphql"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+)
+
+// connectionKey is the context key holding the caller's connection ID
+type connectionKey struct{}
+
+// Request is a GraphQL request as sent over HTTP or graphql-ws
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// Handler serves the GraphQL API for module data
+type Handler struct {
+	token       string
+	eventRouter *event.MessageRouter
+	dataStore   *data.DataStore
+	schema      gql.Schema
+}
+
+// NewHandler creates a new GraphQL handler with a schema built from the types package
+func NewHandler(token string, eventRouter *event.MessageRouter, dataStore *data.DataStore) (*Handler, error) {
+	h := &Handler{
+		token:       token,
+		eventRouter: eventRouter,
+		dataStore:   dataStore,
+	}
+
+	schema, err := h.buildSchema()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+	h.schema = schema
+
+	return h, nil
+}
+
+// ServeHTTP handles /api/graphql, upgrading to graphql-ws when requested
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		h.serveWebSocket(w, r)
+		return
+	}
+
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	// Check for API token in headers, falling back to the query string
+	token := r.Header.Get("X-API-Token")
+	if token == "" {
+		token = r.Header.Get("token")
+	}
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if token != h.token {
+		writeError(w, http.StatusUnauthorized, "Invalid API token")
+		return
+	}
+
+	var req Request
+	if r.Method == http.MethodGet {
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "Invalid variables")
+				return
+			}
+		}
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "Missing query")
+		return
+	}
+
+	operation, err := operationType(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	switch operation {
+	case "subscription":
+		writeError(w, http.StatusBadRequest, "Subscriptions require a graphql-ws WebSocket connection")
+		return
+	case "mutation":
+		// Mutations have side effects, so they are not allowed over GET
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "Mutations require POST")
+			return
+		}
+	}
+
+	slog.Debug("GraphQL request", "remote", r.RemoteAddr, "operation", req.OperationName)
+
+	result := h.execute(context.WithValue(r.Context(), connectionKey{}, "graphql:"+r.RemoteAddr), req)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		slog.Error("Failed to encode response", "error", err)
+	}
+}
+
+// execute runs a query or mutation
+func (h *Handler) execute(ctx context.Context, req Request) *gql.Result {
+	return gql.Do(gql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        ctx,
+	})
+}
+
+// dispatch routes a mutation through the event router
+func (h *Handler) dispatch(ctx context.Context, eventType event.EventType, data map[string]any) (event.MessageResponse, error) {
+	connection, _ := ctx.Value(connectionKey{}).(string)
+
+	response := h.eventRouter.HandleMessage(connection, event.Message{
+		ID:    uuid.NewString(),
+		Event: eventType,
+		Data:  data,
+	})
+	if response.Type == event.ResponseTypeError {
+		if response.Message != "" {
+			return response, fmt.Errorf("%s", response.Message)
+		}
+		return response, fmt.Errorf("%s failed: %s", eventType, response.Subtype)
+	}
+
+	return response, nil
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+		slog.Error("Failed to encode response", "error", err)
+	}
+}
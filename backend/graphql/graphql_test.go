@@ -0,0 +1,221 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func newTestHandler(t *testing.T) (*Handler, *data.DataStore, *event.MessageRouter) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	router := event.NewMessageRouter()
+	h, err := NewHandler("test-token", router, dataStore)
+	require.NoError(t, err)
+
+	return h, dataStore, router
+}
+
+func postQuery(t *testing.T, h *Handler, query string) (int, map[string]any) {
+	body, err := json.Marshal(Request{Query: query})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/graphql", strings.NewReader(string(body)))
+	req.Header.Set("X-API-Token", "test-token")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	return rec.Code, result
+}
+
+func TestQuery(t *testing.T) {
+	h, dataStore, _ := newTestHandler(t)
+
+	usage := 12.5
+	percentage := 80.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleCPU, types.CPUData{Usage: &usage}))
+	require.NoError(t, dataStore.SetModuleData(types.ModuleBattery, types.BatteryData{Percentage: &percentage}))
+	isPrimary := true
+	require.NoError(t, dataStore.SetModuleData(types.ModuleDisplays, types.DisplaysData{
+		{ID: "0", Name: "Primary", ResolutionHorizontal: 3840, IsPrimary: &isPrimary},
+	}))
+
+	t.Run("Selects fields across modules", func(t *testing.T) {
+		code, result := postQuery(t, h, `{ cpu { usage } displays { name resolution_horizontal is_primary } battery { percentage } }`)
+		require.Equal(t, http.StatusOK, code)
+		assert.Nil(t, result["errors"])
+
+		expected := map[string]any{
+			"cpu":      map[string]any{"usage": 12.5},
+			"displays": []any{map[string]any{"name": "Primary", "resolution_horizontal": float64(3840), "is_primary": true}},
+			"battery":  map[string]any{"percentage": float64(80)},
+		}
+		assert.Equal(t, expected, result["data"])
+	})
+
+	t.Run("Unknown field is rejected", func(t *testing.T) {
+		_, result := postQuery(t, h, `{ cpu { nope } }`)
+		assert.NotNil(t, result["errors"])
+	})
+
+	t.Run("Missing token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/graphql?query={cpu{usage}}", nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Mutations are not allowed over GET", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, `/api/graphql?token=test-token&query=mutation{open(url:"x"){type}}`, nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestMutation(t *testing.T) {
+	h, _, router := newTestHandler(t)
+
+	var received event.Message
+	router.RegisterSimpleHandler(event.EventMediaControl, func(connection string, message event.Message) event.MessageResponse {
+		received = message
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMediaControlled,
+			Subtype: event.ResponseSubtypeNone,
+			Message: "Media controlled",
+		}
+	})
+	router.RegisterSimpleHandler(event.EventOpen, func(connection string, message event.Message) event.MessageResponse {
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeError,
+			Subtype: event.ResponseSubtypeMissingPathURL,
+			Message: "No path or URL provided for open",
+		}
+	})
+
+	t.Run("Mutation is routed to the event handler", func(t *testing.T) {
+		_, result := postQuery(t, h, `mutation { mediaControl(action: "PLAY") { type message } }`)
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"type": "MEDIA_CONTROLLED", "message": "Media controlled"}, result["data"].(map[string]any)["mediaControl"])
+		assert.Equal(t, event.EventMediaControl, received.Event)
+		assert.Equal(t, map[string]any{"action": "PLAY"}, received.Data)
+	})
+
+	t.Run("Media control can pick a player", func(t *testing.T) {
+		_, result := postQuery(t, h, `mutation { mediaControl(action: "PAUSE", player: "spotify") { type } }`)
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"action": "PAUSE", "player": "spotify"}, received.Data)
+	})
+
+	t.Run("Media control passes playback values", func(t *testing.T) {
+		_, result := postQuery(t, h, `mutation { mediaControl(action: "SEEK", position: -10, relative: true) { type } }`)
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"action": "SEEK", "position": float64(-10), "relative": true}, received.Data)
+
+		_, result = postQuery(t, h, `mutation { mediaControl(action: "SET_VOLUME", volume: 42.5) { type } }`)
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"action": "SET_VOLUME", "volume": 42.5}, received.Data)
+
+		_, result = postQuery(t, h, `mutation { mediaControl(action: "SET_REPEAT", repeat: "TRACK", shuffle: false, rate: 1.5) { type } }`)
+		assert.Nil(t, result["errors"])
+		assert.Equal(t, map[string]any{"action": "SET_REPEAT", "repeat": "TRACK", "shuffle": false, "rate": 1.5}, received.Data)
+	})
+
+	t.Run("Handler errors are returned as GraphQL errors", func(t *testing.T) {
+		_, result := postQuery(t, h, `mutation { open { type } }`)
+		errors, ok := result["errors"].([]any)
+		require.True(t, ok)
+		require.Len(t, errors, 1)
+		assert.Equal(t, "No path or URL provided for open", errors[0].(map[string]any)["message"])
+	})
+}
+
+func TestSubscription(t *testing.T) {
+	h, dataStore, _ := newTestHandler(t)
+
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{protocolTransportWS}}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	assert.Equal(t, protocolTransportWS, conn.Subprotocol())
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	require.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"token":"test-token"}`)}))
+	var ack wsMessage
+	require.NoError(t, conn.ReadJSON(&ack))
+	require.Equal(t, "connection_ack", ack.Type)
+
+	require.NoError(t, conn.WriteJSON(wsMessage{
+		ID:      "1",
+		Type:    "subscribe",
+		Payload: json.RawMessage(`{"query":"subscription { memory { virtual { percent } } }"}`),
+	}))
+
+	// The current data is sent first
+	var snapshot wsMessage
+	require.NoError(t, conn.ReadJSON(&snapshot))
+	assert.Equal(t, "next", snapshot.Type)
+	assert.Equal(t, "1", snapshot.ID)
+
+	percent := 64.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleMemory, types.MemoryData{
+		Virtual: &types.MemoryVirtual{Percent: &percent},
+	}))
+
+	var update wsMessage
+	require.NoError(t, conn.ReadJSON(&update))
+	assert.Equal(t, "next", update.Type)
+	assert.JSONEq(t, `{"data":{"memory":{"virtual":{"percent":64}}}}`, string(update.Payload))
+
+	require.NoError(t, conn.WriteJSON(wsMessage{ID: "1", Type: "complete"}))
+	require.NoError(t, conn.WriteJSON(wsMessage{Type: "ping"}))
+
+	var pong wsMessage
+	require.NoError(t, conn.ReadJSON(&pong))
+	assert.Equal(t, "pong", pong.Type)
+}
+
+func TestSubscriptionRejectsInvalidToken(t *testing.T) {
+	h, _, _ := newTestHandler(t)
+
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{protocolTransportWS}}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	require.NoError(t, conn.WriteJSON(wsMessage{Type: "connection_init", Payload: json.RawMessage(`{"token":"wrong"}`)}))
+
+	_, _, err = conn.ReadMessage()
+	var closeErr *websocket.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	assert.Equal(t, 4403, closeErr.Code)
+}
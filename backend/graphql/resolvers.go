@@ -0,0 +1,218 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// subscriptionBuffer is the number of updates buffered per subscription before dropping
+const subscriptionBuffer = 16
+
+// actionResultType is returned by mutations that map onto router events
+var actionResultType = gql.NewObject(gql.ObjectConfig{
+	Name: "ActionResult",
+	Fields: gql.Fields{
+		"type":    &gql.Field{Type: gql.NewNonNull(gql.String)},
+		"message": &gql.Field{Type: gql.String},
+	},
+})
+
+// buildSchema builds the schema with one query and subscription field per
+// data module, and mutations for router events
+func (h *Handler) buildSchema() (gql.Schema, error) {
+	builder := newTypeBuilder()
+
+	queryFields := gql.Fields{}
+	subscriptionFields := gql.Fields{}
+	for _, module := range moduleTypes {
+		outputType := builder.outputType(module.Type)
+
+		queryFields[string(module.Name)] = &gql.Field{
+			Type:        outputType,
+			Description: fmt.Sprintf("Current %s module data", module.Name),
+			Resolve:     h.resolveModule(module.Name),
+		}
+		subscriptionFields[string(module.Name)] = &gql.Field{
+			Type:        outputType,
+			Description: fmt.Sprintf("Current %s module data, followed by every update", module.Name),
+			Resolve: func(p gql.ResolveParams) (any, error) {
+				return p.Source, nil
+			},
+			Subscribe: h.subscribeModule(module.Name),
+		}
+	}
+
+	return gql.NewSchema(gql.SchemaConfig{
+		Query: gql.NewObject(gql.ObjectConfig{
+			Name:   "Query",
+			Fields: queryFields,
+		}),
+		Mutation: gql.NewObject(gql.ObjectConfig{
+			Name:   "Mutation",
+			Fields: h.mutationFields(),
+		}),
+		Subscription: gql.NewObject(gql.ObjectConfig{
+			Name:   "Subscription",
+			Fields: subscriptionFields,
+		}),
+	})
+}
+
+// resolveModule resolves the current data for a module
+func (h *Handler) resolveModule(name types.ModuleName) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (any, error) {
+		module, err := h.dataStore.GetModule(name)
+		if err != nil {
+			return nil, err
+		}
+		return normalizeModuleData(module.Data)
+	}
+}
+
+// subscribeModule returns a channel that receives the current data for a
+// module followed by each update published on the event bus
+func (h *Handler) subscribeModule(name types.ModuleName) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (any, error) {
+		ctx := p.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		updates := make(chan any, subscriptionBuffer)
+		send := func(value any) {
+			select {
+			case <-ctx.Done():
+			case updates <- value:
+			default:
+				slog.Warn("GraphQL: Subscription buffer full, dropping update", "module", name)
+			}
+		}
+
+		subscriberID := "graphql-" + uuid.NewString()
+		eventBus := bus.GetInstance()
+		eventBus.Subscribe(bus.EventDataModuleUpdate, subscriberID, func(e bus.Event) {
+			var module types.Module
+			if err := mapstructure.Decode(e.Data, &module); err != nil {
+				slog.Error("Failed to decode module data", "error", err)
+				return
+			}
+			if module.Name != name {
+				return
+			}
+			value, err := normalizeModuleData(module.Data)
+			if err != nil {
+				slog.Error("GraphQL: Failed to normalize module data", "module", name, "error", err)
+				return
+			}
+			send(value)
+		})
+		go func() {
+			<-ctx.Done()
+			eventBus.Unsubscribe(bus.EventDataModuleUpdate, subscriberID)
+		}()
+
+		module, err := h.dataStore.GetModule(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := normalizeModuleData(module.Data)
+		if err != nil {
+			return nil, err
+		}
+		send(value)
+
+		return updates, nil
+	}
+}
+
+// mutationFields maps mutations onto existing router events
+func (h *Handler) mutationFields() gql.Fields {
+	return gql.Fields{
+		"mediaControl": &gql.Field{
+			Type:        actionResultType,
+			Description: "Control media playback",
+			Args: gql.FieldConfigArgument{
+				"action":   &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				"player":   &gql.ArgumentConfig{Type: gql.String, Description: "Player to control, defaulting to the active player"},
+				"position": &gql.ArgumentConfig{Type: gql.Float, Description: "Position in seconds for SEEK, or the offset to seek by when relative"},
+				"relative": &gql.ArgumentConfig{Type: gql.Boolean},
+				"volume":   &gql.ArgumentConfig{Type: gql.Float, Description: "Volume from 0 to 100 for SET_VOLUME"},
+				"shuffle":  &gql.ArgumentConfig{Type: gql.Boolean, Description: "Shuffle state for SET_SHUFFLE"},
+				"repeat":   &gql.ArgumentConfig{Type: gql.String, Description: "NONE, TRACK or PLAYLIST for SET_REPEAT"},
+				"rate":     &gql.ArgumentConfig{Type: gql.Float, Description: "Playback rate for SET_RATE, where 1 is normal speed"},
+			},
+			Resolve: h.resolveAction(event.EventMediaControl),
+		},
+		"notification": &gql.Field{
+			Type:        actionResultType,
+			Description: "Send a desktop notification",
+			Args: gql.FieldConfigArgument{
+				"title":      &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				"message":    &gql.ArgumentConfig{Type: gql.NewNonNull(gql.String)},
+				"icon":       &gql.ArgumentConfig{Type: gql.String},
+				"duration":   &gql.ArgumentConfig{Type: gql.Int},
+				"actionUrl":  &gql.ArgumentConfig{Type: gql.String},
+				"actionPath": &gql.ArgumentConfig{Type: gql.String},
+				"sound":      &gql.ArgumentConfig{Type: gql.String},
+			},
+			Resolve: h.resolveAction(event.EventNotification),
+		},
+		"open": &gql.Field{
+			Type:        actionResultType,
+			Description: "Open a file path or URL",
+			Args: gql.FieldConfigArgument{
+				"path": &gql.ArgumentConfig{Type: gql.String},
+				"url":  &gql.ArgumentConfig{Type: gql.String},
+			},
+			Resolve: h.resolveAction(event.EventOpen),
+		},
+	}
+}
+
+// resolveAction dispatches a mutation's arguments as the event data
+func (h *Handler) resolveAction(eventType event.EventType) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (any, error) {
+		response, err := h.dispatch(p.Context, eventType, p.Args)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{
+			"type":    string(response.Type),
+			"message": response.Message,
+		}, nil
+	}
+}
+
+// operationType returns the type of the operation a request will execute
+func operationType(req Request) (string, error) {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("invalid query: %w", err)
+	}
+
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if req.OperationName == "" || (operation.Name != nil && operation.Name.Value == req.OperationName) {
+			return operation.Operation, nil
+		}
+	}
+
+	return "", fmt.Errorf("operation not found")
+}
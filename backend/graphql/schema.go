@@ -0,0 +1,233 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	"github.com/timmo001/system-bridge/types"
+)
+
+// moduleTypes maps each data module to the Go type its data is stored as.
+// The GraphQL schema is built from these types so it mirrors the types package.
+var moduleTypes = []struct {
+	Name types.ModuleName
+	Type reflect.Type
+}{
+	{types.ModuleBattery, reflect.TypeFor[types.BatteryData]()},
+	{types.ModuleCPU, reflect.TypeFor[types.CPUData]()},
+	{types.ModuleDisks, reflect.TypeFor[types.DisksData]()},
+	{types.ModuleDisplays, reflect.TypeFor[types.DisplaysData]()},
+	{types.ModuleGPUs, reflect.TypeFor[types.GPUsData]()},
+	{types.ModuleMedia, reflect.TypeFor[types.MediaData]()},
+	{types.ModuleMemory, reflect.TypeFor[types.MemoryData]()},
+	{types.ModuleNetworks, reflect.TypeFor[types.NetworksData]()},
+	{types.ModuleProcesses, reflect.TypeFor[types.ProcessesData]()},
+	{types.ModuleSensors, reflect.TypeFor[types.SensorsData]()},
+	{types.ModuleSystem, reflect.TypeFor[types.SystemData]()},
+}
+
+// JSONScalar carries arbitrary JSON values for fields typed as any
+var JSONScalar = gql.NewScalar(gql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Arbitrary JSON value",
+	Serialize: func(value any) any {
+		return value
+	},
+	ParseValue: func(value any) any {
+		return value
+	},
+	ParseLiteral: parseJSONLiteral,
+})
+
+// LongScalar is a 64-bit integer. GraphQL Int is limited to 32 bits, which is
+// too small for values such as memory sizes and byte counters.
+var LongScalar = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Long",
+	Description: "64-bit integer",
+	Serialize:   coerceLong,
+	ParseValue:  coerceLong,
+	ParseLiteral: func(valueAST ast.Value) any {
+		if v, ok := valueAST.(*ast.IntValue); ok {
+			var result int64
+			if _, err := fmt.Sscan(v.Value, &result); err == nil {
+				return result
+			}
+		}
+		return nil
+	},
+})
+
+func coerceLong(value any) any {
+	switch v := value.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return nil
+		}
+		return int64(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		return nil
+	default:
+		rv := reflect.ValueOf(value)
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return rv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return rv.Uint()
+		case reflect.Pointer:
+			if rv.IsNil() {
+				return nil
+			}
+			return coerceLong(rv.Elem().Interface())
+		}
+		return nil
+	}
+}
+
+func parseJSONLiteral(valueAST ast.Value) any {
+	switch v := valueAST.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue, *ast.FloatValue:
+		var result float64
+		if _, err := fmt.Sscan(v.GetValue().(string), &result); err == nil {
+			return result
+		}
+		return nil
+	case *ast.ListValue:
+		values := make([]any, 0, len(v.Values))
+		for _, item := range v.Values {
+			values = append(values, parseJSONLiteral(item))
+		}
+		return values
+	case *ast.ObjectValue:
+		values := make(map[string]any, len(v.Fields))
+		for _, field := range v.Fields {
+			values[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// typeBuilder converts Go types into GraphQL output types, caching objects so
+// shared and recursive types are only defined once
+type typeBuilder struct {
+	objects map[reflect.Type]*gql.Object
+}
+
+func newTypeBuilder() *typeBuilder {
+	return &typeBuilder{objects: make(map[reflect.Type]*gql.Object)}
+}
+
+// outputType returns the GraphQL type for a Go type. All fields are nullable
+// because module data may be partially collected on some platforms.
+func (b *typeBuilder) outputType(t reflect.Type) gql.Output {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return gql.Boolean
+	case reflect.String:
+		return gql.String
+	case reflect.Float32, reflect.Float64:
+		return gql.Float
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return LongScalar
+	case reflect.Slice, reflect.Array:
+		return gql.NewList(b.outputType(t.Elem()))
+	case reflect.Struct:
+		return b.object(t)
+	default:
+		return JSONScalar
+	}
+}
+
+// object returns the GraphQL object for a struct type
+func (b *typeBuilder) object(t reflect.Type) *gql.Object {
+	if obj, ok := b.objects[t]; ok {
+		return obj
+	}
+
+	// Fields are resolved lazily so recursive types can reference themselves
+	obj := gql.NewObject(gql.ObjectConfig{
+		Name: t.Name(),
+		Fields: gql.FieldsThunk(func() gql.Fields {
+			fields := gql.Fields{}
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				if !field.IsExported() {
+					continue
+				}
+				name := jsonFieldName(field)
+				if name == "" {
+					continue
+				}
+				fields[name] = &gql.Field{
+					Type:    b.outputType(field.Type),
+					Resolve: resolveMapField(name),
+				}
+			}
+			return fields
+		}),
+	})
+	b.objects[t] = obj
+
+	return obj
+}
+
+// jsonFieldName returns the JSON name of a struct field, or an empty string if
+// the field is not serialized
+func jsonFieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// resolveMapField resolves a field from JSON-normalized module data
+func resolveMapField(name string) gql.FieldResolveFn {
+	return func(p gql.ResolveParams) (any, error) {
+		if source, ok := p.Source.(map[string]any); ok {
+			return source[name], nil
+		}
+		return nil, nil
+	}
+}
+
+// normalizeModuleData converts module data into plain JSON values. Stored data
+// may be a typed struct or, after a restart, a decoded map, so resolvers work
+// on the JSON form of both.
+func normalizeModuleData(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode module data: %w", err)
+	}
+	var result any
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode module data: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,326 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	gql "github.com/graphql-go/graphql"
+)
+
+const (
+	// protocolTransportWS is the graphql-transport-ws protocol used by the graphql-ws library
+	protocolTransportWS = "graphql-transport-ws"
+	// protocolLegacyWS is the legacy subscriptions-transport-ws protocol
+	protocolLegacyWS = "graphql-ws"
+
+	// connectionInitTimeout is how long a client has to send connection_init
+	connectionInitTimeout = 10 * time.Second
+	// legacyKeepAlive is the interval between keep-alive messages on the legacy protocol
+	legacyKeepAlive = 15 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		// Token authentication provides security
+		return true
+	},
+	Subprotocols: []string{protocolTransportWS, protocolLegacyWS},
+}
+
+// wsMessage is a graphql-ws protocol message
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// wsConnection is a single graphql-ws client
+type wsConnection struct {
+	handler       *Handler
+	conn          *websocket.Conn
+	legacy        bool
+	connection    string
+	writeMux      sync.Mutex
+	mutex         sync.Mutex
+	subscriptions map[string]context.CancelFunc
+}
+
+// serveWebSocket upgrades the request and serves graphql-ws messages until the client disconnects
+func (h *Handler) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		slog.Error("Failed to upgrade GraphQL connection", "error", err)
+		return
+	}
+
+	c := &wsConnection{
+		handler:       h,
+		conn:          conn,
+		legacy:        conn.Subprotocol() == protocolLegacyWS,
+		connection:    "graphql:" + r.RemoteAddr,
+		subscriptions: make(map[string]context.CancelFunc),
+	}
+
+	slog.Info("GraphQL WebSocket client connected", "remote", r.RemoteAddr, "protocol", conn.Subprotocol())
+
+	c.serve(r.URL.Query().Get("token"))
+
+	c.stopAll()
+	if err := conn.Close(); err != nil {
+		slog.Debug("Error closing GraphQL connection", "error", err)
+	}
+	slog.Info("GraphQL WebSocket client disconnected", "remote", r.RemoteAddr)
+}
+
+// serve reads messages until the connection closes
+func (c *wsConnection) serve(queryToken string) {
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), connectionKey{}, c.connection))
+	defer cancel()
+
+	if !c.initialize(queryToken) {
+		return
+	}
+
+	if c.legacy {
+		go c.keepAlive(ctx)
+	}
+
+	for {
+		var msg wsMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				slog.Debug("GraphQL WebSocket read error", "error", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case "subscribe", "start":
+			var req Request
+			if err := json.Unmarshal(msg.Payload, &req); err != nil || msg.ID == "" {
+				c.sendError(msg.ID, "Invalid subscribe message")
+				continue
+			}
+			c.start(ctx, msg.ID, req)
+		case "complete", "stop":
+			c.stop(msg.ID)
+		case "ping":
+			c.write(wsMessage{Type: "pong"})
+		case "pong":
+		case "connection_terminate":
+			return
+		case "connection_init":
+			// A second connection_init is a protocol violation
+			c.closeWithCode(4429, "Too many initialisation requests")
+			return
+		default:
+			slog.Debug("GraphQL WebSocket unknown message type", "type", msg.Type)
+		}
+	}
+}
+
+// initialize waits for connection_init and validates the API token, which may
+// be sent in the payload or as a query parameter on the upgrade request
+func (c *wsConnection) initialize(queryToken string) bool {
+	if err := c.conn.SetReadDeadline(time.Now().Add(connectionInitTimeout)); err != nil {
+		return false
+	}
+
+	var msg wsMessage
+	if err := c.conn.ReadJSON(&msg); err != nil {
+		c.closeWithCode(4408, "Connection initialisation timeout")
+		return false
+	}
+	if msg.Type != "connection_init" {
+		c.closeWithCode(4400, "Expected connection_init")
+		return false
+	}
+
+	var payload map[string]any
+	if len(msg.Payload) > 0 {
+		_ = json.Unmarshal(msg.Payload, &payload)
+	}
+
+	token := queryToken
+	for _, key := range []string{"token", "X-API-Token", "x-api-token"} {
+		if value, ok := payload[key].(string); ok && value != "" {
+			token = value
+			break
+		}
+	}
+	if value, ok := payload["Authorization"].(string); ok && token == "" {
+		token = strings.TrimPrefix(value, "Bearer ")
+	}
+
+	if token != c.handler.token {
+		slog.Warn("GraphQL connection rejected: invalid token")
+		if c.legacy {
+			c.write(wsMessage{Type: "connection_error", Payload: mustMarshal(map[string]string{"message": "Invalid API token"})})
+		}
+		c.closeWithCode(4403, "Forbidden")
+		return false
+	}
+
+	if err := c.conn.SetReadDeadline(time.Time{}); err != nil {
+		return false
+	}
+
+	c.write(wsMessage{Type: "connection_ack"})
+	if c.legacy {
+		c.write(wsMessage{Type: "ka"})
+	}
+
+	return true
+}
+
+// start executes an operation, streaming results for subscriptions
+func (c *wsConnection) start(ctx context.Context, id string, req Request) {
+	operation, err := operationType(req)
+	if err != nil {
+		c.sendError(id, err.Error())
+		return
+	}
+
+	c.mutex.Lock()
+	if _, exists := c.subscriptions[id]; exists {
+		c.mutex.Unlock()
+		if !c.legacy {
+			c.closeWithCode(4409, "Subscriber for "+id+" already exists")
+		}
+		return
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	c.subscriptions[id] = cancel
+	c.mutex.Unlock()
+
+	go func() {
+		defer c.finish(id)
+
+		if operation != "subscription" {
+			c.sendResult(id, c.handler.execute(subCtx, req))
+			return
+		}
+
+		results := gql.Subscribe(gql.Params{
+			Schema:         c.handler.schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        subCtx,
+		})
+		for {
+			select {
+			case <-subCtx.Done():
+				// Drain so the executor goroutine can exit
+				go func() {
+					for range results {
+					}
+				}()
+				return
+			case result, ok := <-results:
+				if !ok {
+					return
+				}
+				c.sendResult(id, result)
+			}
+		}
+	}()
+}
+
+// finish removes an operation and tells the client it has completed, unless
+// the client stopped it
+func (c *wsConnection) finish(id string) {
+	c.mutex.Lock()
+	cancel, exists := c.subscriptions[id]
+	delete(c.subscriptions, id)
+	c.mutex.Unlock()
+
+	if exists {
+		cancel()
+		c.write(wsMessage{ID: id, Type: "complete"})
+	}
+}
+
+// stop cancels an operation at the client's request
+func (c *wsConnection) stop(id string) {
+	c.mutex.Lock()
+	cancel, exists := c.subscriptions[id]
+	delete(c.subscriptions, id)
+	c.mutex.Unlock()
+
+	if exists {
+		cancel()
+	}
+}
+
+// stopAll cancels all running operations
+func (c *wsConnection) stopAll() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for id, cancel := range c.subscriptions {
+		cancel()
+		delete(c.subscriptions, id)
+	}
+}
+
+func (c *wsConnection) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(legacyKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.write(wsMessage{Type: "ka"})
+		}
+	}
+}
+
+func (c *wsConnection) sendResult(id string, result *gql.Result) {
+	messageType := "next"
+	if c.legacy {
+		messageType = "data"
+	}
+	c.write(wsMessage{ID: id, Type: messageType, Payload: mustMarshal(result)})
+}
+
+func (c *wsConnection) sendError(id string, message string) {
+	payload := []map[string]string{{"message": message}}
+	if c.legacy {
+		c.write(wsMessage{ID: id, Type: "error", Payload: mustMarshal(map[string]string{"message": message})})
+		return
+	}
+	c.write(wsMessage{ID: id, Type: "error", Payload: mustMarshal(payload)})
+}
+
+func (c *wsConnection) write(msg wsMessage) {
+	c.writeMux.Lock()
+	defer c.writeMux.Unlock()
+	if err := c.conn.WriteJSON(msg); err != nil {
+		slog.Debug("Failed to write GraphQL WebSocket message", "type", msg.Type, "error", err)
+	}
+}
+
+func (c *wsConnection) closeWithCode(code int, reason string) {
+	c.writeMux.Lock()
+	defer c.writeMux.Unlock()
+	message := websocket.FormatCloseMessage(code, reason)
+	if err := c.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		slog.Debug("Failed to close GraphQL WebSocket", "error", err)
+	}
+}
+
+func mustMarshal(value any) json.RawMessage {
+	b, err := json.Marshal(value)
+	if err != nil {
+		slog.Error("Failed to encode GraphQL WebSocket payload", "error", err)
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/timmo001/system-bridge/artwork"
+)
+
+// ServeArtworkHandler serves cached media artwork, resized to fit the size
+// query parameter (GET /api/media/artwork/{hash}?size=). The hash is only
+// known from module data, which needs the API token, so browsers can load
+// the artwork without one.
+func ServeArtworkHandler(w http.ResponseWriter, r *http.Request) {
+	cache := artwork.GetInstance()
+	if cache == nil {
+		writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Artwork cache not available"})
+		return
+	}
+
+	size := 0
+	if value := r.URL.Query().Get("size"); value != "" {
+		var err error
+		if size, err = strconv.Atoi(value); err != nil || size < 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "Invalid size"})
+			return
+		}
+	}
+
+	hash := r.PathValue("hash")
+	art, err := cache.Get(r.Context(), hash, size)
+	if err != nil {
+		if errors.Is(err, artwork.ErrNotFound) {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "Artwork not found"})
+			return
+		}
+		slog.Warn("Failed to get artwork", "hash", hash, "size", size, "error", err)
+		writeJSON(w, http.StatusBadGateway, map[string]string{"error": "Failed to get artwork"})
+		return
+	}
+
+	f, err := os.Open(art.Path)
+	if err != nil {
+		slog.Error("Failed to open cached artwork", "path", art.Path, "error", err)
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		return
+	}
+	defer func() { _ = f.Close() }()
+
+	w.Header().Set("Content-Type", art.ContentType)
+	w.Header().Set("ETag", art.ETag)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	// ServeContent answers If-None-Match with 304 Not Modified
+	http.ServeContent(w, r, "", art.ModTime, f)
+}
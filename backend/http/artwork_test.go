@@ -0,0 +1,53 @@
+package http
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/artwork"
+)
+
+func TestServeArtworkHandler(t *testing.T) {
+	var cover bytes.Buffer
+	require.NoError(t, png.Encode(&cover, image.NewGray(image.Rect(0, 0, 300, 300))))
+	path := filepath.Join(t.TempDir(), "cover.png")
+	require.NoError(t, os.WriteFile(path, cover.Bytes(), 0o644))
+
+	cache := artwork.NewCache(t.TempDir())
+	artwork.SetInstance(cache)
+	t.Cleanup(func() { artwork.SetInstance(nil) })
+	url, ok := cache.URL("file://" + path)
+	require.True(t, ok)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+artwork.URLPrefix+"{hash}", ServeArtworkHandler)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url+"?size=128", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "image/jpeg", rec.Header().Get("Content-Type"))
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, url+"?size=128", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url+"?size=big", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, artwork.URLPrefix+"0123456789abcdef0123456789abcdef", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/backend/clients"
+)
+
+// GetClientsHandler handles requests to list connected WebSocket and MCP clients
+func GetClientsHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			if err := json.NewEncoder(w).Encode(map[string]string{"error": "Method not allowed"}); err != nil {
+				slog.Error("Failed to encode response", "error", err)
+			}
+			return
+		}
+
+		// Check for API token in both X-API-Token and token headers
+		requestToken := r.Header.Get("X-API-Token")
+		if requestToken == "" {
+			requestToken = r.Header.Get("token")
+		}
+		if requestToken != token {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			if err := json.NewEncoder(w).Encode(map[string]string{"error": "Invalid API token"}); err != nil {
+				slog.Error("Failed to encode response", "error", err)
+			}
+			return
+		}
+
+		slog.Info("GET: /api/clients")
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(clients.List()); err != nil {
+			slog.Error("Error encoding response", "error", err)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+// maxCommandRequestSize limits the size of a command execute request body
+const maxCommandRequestSize = 1 << 20
+
+// CommandExecuteRequest is the body of a command execute request
+type CommandExecuteRequest struct {
+	// Params are the values of the command's declared parameters
+	Params map[string]any `json:"params,omitempty"`
+	// Async starts the command and returns its job straight away, instead of
+	// waiting for it to finish
+	Async bool `json:"async,omitempty"`
+	// TimeoutSeconds stops a synchronous execution early. The command's own
+	// timeout still applies.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty"`
+}
+
+// ExecuteCommandHandler handles requests to run an allowlisted command
+// (POST /api/commands/{id}/execute)
+func ExecuteCommandHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		commandID := r.PathValue("id")
+		slog.Info("POST: /api/commands/:id/execute", "commandID", commandID)
+
+		var body CommandExecuteRequest
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxCommandRequestSize)).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "Invalid request body"})
+			return
+		}
+		if body.TimeoutSeconds < 0 {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "timeoutSeconds cannot be negative"})
+			return
+		}
+
+		cfg, err := settings.Load()
+		if err != nil {
+			slog.Error("Failed to load settings", "error", err)
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "Failed to load settings"})
+			return
+		}
+
+		req := command.ExecuteRequest{
+			CommandID:  commandID,
+			Params:     body.Params,
+			Connection: "http:" + r.RemoteAddr,
+		}
+
+		if body.Async {
+			jobID, err := command.Execute(req, cfg, nil)
+			if err != nil {
+				writeCommandError(w, err)
+				return
+			}
+			job, err := command.GetJob(jobID)
+			if err != nil {
+				writeCommandError(w, err)
+				return
+			}
+			writeJSON(w, http.StatusAccepted, job)
+			return
+		}
+
+		ctx := r.Context()
+		if body.TimeoutSeconds > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(body.TimeoutSeconds)*time.Second)
+			defer cancel()
+		}
+
+		result, err := command.ExecuteSync(ctx, req, cfg)
+		if err != nil {
+			writeCommandError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// GetCommandJobHandler handles requests to get a command job, for polling
+// asynchronous executions (GET /api/commands/jobs/{jobID})
+func GetCommandJobHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		jobID := r.PathValue("jobID")
+		slog.Info("GET: /api/commands/jobs/:jobID", "jobID", jobID)
+
+		job, err := command.GetJob(jobID)
+		if err != nil {
+			writeCommandError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, job)
+	}
+}
+
+// writeCommandError writes the response for a command error
+func writeCommandError(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	switch {
+	case errors.Is(err, command.ErrCommandNotFound), errors.Is(err, command.ErrJobNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, command.ErrConcurrencyLimit):
+		status = http.StatusTooManyRequests
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+func newTestCommandServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+
+	cfg, err := settings.Load()
+	require.NoError(t, err)
+	cfg.Commands.Allowlist = []settings.SettingsCommandDefinition{
+		{
+			ID:        "greet",
+			Name:      "Greet",
+			Command:   "/bin/echo",
+			Arguments: []string{"hello", "{{name}}"},
+			Parameters: []settings.SettingsCommandParameter{
+				{Name: "name", Type: settings.CommandParameterTypeString, Default: "world"},
+			},
+		},
+	}
+	require.NoError(t, cfg.Save())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/commands/{id}/execute", ExecuteCommandHandler("test-token"))
+	mux.HandleFunc("GET /api/commands/jobs/{jobID}", GetCommandJobHandler("test-token"))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestExecuteCommandHandler(t *testing.T) {
+	server := newTestCommandServer(t)
+
+	t.Run("Runs synchronously", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodPost, server.URL+"/api/commands/greet/execute", `{"params":{"name":"there"}}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result command.ExecuteResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "hello there\n", result.Stdout)
+		assert.NotEmpty(t, result.JobID)
+	})
+
+	t.Run("Runs with no body", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodPost, server.URL+"/api/commands/greet/execute", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result command.ExecuteResult
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, "hello world\n", result.Stdout)
+	})
+
+	t.Run("Runs asynchronously", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodPost, server.URL+"/api/commands/greet/execute", `{"async":true}`)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+		var job command.Job
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&job))
+		require.NotEmpty(t, job.ID)
+
+		require.Eventually(t, func() bool {
+			resp := apiRequest(t, http.MethodGet, server.URL+"/api/commands/jobs/"+job.ID, "")
+			if resp.StatusCode != http.StatusOK {
+				return false
+			}
+			var polled command.Job
+			return json.NewDecoder(resp.Body).Decode(&polled) == nil && polled.Status == command.JobStatusCompleted
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("Unknown command", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodPost, server.URL+"/api/commands/missing/execute", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Invalid parameter", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodPost, server.URL+"/api/commands/greet/execute", `{"params":{"other":"x"}}`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Unknown job", func(t *testing.T) {
+		resp := apiRequest(t, http.MethodGet, server.URL+"/api/commands/jobs/missing", "")
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		resp, err := http.Post(server.URL+"/api/commands/greet/execute", "application/json", nil)
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -0,0 +1,304 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+)
+
+const (
+	// eventStreamHistorySize is the number of past events kept for Last-Event-ID replay
+	eventStreamHistorySize = 256
+	// eventStreamClientBuffer is the number of events buffered per client before dropping
+	eventStreamClientBuffer = 64
+	// eventStreamKeepAlive is the interval between keep-alive comments
+	eventStreamKeepAlive = 15 * time.Second
+	// eventStreamRetry is the reconnection delay suggested to clients
+	eventStreamRetry = 5 * time.Second
+)
+
+// streamEvent is a single module data update with its stream sequence ID
+type streamEvent struct {
+	ID     uint64
+	Module types.ModuleName
+	Data   []byte
+}
+
+// eventStreamClient is a connected Server-Sent Events consumer
+type eventStreamClient struct {
+	modules []types.ModuleName
+	events  chan streamEvent
+}
+
+// EventStream serves module data updates as Server-Sent Events
+type EventStream struct {
+	token     string
+	dataStore *data.DataStore
+	mutex     sync.RWMutex
+	clients   map[*eventStreamClient]struct{}
+	history   []streamEvent
+	lastID    uint64
+}
+
+// NewEventStream creates a new EventStream and subscribes it to module data updates
+func NewEventStream(token string, dataStore *data.DataStore) *EventStream {
+	es := &EventStream{
+		token:     token,
+		dataStore: dataStore,
+		clients:   make(map[*eventStreamClient]struct{}),
+		history:   make([]streamEvent, 0, eventStreamHistorySize),
+	}
+
+	bus.GetInstance().Subscribe(bus.EventDataModuleUpdate, "sse", es.handleDataModuleUpdate)
+
+	return es
+}
+
+// ServeHTTP handles GET /api/events
+func (es *EventStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Method not allowed"}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return
+	}
+
+	// Check for API token in headers, falling back to the query string for
+	// clients such as EventSource that cannot set headers
+	token := r.Header.Get("X-API-Token")
+	if token == "" {
+		token = r.Header.Get("token")
+	}
+	if token == "" {
+		token = r.URL.Query().Get("token")
+	}
+	if token != es.token {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Invalid API token"}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return
+	}
+
+	modules, err := es.parseModules(r.URL.Query().Get("modules"))
+	if err != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": err.Error()}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return
+	}
+
+	// Last-Event-ID is sent by EventSource on reconnect; also accept it as a query parameter
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("lastEventId")
+	}
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	client := &eventStreamClient{
+		modules: modules,
+		events:  make(chan streamEvent, eventStreamClientBuffer),
+	}
+
+	// Register before building the backlog so no update is missed in between
+	backlog := es.addClient(client, lastEventID)
+	defer es.removeClient(client)
+
+	slog.Info("GET: /api/events", "remote", r.RemoteAddr, "modules", modules, "lastEventID", lastEventID)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", eventStreamRetry.Milliseconds()); err != nil {
+		return
+	}
+	for _, e := range backlog {
+		if err := writeStreamEvent(w, e); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		slog.Error("Failed to flush event stream", "error", err)
+		return
+	}
+
+	keepAlive := time.NewTicker(eventStreamKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			slog.Debug("SSE client disconnected", "remote", r.RemoteAddr)
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case e := <-client.events:
+			if err := writeStreamEvent(w, e); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// parseModules parses a comma separated module list, defaulting to all registered modules
+func (es *EventStream) parseModules(raw string) ([]types.ModuleName, error) {
+	registered := make([]types.ModuleName, 0)
+	for _, u := range es.dataStore.GetRegisteredModules() {
+		registered = append(registered, u.Name())
+	}
+
+	if strings.TrimSpace(raw) == "" {
+		return registered, nil
+	}
+
+	modules := make([]types.ModuleName, 0)
+	for name := range strings.SplitSeq(raw, ",") {
+		module := types.ModuleName(strings.TrimSpace(name))
+		if module == "" {
+			continue
+		}
+		if !slices.Contains(registered, module) {
+			return nil, fmt.Errorf("unknown module: %s", module)
+		}
+		if !slices.Contains(modules, module) {
+			modules = append(modules, module)
+		}
+	}
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("no modules requested")
+	}
+
+	return modules, nil
+}
+
+// addClient registers a client and returns the events it should receive first.
+// When lastEventID is still within the history, only the missed events are
+// replayed. Otherwise the current data for each module is sent as a snapshot.
+func (es *EventStream) addClient(client *eventStreamClient, lastEventID string) []streamEvent {
+	es.mutex.Lock()
+	es.clients[client] = struct{}{}
+
+	if id, err := strconv.ParseUint(lastEventID, 10, 64); err == nil && id <= es.lastID {
+		if id == es.lastID || (len(es.history) > 0 && es.history[0].ID <= id+1) {
+			backlog := make([]streamEvent, 0)
+			for _, e := range es.history {
+				if e.ID > id && slices.Contains(client.modules, e.Module) {
+					backlog = append(backlog, e)
+				}
+			}
+			es.mutex.Unlock()
+			return backlog
+		}
+	}
+
+	snapshotID := es.lastID
+	es.mutex.Unlock()
+
+	backlog := make([]streamEvent, 0, len(client.modules))
+	for _, name := range client.modules {
+		module, err := es.dataStore.GetModule(name)
+		if err != nil {
+			slog.Warn("SSE: Data module not registered", "module", name)
+			continue
+		}
+		payload, err := encodeStreamPayload(module)
+		if err != nil {
+			slog.Error("SSE: Failed to encode module data", "module", name, "error", err)
+			continue
+		}
+		backlog = append(backlog, streamEvent{ID: snapshotID, Module: name, Data: payload})
+	}
+
+	return backlog
+}
+
+// removeClient unregisters a client
+func (es *EventStream) removeClient(client *eventStreamClient) {
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+	delete(es.clients, client)
+}
+
+// handleDataModuleUpdate records module updates and fans them out to clients
+func (es *EventStream) handleDataModuleUpdate(e bus.Event) {
+	if e.Type != bus.EventDataModuleUpdate {
+		return
+	}
+
+	var module types.Module
+	if err := mapstructure.Decode(e.Data, &module); err != nil {
+		slog.Error("Failed to decode module data", "error", err)
+		return
+	}
+
+	payload, err := encodeStreamPayload(module)
+	if err != nil {
+		slog.Error("SSE: Failed to encode module data", "module", module.Name, "error", err)
+		return
+	}
+
+	es.mutex.Lock()
+	defer es.mutex.Unlock()
+
+	es.lastID++
+	streamed := streamEvent{ID: es.lastID, Module: module.Name, Data: payload}
+
+	if len(es.history) >= eventStreamHistorySize {
+		es.history = slices.Delete(es.history, 0, 1)
+	}
+	es.history = append(es.history, streamed)
+
+	for client := range es.clients {
+		if !slices.Contains(client.modules, module.Name) {
+			continue
+		}
+		select {
+		case client.events <- streamed:
+		default:
+			slog.Warn("SSE: Client buffer full, dropping update", "module", module.Name, "id", streamed.ID)
+		}
+	}
+}
+
+// encodeStreamPayload encodes a module in the same envelope used for WebSocket DATA_UPDATE messages
+func encodeStreamPayload(module types.Module) ([]byte, error) {
+	return json.Marshal(event.MessageResponse{
+		ID:      "system",
+		Type:    event.ResponseTypeDataUpdate,
+		Subtype: event.ResponseSubtypeNone,
+		Data:    module.Data,
+		Module:  module.Name,
+	})
+}
+
+// writeStreamEvent writes a single event in text/event-stream format
+func writeStreamEvent(w http.ResponseWriter, e streamEvent) error {
+	_, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, event.ResponseTypeDataUpdate, e.Data)
+	return err
+}
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func newTestEventStream(t *testing.T) *EventStream {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	es := NewEventStream("test-token", dataStore)
+	t.Cleanup(func() {
+		bus.GetInstance().Unsubscribe(bus.EventDataModuleUpdate, "sse")
+	})
+	return es
+}
+
+func publishTestUpdate(es *EventStream, module types.ModuleName, value any) {
+	es.handleDataModuleUpdate(bus.Event{
+		Type: bus.EventDataModuleUpdate,
+		Data: types.Module{Name: module, Data: value},
+	})
+}
+
+func TestEventStreamAuthentication(t *testing.T) {
+	es := newTestEventStream(t)
+
+	t.Run("Missing token", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		es.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/events", nil))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("Unknown module", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		es.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/events?token=test-token&modules=nope", nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Wrong method", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		es.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/events?token=test-token", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestEventStreamReplay(t *testing.T) {
+	es := newTestEventStream(t)
+
+	publishTestUpdate(es, types.ModuleCPU, map[string]any{"usage": 1})
+	publishTestUpdate(es, types.ModuleMemory, map[string]any{"percent": 2})
+	publishTestUpdate(es, types.ModuleCPU, map[string]any{"usage": 3})
+
+	t.Run("Replays missed events for subscribed modules", func(t *testing.T) {
+		client := &eventStreamClient{modules: []types.ModuleName{types.ModuleCPU}, events: make(chan streamEvent, 1)}
+		backlog := es.addClient(client, "1")
+		defer es.removeClient(client)
+
+		require.Len(t, backlog, 1)
+		assert.Equal(t, uint64(3), backlog[0].ID)
+		assert.Contains(t, string(backlog[0].Data), `"usage":3`)
+	})
+
+	t.Run("Up to date client receives nothing", func(t *testing.T) {
+		client := &eventStreamClient{modules: []types.ModuleName{types.ModuleCPU}, events: make(chan streamEvent, 1)}
+		backlog := es.addClient(client, "3")
+		defer es.removeClient(client)
+
+		assert.Empty(t, backlog)
+	})
+
+	t.Run("Unknown ID falls back to a snapshot", func(t *testing.T) {
+		client := &eventStreamClient{modules: []types.ModuleName{types.ModuleCPU, types.ModuleMemory}, events: make(chan streamEvent, 1)}
+		backlog := es.addClient(client, "999")
+		defer es.removeClient(client)
+
+		require.Len(t, backlog, 2)
+		for _, e := range backlog {
+			assert.Equal(t, uint64(3), e.ID)
+		}
+	})
+}
+
+func TestEventStreamDelivery(t *testing.T) {
+	es := newTestEventStream(t)
+	server := httptest.NewServer(es)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"?modules=cpu", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-API-Token", "test-token")
+	req.Header.Set("Last-Event-ID", "0")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(line, "retry:"))
+
+	// Wait for the client to be registered before publishing
+	require.Eventually(t, func() bool {
+		es.mutex.RLock()
+		defer es.mutex.RUnlock()
+		return len(es.clients) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	publishTestUpdate(es, types.ModuleMemory, map[string]any{"percent": 50})
+	publishTestUpdate(es, types.ModuleCPU, map[string]any{"usage": 42})
+
+	lines := make([]string, 0)
+	for len(lines) < 3 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	assert.Equal(t, "id: 2", lines[0])
+	assert.Equal(t, "event: DATA_UPDATE", lines[1])
+	assert.Contains(t, lines[2], `"module":"cpu"`)
+	assert.Contains(t, lines[2], `"usage":42`)
+}
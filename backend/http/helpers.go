@@ -0,0 +1,31 @@
+package http
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// requestAuthorized checks the API token of a request, writing an error
+// response if it is invalid
+func requestAuthorized(w http.ResponseWriter, r *http.Request, token string) bool {
+	// Check for API token in both X-API-Token and token headers
+	requestToken := r.Header.Get("X-API-Token")
+	if requestToken == "" {
+		requestToken = r.Header.Get("token")
+	}
+	if requestToken != token {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "Invalid API token"})
+		return false
+	}
+	return true
+}
+
+// writeJSON writes a JSON response
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("Failed to encode response", "error", err)
+	}
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/timmo001/system-bridge/macro"
+)
+
+// RunMacroHandler handles requests to run a macro and waits for it to finish
+// (POST /api/macros/{id}/run)
+func RunMacroHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		macroID := r.PathValue("id")
+		slog.Info("POST: /api/macros/:id/run", "macroID", macroID)
+
+		runner := macro.GetInstance()
+		if runner == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Macro runner not available"})
+			return
+		}
+
+		result, err := runner.Run(r.Context(), macroID)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, macro.ErrMacroNotFound) {
+				status = http.StatusNotFound
+			}
+			writeJSON(w, status, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	}
+}
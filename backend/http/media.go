@@ -2,22 +2,30 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/utils"
 	"github.com/timmo001/system-bridge/utils/handlers/filesystem"
+	"github.com/timmo001/system-bridge/utils/mediaurl"
 )
 
-// ServeMediaFileDataHandler handles requests to serve media files from predefined base directories
+// ServeMediaFileDataHandler handles requests to serve media files from predefined base directories.
+// Requests are authorized by a signed URL from GET_MEDIA_FILE_URL, or the API token in the
+// X-API-Token header. The token query parameter is still accepted, but is deprecated as it leaks
+// into browser history and proxy logs.
 func ServeMediaFileDataHandler(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusMethodNotAllowed)
 		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Method not allowed"}); err != nil {
@@ -26,37 +34,19 @@ func ServeMediaFileDataHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get query parameters
-	base := r.URL.Query().Get("base")
-	path := r.URL.Query().Get("path")
-	token := r.URL.Query().Get("token")
-
-	// Validate required parameters
-	if base == "" || path == "" || token == "" {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusBadRequest)
-		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Missing required parameters: base, path, token"}); err != nil {
-			slog.Error("Failed to encode response", "error", err)
-		}
+	query := r.URL.Query()
+	file, ok := authorizeMediaFileRequest(w, r, query)
+	if !ok {
 		return
 	}
+	base := file.Base
+	path := file.Path
 
-	// Validate API token
-	expectedToken, err := utils.LoadToken()
-	if err != nil {
-		slog.Error("Failed to load token for authentication", "error", err)
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusInternalServerError)
-		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Authentication error"}); err != nil {
-			slog.Error("Failed to encode response", "error", err)
-		}
-		return
-	}
-
-	if token != expectedToken {
+	// Validate required parameters
+	if base == "" || path == "" {
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusUnauthorized)
-		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Invalid API token"}); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Missing required parameters: base, path"}); err != nil {
 			slog.Error("Failed to encode response", "error", err)
 		}
 		return
@@ -141,27 +131,93 @@ func ServeMediaFileDataHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Size limit (100MB)
-	const maxFileSize = 100 * 1024 * 1024 // 100MB
-	if fileInfo.Size() > maxFileSize {
-		slog.Error("File too large", "size", fileInfo.Size(), "max_size", maxFileSize)
+	f, err := os.Open(cleanPath)
+	if err != nil {
+		slog.Error("Failed to open file", "path", cleanPath, "error", err)
 		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusRequestEntityTooLarge)
-		if err := json.NewEncoder(w).Encode(map[string]string{"error": "File too large"}); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Internal server error"}); err != nil {
 			slog.Error("Failed to encode response", "error", err)
 		}
 		return
 	}
+	defer func() { _ = f.Close() }()
 
 	// Set appropriate headers
-	contentType := getContentType(cleanPath)
-	w.Header().Set("Content-Type", contentType)
-	w.Header().Set("Content-Length", fmt.Sprintf("%d", fileInfo.Size()))
-	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"%s\"", filepath.Base(cleanPath)))
-
-	// Serve the file
-	slog.Info("Serving media file", "path", cleanPath, "size", fileInfo.Size())
-	http.ServeFile(w, r, cleanPath)
+	disposition := "inline"
+	if file.Download {
+		disposition = "attachment"
+	}
+	w.Header().Set("Content-Type", getContentType(cleanPath))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": filepath.Base(cleanPath)}))
+	w.Header().Set("ETag", fileETag(fileInfo))
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	// Serve the file. ServeContent handles Range, If-Range, If-None-Match and
+	// If-Modified-Since, and sets Content-Length and Last-Modified.
+	slog.Info("Serving media file", "path", cleanPath, "size", fileInfo.Size(), "range", r.Header.Get("Range"))
+	http.ServeContent(w, r, cleanPath, fileInfo.ModTime(), f)
+}
+
+// authorizeMediaFileRequest checks a media file request is signed or has the
+// API token, returning the file it asks for
+func authorizeMediaFileRequest(w http.ResponseWriter, r *http.Request, query url.Values) (mediaurl.File, bool) {
+	expectedToken, err := utils.LoadToken()
+	if err != nil {
+		slog.Error("Failed to load token for authentication", "error", err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Authentication error"}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return mediaurl.File{}, false
+	}
+
+	if mediaurl.IsSigned(query) {
+		file, err := mediaurl.Verify(expectedToken, query, time.Now())
+		if err != nil {
+			slog.Info("Rejected media file URL", "error", err)
+			message := "Invalid signature"
+			if errors.Is(err, mediaurl.ErrExpired) {
+				message = "URL has expired"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+				slog.Error("Failed to encode response", "error", err)
+			}
+			return mediaurl.File{}, false
+		}
+		return file, true
+	}
+
+	token := r.Header.Get("X-API-Token")
+	if token == "" && query.Get("token") != "" {
+		slog.Warn("Media file requested with the API token in the URL, which is deprecated. Use GET_MEDIA_FILE_URL for a signed URL.")
+		token = query.Get("token")
+	}
+	if token == "" {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Missing signature or API token"}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return mediaurl.File{}, false
+	}
+	if token != expectedToken {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		if err := json.NewEncoder(w).Encode(map[string]string{"error": "Invalid API token"}); err != nil {
+			slog.Error("Failed to encode response", "error", err)
+		}
+		return mediaurl.File{}, false
+	}
+	return mediaurl.FileFromQuery(query), true
+}
+
+// fileETag returns an entity tag for a file from its size and modification time
+func fileETag(info os.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size())
 }
 
 // getBaseDirectoryPath returns the absolute path for a base directory key
@@ -281,6 +337,9 @@ func getContentType(filename string) string {
 	case ".xlsx":
 		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
 	default:
+		if contentType := mime.TypeByExtension(ext); contentType != "" {
+			return contentType
+		}
 		return "application/octet-stream"
 	}
 }
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/timmo001/system-bridge/medialibrary"
+)
+
+// SearchMediaLibraryHandler handles requests to search the media library
+// (GET /api/media/library?query=&type=&base=&artist=&album=&limit=&offset=)
+func SearchMediaLibraryHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		params := r.URL.Query()
+		slog.Debug("GET: /api/media/library", "query", params)
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Media library not available"})
+			return
+		}
+
+		query := medialibrary.Query{
+			Query:  params.Get("query"),
+			Type:   medialibrary.ItemType(params.Get("type")),
+			Base:   params.Get("base"),
+			Artist: params.Get("artist"),
+			Album:  params.Get("album"),
+		}
+		for name, value := range map[string]*int{"limit": &query.Limit, "offset": &query.Offset} {
+			if params.Get(name) == "" {
+				continue
+			}
+			n, err := strconv.Atoi(params.Get(name))
+			if err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]string{"error": "Invalid " + name})
+				return
+			}
+			*value = n
+		}
+
+		result, err := library.Search(query)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, result)
+	}
+}
+
+// GetMediaLibraryArtistsHandler handles requests for the artists in the media
+// library (GET /api/media/library/artists)
+func GetMediaLibraryArtistsHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Media library not available"})
+			return
+		}
+		writeJSON(w, http.StatusOK, library.Artists())
+	}
+}
+
+// GetMediaLibraryAlbumsHandler handles requests for the albums in the media
+// library, optionally of an artist (GET /api/media/library/albums?artist=)
+func GetMediaLibraryAlbumsHandler(token string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !requestAuthorized(w, r, token) {
+			return
+		}
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Media library not available"})
+			return
+		}
+		writeJSON(w, http.StatusOK, library.Albums(r.URL.Query().Get("artist")))
+	}
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/medialibrary"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// newTestMediaLibraryServer indexes a media directory holding one picture
+func newTestMediaLibraryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 4, 3))))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "holiday.png"), buf.Bytes(), 0o644))
+
+	cfg, err := settings.Load()
+	require.NoError(t, err)
+	cfg.Media.Directories = []settings.SettingsMediaDirectory{{Name: "pictures", Path: dir}}
+	require.NoError(t, cfg.Save())
+
+	library := medialibrary.NewLibrary(filepath.Join(t.TempDir(), "media-library.json"))
+	medialibrary.SetInstance(library)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		library.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		medialibrary.SetInstance(nil)
+	})
+	require.Eventually(t, func() bool {
+		result, err := library.Search(medialibrary.Query{})
+		return err == nil && result.Total == 1
+	}, 5*time.Second, 20*time.Millisecond)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/media/library", SearchMediaLibraryHandler("test-token"))
+	mux.HandleFunc("GET /api/media/library/artists", GetMediaLibraryArtistsHandler("test-token"))
+	mux.HandleFunc("GET /api/media/library/albums", GetMediaLibraryAlbumsHandler("test-token"))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSearchMediaLibrary(t *testing.T) {
+	server := newTestMediaLibraryServer(t)
+
+	resp := apiRequest(t, http.MethodGet, server.URL+"/api/media/library?query=holiday&type=image", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result medialibrary.SearchResult
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, 1, result.Total)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "pictures", result.Items[0].Base)
+	assert.Equal(t, "holiday.png", result.Items[0].Path)
+	assert.Equal(t, 4, result.Items[0].Width)
+
+	resp = apiRequest(t, http.MethodGet, server.URL+"/api/media/library?type=audio", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Zero(t, result.Total)
+	assert.Empty(t, result.Items)
+
+	resp = apiRequest(t, http.MethodGet, server.URL+"/api/media/library?type=podcast", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp = apiRequest(t, http.MethodGet, server.URL+"/api/media/library?limit=ten", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/media/library", nil)
+	require.NoError(t, err)
+	unauthorized, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	_ = unauthorized.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, unauthorized.StatusCode)
+}
+
+func TestBrowseMediaLibrary(t *testing.T) {
+	server := newTestMediaLibraryServer(t)
+
+	resp := apiRequest(t, http.MethodGet, server.URL+"/api/media/library/artists", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var artists []medialibrary.Artist
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&artists))
+	assert.NotNil(t, artists)
+	assert.Empty(t, artists)
+
+	resp = apiRequest(t, http.MethodGet, server.URL+"/api/media/library/albums?artist=Band", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var albums []medialibrary.Album
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&albums))
+	assert.Empty(t, albums)
+}
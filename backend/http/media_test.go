@@ -0,0 +1,149 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils"
+	"github.com/timmo001/system-bridge/utils/mediaurl"
+)
+
+// newTestMediaServer serves a media directory named testmedia holding
+// song.mp3, whose content is returned
+func newTestMediaServer(t *testing.T) (*httptest.Server, []byte) {
+	t.Helper()
+
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+	require.NoError(t, utils.SaveToken("test-token"))
+
+	dir := t.TempDir()
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "song.mp3"), content, 0o644))
+
+	cfg, err := settings.Load()
+	require.NoError(t, err)
+	cfg.Media.Directories = []settings.SettingsMediaDirectory{{Name: "testmedia", Path: dir}}
+	require.NoError(t, cfg.Save())
+
+	server := httptest.NewServer(http.HandlerFunc(ServeMediaFileDataHandler))
+	t.Cleanup(server.Close)
+	return server, content
+}
+
+func mediaRequest(t *testing.T, url string, header http.Header) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+	return resp
+}
+
+func TestServeMediaFileSigned(t *testing.T) {
+	server, content := newTestMediaServer(t)
+	file := mediaurl.File{Base: "testmedia", Path: "song.mp3"}
+
+	resp := mediaRequest(t, server.URL+mediaurl.Sign("test-token", file, time.Now().Add(time.Hour)), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, body)
+	assert.Equal(t, "audio/mpeg", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "bytes", resp.Header.Get("Accept-Ranges"))
+	assert.Equal(t, `inline; filename=song.mp3`, resp.Header.Get("Content-Disposition"))
+	assert.NotEmpty(t, resp.Header.Get("Last-Modified"))
+	etag := resp.Header.Get("ETag")
+	require.NotEmpty(t, etag)
+
+	// A matching ETag is not modified
+	resp = mediaRequest(t, server.URL+mediaurl.Sign("test-token", file, time.Now().Add(time.Hour)), http.Header{
+		"If-None-Match": {etag},
+	})
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// Downloads are attachments
+	download := file
+	download.Download = true
+	resp = mediaRequest(t, server.URL+mediaurl.Sign("test-token", download, time.Now().Add(time.Hour)), nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `attachment; filename=song.mp3`, resp.Header.Get("Content-Disposition"))
+}
+
+func TestServeMediaFileRange(t *testing.T) {
+	server, content := newTestMediaServer(t)
+	url := server.URL + mediaurl.Sign("test-token", mediaurl.File{Base: "testmedia", Path: "song.mp3"}, time.Now().Add(time.Hour))
+
+	resp := mediaRequest(t, url, http.Header{"Range": {"bytes=10-19"}})
+	require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content[10:20], body)
+	assert.Equal(t, "bytes 10-19/36", resp.Header.Get("Content-Range"))
+
+	// A stale If-Range gets the whole file
+	resp = mediaRequest(t, url, http.Header{"Range": {"bytes=10-19"}, "If-Range": {`"stale"`}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, content, body)
+
+	resp = mediaRequest(t, url, http.Header{"Range": {"bytes=100-"}})
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.StatusCode)
+}
+
+func TestServeMediaFileAuthorization(t *testing.T) {
+	server, _ := newTestMediaServer(t)
+	file := mediaurl.File{Base: "testmedia", Path: "song.mp3"}
+
+	t.Run("expired", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Sign("test-token", file, time.Now().Add(-time.Minute)), nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("signed with another token", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Sign("other-token", file, time.Now().Add(time.Hour)), nil)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("header token", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Path+"?base=testmedia&path=song.mp3", http.Header{
+			"X-Api-Token": {"test-token"},
+		})
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("query token", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Path+"?base=testmedia&path=song.mp3&token=test-token", nil)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Path+"?base=testmedia&path=song.mp3", http.Header{
+			"X-Api-Token": {"wrong"},
+		})
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("no token", func(t *testing.T) {
+		resp := mediaRequest(t, server.URL+mediaurl.Path+"?base=testmedia&path=song.mp3", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
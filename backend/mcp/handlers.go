@@ -9,22 +9,71 @@ import (
 	"log/slog"
 
 	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
 )
 
+// toolEvents maps tools onto the router events they dispatch
+var toolEvents = map[string]event.EventType{
+	ToolSendNotification:   event.EventNotification,
+	ToolMediaControl:       event.EventMediaControl,
+	ToolOpen:               event.EventOpen,
+	ToolKeyboardKeypress:   event.EventKeyboardKeypress,
+	ToolKeyboardText:       event.EventKeyboardText,
+	ToolKeyboardSequence:   event.EventKeyboardSequence,
+	ToolGetKeyboardKeys:    event.EventGetKeyboardKeys,
+	ToolMouseMove:          event.EventMouseMove,
+	ToolMouseClick:         event.EventMouseClick,
+	ToolMouseScroll:        event.EventMouseScroll,
+	ToolMouseDrag:          event.EventMouseDrag,
+	ToolGetMousePosition:   event.EventGetMousePosition,
+	ToolGetDirectories:     event.EventGetDirectories,
+	ToolGetDirectory:       event.EventGetDirectory,
+	ToolGetFiles:           event.EventGetFiles,
+	ToolGetFile:            event.EventGetFile,
+	ToolMediaLibrarySearch: event.EventMediaLibrarySearch,
+	ToolValidateDirectory:  event.EventValidateDirectory,
+	ToolGetSettings:        event.EventGetSettings,
+	ToolUpdateSettings:     event.EventUpdateSettings,
+	ToolPowerHibernate:     event.EventPowerHibernate,
+	ToolPowerLock:          event.EventPowerLock,
+	ToolPowerLogout:        event.EventPowerLogout,
+	ToolPowerRestart:       event.EventPowerRestart,
+	ToolPowerShutdown:      event.EventPowerShutdown,
+	ToolPowerSleep:         event.EventPowerSleep,
+	ToolExitApplication:    event.EventExitApplication,
+	ToolCommandGetJob:      event.EventCommandGetJob,
+	ToolRunMacro:           event.EventRunMacro,
+}
+
 // ExecuteTool routes tool calls to appropriate handlers
 func (s *MCPServer) ExecuteTool(ctx context.Context, toolName string, arguments map[string]interface{}) (interface{}, error) {
 	slog.Debug("Executing MCP tool", "tool", toolName, "arguments", arguments)
 
+	eventType, isEventTool := toolEvents[toolName]
+	if !isEventTool && toolName != ToolGetData && toolName != ToolCommandExecute && toolName != ToolCommandStart {
+		return nil, fmt.Errorf("unknown tool: %s", toolName)
+	}
+
+	cfg, err := settings.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+	if !IsToolEnabled(toolName, cfg) {
+		slog.Warn("MCP tool call denied", "tool", toolName, "connection", connectionID(ctx))
+		return nil, fmt.Errorf("tool is disabled: %s", toolName)
+	}
+
 	switch toolName {
-	case "system_bridge_get_data":
+	case ToolGetData:
 		return s.handleGetData(ctx, arguments)
-	case "system_bridge_send_notification":
-		return s.handleNotification(ctx, arguments)
-	case "system_bridge_media_control":
-		return s.handleMediaControl(ctx, arguments)
+	case ToolCommandExecute:
+		return s.handleCommandExecute(ctx, arguments, cfg)
+	case ToolCommandStart:
+		return s.handleCommandStart(ctx, arguments, cfg)
 	default:
-		return nil, fmt.Errorf("unknown tool: %s", toolName)
+		return s.handleEvent(ctx, eventType, arguments)
 	}
 }
 
@@ -63,42 +112,75 @@ func (s *MCPServer) handleGetData(ctx context.Context, arguments map[string]inte
 	return result, nil
 }
 
-// handleNotification sends a desktop notification
-func (s *MCPServer) handleNotification(ctx context.Context, arguments map[string]interface{}) (interface{}, error) {
+// handleEvent dispatches a tool call to the router event it maps onto
+func (s *MCPServer) handleEvent(ctx context.Context, eventType event.EventType, arguments map[string]interface{}) (interface{}, error) {
+	if arguments == nil {
+		arguments = map[string]interface{}{}
+	}
+
 	message := event.Message{
 		ID:    generateID(),
-		Event: event.EventNotification,
+		Event: eventType,
 		Data:  arguments,
 	}
 
-	response := s.eventRouter.HandleMessage("mcp", message)
+	response := s.eventRouter.HandleMessage(connectionID(ctx), message)
 	if response.Type == event.ResponseTypeError {
 		return nil, fmt.Errorf("%s", response.Message)
 	}
 
-	return map[string]interface{}{
+	result := map[string]interface{}{
 		"success": true,
 		"message": response.Message,
-	}, nil
+	}
+	if response.Data != nil {
+		result["data"] = response.Data
+	}
+
+	return result, nil
 }
 
-// handleMediaControl controls media playback
-func (s *MCPServer) handleMediaControl(ctx context.Context, arguments map[string]interface{}) (interface{}, error) {
-	message := event.Message{
-		ID:    generateID(),
-		Event: event.EventMediaControl,
-		Data:  arguments,
+// handleCommandExecute runs an allowlisted command and returns its output
+func (s *MCPServer) handleCommandExecute(ctx context.Context, arguments map[string]interface{}, cfg *settings.Settings) (interface{}, error) {
+	commandID, _ := arguments["commandID"].(string)
+	if commandID == "" {
+		return nil, fmt.Errorf("missing required parameter: commandID")
+	}
+	params, _ := arguments["params"].(map[string]interface{})
+
+	result, err := command.ExecuteSync(ctx, command.ExecuteRequest{
+		CommandID:  commandID,
+		Params:     params,
+		RequestID:  generateID(),
+		Connection: connectionID(ctx),
+	}, cfg)
+	if err != nil {
+		return nil, err
 	}
 
-	response := s.eventRouter.HandleMessage("mcp", message)
-	if response.Type == event.ResponseTypeError {
-		return nil, fmt.Errorf("%s", response.Message)
+	return result, nil
+}
+
+// handleCommandStart starts an allowlisted command in the background and
+// returns its job
+func (s *MCPServer) handleCommandStart(ctx context.Context, arguments map[string]interface{}, cfg *settings.Settings) (interface{}, error) {
+	commandID, _ := arguments["commandID"].(string)
+	if commandID == "" {
+		return nil, fmt.Errorf("missing required parameter: commandID")
+	}
+	params, _ := arguments["params"].(map[string]interface{})
+
+	jobID, err := command.Execute(command.ExecuteRequest{
+		CommandID:  commandID,
+		Params:     params,
+		RequestID:  generateID(),
+		Connection: connectionID(ctx),
+	}, cfg, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return map[string]interface{}{
-		"success": true,
-		"message": response.Message,
-	}, nil
+	return command.GetJob(jobID)
 }
 
 // generateID generates a unique request ID
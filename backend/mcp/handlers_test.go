@@ -4,10 +4,12 @@ import (
 	"context"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/timmo001/system-bridge/data"
 	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
 )
 
 func TestHandleGetData(t *testing.T) {
@@ -75,3 +77,89 @@ func TestExecuteToolUnknown(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "unknown tool")
 }
+
+func TestIsToolEnabled(t *testing.T) {
+	cfg := &settings.Settings{}
+
+	assert.True(t, IsToolEnabled(ToolGetData, cfg))
+	assert.True(t, IsToolEnabled(ToolGetFiles, cfg))
+	assert.True(t, IsToolEnabled(ToolCommandExecute, cfg))
+	assert.False(t, IsToolEnabled(ToolPowerShutdown, cfg))
+	assert.False(t, IsToolEnabled(ToolKeyboardText, cfg))
+	assert.False(t, IsToolEnabled(ToolRunMacro, cfg))
+
+	cfg.MCP.Tools = map[string]bool{
+		ToolPowerLock:      true,
+		ToolCommandExecute: false,
+	}
+	assert.True(t, IsToolEnabled(ToolPowerLock, cfg))
+	assert.False(t, IsToolEnabled(ToolCommandExecute, cfg))
+	assert.False(t, IsToolEnabled(ToolPowerShutdown, cfg))
+
+	names := make([]string, 0)
+	for _, tool := range GetEnabledToolDefinitions(cfg) {
+		names = append(names, tool.Name)
+	}
+	assert.Contains(t, names, ToolPowerLock)
+	assert.NotContains(t, names, ToolCommandExecute)
+	assert.NotContains(t, names, ToolPowerShutdown)
+}
+
+func TestToolDefinitionsHaveHandlers(t *testing.T) {
+	for _, tool := range GetToolDefinitions() {
+		_, isEventTool := toolEvents[tool.Name]
+		assert.True(t, isEventTool || tool.Name == ToolGetData || tool.Name == ToolCommandExecute || tool.Name == ToolCommandStart, "tool %s has no handler", tool.Name)
+		assert.NotNil(t, tool.InputSchema, "tool %s has no input schema", tool.Name)
+	}
+}
+
+func TestExecuteToolPermissions(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	eventRouter := event.NewMessageRouter()
+	var received event.Message
+	eventRouter.RegisterSimpleHandler(event.EventGetDirectories, func(connection string, message event.Message) event.MessageResponse {
+		received = message
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeDirectories,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    []string{"documents"},
+		}
+	})
+	shutdownCalled := false
+	eventRouter.RegisterSimpleHandler(event.EventPowerShutdown, func(connection string, message event.Message) event.MessageResponse {
+		shutdownCalled = true
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypePowerShuttingdown}
+	})
+	server := NewMCPServer("test-token", eventRouter, dataStore)
+
+	t.Run("Enabled tool is routed to its event", func(t *testing.T) {
+		result, err := server.ExecuteTool(context.Background(), ToolGetDirectories, nil)
+		require.NoError(t, err)
+		assert.Equal(t, event.EventGetDirectories, received.Event)
+		assert.Equal(t, []string{"documents"}, result.(map[string]interface{})["data"])
+	})
+
+	t.Run("Dangerous tool is disabled by default", func(t *testing.T) {
+		_, err := server.ExecuteTool(context.Background(), ToolPowerShutdown, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tool is disabled")
+		assert.False(t, shutdownCalled)
+	})
+
+	t.Run("Disabled tools are not listed", func(t *testing.T) {
+		response := server.HandleRequest(context.Background(), MCPRequest{JSONRPC: "2.0", ID: 1, Method: "tools/list"})
+		require.Nil(t, response.Error)
+		result, ok := response.Result.(ToolsListResult)
+		require.True(t, ok)
+		for _, tool := range result.Tools {
+			assert.NotEqual(t, ToolPowerShutdown, tool.Name)
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package mcp
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *MCPServer
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global MCP server instance
+func GetInstance() *MCPServer {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global MCP server instance
+func SetInstance(instance *MCPServer) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
@@ -0,0 +1,324 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// Prompt names
+const (
+	PromptDiagnoseHighCPU = "diagnose_high_cpu"
+	PromptDiskSpaceReport = "disk_space_report"
+	PromptWhatsPlaying    = "whats_playing"
+)
+
+const (
+	// defaultProcessLimit is the number of processes included in the high CPU prompt
+	defaultProcessLimit = 10
+	// defaultDiskThreshold is the usage percentage at which the disk space
+	// prompt flags a partition
+	defaultDiskThreshold = 90.0
+)
+
+// promptRenderer renders a prompt's message from current module data
+type promptRenderer func(dataStore *data.DataStore, args map[string]string) (string, error)
+
+// promptRenderers maps prompt names to their renderers
+var promptRenderers = map[string]promptRenderer{
+	PromptDiagnoseHighCPU: renderDiagnoseHighCPU,
+	PromptDiskSpaceReport: renderDiskSpaceReport,
+	PromptWhatsPlaying:    renderWhatsPlaying,
+}
+
+// GetPromptDefinitions returns all available MCP prompts
+func GetPromptDefinitions() []Prompt {
+	return []Prompt{
+		{
+			Name:        PromptDiagnoseHighCPU,
+			Description: "Diagnose high CPU usage from the current CPU and process data",
+			Arguments: []PromptArgument{
+				{
+					Name:        "limit",
+					Description: fmt.Sprintf("Number of top processes to include (default %d)", defaultProcessLimit),
+				},
+			},
+		},
+		{
+			Name:        PromptDiskSpaceReport,
+			Description: "Report disk space usage and flag partitions that are running out of space",
+			Arguments: []PromptArgument{
+				{
+					Name:        "threshold",
+					Description: fmt.Sprintf("Usage percentage at which a partition is flagged (default %g)", defaultDiskThreshold),
+				},
+			},
+		},
+		{
+			Name:        PromptWhatsPlaying,
+			Description: "Describe the media currently playing",
+		},
+	}
+}
+
+// handlePromptsList handles the prompts/list request
+func (s *MCPServer) handlePromptsList(req MCPRequest) MCPResponse {
+	return NewSuccessResponse(req.ID, PromptsListResult{Prompts: GetPromptDefinitions()})
+}
+
+// handlePromptGet handles the prompts/get request
+func (s *MCPServer) handlePromptGet(req MCPRequest) MCPResponse {
+	var params PromptGetParams
+	paramsJSON, err := json.Marshal(req.Params)
+	if err != nil {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Invalid parameters", nil)
+	}
+	if err := json.Unmarshal(paramsJSON, &params); err != nil {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Invalid parameters", nil)
+	}
+
+	render, ok := promptRenderers[params.Name]
+	if !ok {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, fmt.Sprintf("Unknown prompt: %s", params.Name), nil)
+	}
+
+	text, err := render(s.dataStore, params.Arguments)
+	if err != nil {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, err.Error(), nil)
+	}
+
+	var description string
+	for _, prompt := range GetPromptDefinitions() {
+		if prompt.Name == params.Name {
+			description = prompt.Description
+			break
+		}
+	}
+
+	return NewSuccessResponse(req.ID, PromptGetResult{
+		Description: description,
+		Messages: []PromptMessage{
+			{
+				Role:    "user",
+				Content: ContentItem{Type: "text", Text: text},
+			},
+		},
+	})
+}
+
+// moduleData decodes a module's current data into out. Data loaded from the
+// cache on disk is not typed, so it is round-tripped through JSON. It returns
+// false when the module has no data yet.
+func moduleData(dataStore *data.DataStore, name types.ModuleName, out any) bool {
+	module, err := dataStore.GetModule(name)
+	if err != nil || module.Data == nil {
+		return false
+	}
+	encoded, err := json.Marshal(module.Data)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(encoded, out) == nil
+}
+
+// renderDiagnoseHighCPU renders the diagnose_high_cpu prompt
+func renderDiagnoseHighCPU(dataStore *data.DataStore, args map[string]string) (string, error) {
+	limit := defaultProcessLimit
+	if value := args["limit"]; value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			return "", fmt.Errorf("limit must be a positive integer")
+		}
+		limit = parsed
+	}
+
+	var b strings.Builder
+	b.WriteString("My computer's CPU usage is high. Using the data below from System Bridge, work out which processes are responsible, ")
+	b.WriteString("whether the load looks like normal activity or a problem, and suggest what I should do about it.\n\n")
+
+	b.WriteString("## CPU\n\n")
+	var cpu types.CPUData
+	if moduleData(dataStore, types.ModuleCPU, &cpu) {
+		writeOptional(&b, "Usage", cpu.Usage, "%.1f%%")
+		writeOptional(&b, "Load average", cpu.LoadAverage, "%.2f")
+		writeOptional(&b, "Cores", cpu.Count, "%d")
+		if cpu.Frequency != nil {
+			writeOptional(&b, "Frequency", cpu.Frequency.Current, "%.0f MHz")
+		}
+		writeOptional(&b, "Temperature", cpu.Temperature, "%.1f°C")
+		if len(cpu.PerCPU) > 0 {
+			usages := make([]string, 0, len(cpu.PerCPU))
+			for _, core := range cpu.PerCPU {
+				if core.Usage != nil {
+					usages = append(usages, fmt.Sprintf("%.0f%%", *core.Usage))
+				}
+			}
+			if len(usages) > 0 {
+				fmt.Fprintf(&b, "- Per core usage: %s\n", strings.Join(usages, ", "))
+			}
+		}
+	} else {
+		b.WriteString("No CPU data is available yet.\n")
+	}
+
+	fmt.Fprintf(&b, "\n## Top %d processes by CPU usage\n\n", limit)
+	var processes types.ProcessesData
+	if moduleData(dataStore, types.ModuleProcesses, &processes) && len(processes) > 0 {
+		sort.SliceStable(processes, func(i, j int) bool {
+			return valueOrZero(processes[i].CPUUsage) > valueOrZero(processes[j].CPUUsage)
+		})
+		if len(processes) > limit {
+			processes = processes[:limit]
+		}
+
+		b.WriteString("| PID | Name | CPU % | Memory % | User | Status |\n")
+		b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+		for _, process := range processes {
+			fmt.Fprintf(&b, "| %.0f | %s | %.1f | %.1f | %s | %s |\n",
+				process.ID,
+				stringOrUnknown(process.Name),
+				valueOrZero(process.CPUUsage),
+				valueOrZero(process.MemoryUsage),
+				stringOrUnknown(process.Username),
+				stringOrUnknown(process.Status),
+			)
+		}
+	} else {
+		b.WriteString("No process data is available yet.\n")
+	}
+
+	return b.String(), nil
+}
+
+// renderDiskSpaceReport renders the disk_space_report prompt
+func renderDiskSpaceReport(dataStore *data.DataStore, args map[string]string) (string, error) {
+	threshold := defaultDiskThreshold
+	if value := args["threshold"]; value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil || parsed <= 0 || parsed > 100 {
+			return "", fmt.Errorf("threshold must be a percentage between 0 and 100")
+		}
+		threshold = parsed
+	}
+
+	var b strings.Builder
+	b.WriteString("Write a short report on my disk space using the data below from System Bridge. ")
+	fmt.Fprintf(&b, "Call out any partition at or above %g%% usage and suggest how I could free up space.\n\n", threshold)
+
+	b.WriteString("## Partitions\n\n")
+	var disks types.DisksData
+	rows := 0
+	if moduleData(dataStore, types.ModuleDisks, &disks) {
+		for _, device := range disks.Devices {
+			for _, partition := range device.Partitions {
+				if partition.Usage == nil {
+					continue
+				}
+				if rows == 0 {
+					b.WriteString("| Device | Mount point | Filesystem | Used | Free | Total | Usage |\n")
+					b.WriteString("| --- | --- | --- | --- | --- | --- | --- |\n")
+				}
+				rows++
+
+				usage := fmt.Sprintf("%.1f%%", partition.Usage.Percent)
+				if partition.Usage.Percent >= threshold {
+					usage += " (low space)"
+				}
+				fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s |\n",
+					partition.Device,
+					partition.MountPoint,
+					partition.FilesystemType,
+					formatBytes(partition.Usage.Used),
+					formatBytes(partition.Usage.Free),
+					formatBytes(partition.Usage.Total),
+					usage,
+				)
+			}
+		}
+	}
+	if rows == 0 {
+		b.WriteString("No disk data is available yet.\n")
+	}
+
+	return b.String(), nil
+}
+
+// renderWhatsPlaying renders the whats_playing prompt
+func renderWhatsPlaying(dataStore *data.DataStore, _ map[string]string) (string, error) {
+	var b strings.Builder
+	b.WriteString("Tell me what is playing on my computer, using the media data below from System Bridge.\n\n")
+
+	var media types.MediaData
+	if !moduleData(dataStore, types.ModuleMedia, &media) || media.Status == nil {
+		b.WriteString("Nothing is playing.\n")
+		return b.String(), nil
+	}
+
+	b.WriteString("## Media\n\n")
+	writeOptional(&b, "Status", media.Status, "%s")
+	writeOptional(&b, "Title", media.Title, "%s")
+	writeOptional(&b, "Artist", media.Artist, "%s")
+	writeOptional(&b, "Album", media.AlbumTitle, "%s")
+	writeOptional(&b, "Album artist", media.AlbumArtist, "%s")
+	writeOptional(&b, "Type", media.Type, "%s")
+	if media.Position != nil && media.Duration != nil {
+		fmt.Fprintf(&b, "- Position: %s of %s\n", formatDuration(*media.Position), formatDuration(*media.Duration))
+	}
+	if media.Volume != nil {
+		fmt.Fprintf(&b, "- Volume: %.0f%%\n", *media.Volume)
+	}
+	writeOptional(&b, "Shuffle", media.Shuffle, "%t")
+	writeOptional(&b, "Repeat", media.Repeat, "%s")
+
+	return b.String(), nil
+}
+
+// writeOptional writes a list item for a value that may be missing
+func writeOptional[T any](b *strings.Builder, label string, value *T, format string) {
+	if value == nil {
+		return
+	}
+	fmt.Fprintf(b, "- %s: "+format+"\n", label, *value)
+}
+
+func valueOrZero(value *float64) float64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func stringOrUnknown(value *string) string {
+	if value == nil || *value == "" {
+		return "unknown"
+	}
+	return *value
+}
+
+// formatBytes formats a byte count with a binary unit
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+// formatDuration formats seconds as m:ss, or h:mm:ss for an hour or more
+func formatDuration(seconds float64) string {
+	total := int(seconds)
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, total%3600/60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
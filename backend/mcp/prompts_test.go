@@ -0,0 +1,110 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func getPrompt(t *testing.T, server *MCPServer, name string, args map[string]interface{}) MCPResponse {
+	t.Helper()
+	return server.HandleRequest(context.Background(), MCPRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "prompts/get",
+		Params:  map[string]interface{}{"name": name, "arguments": args},
+	})
+}
+
+func promptText(t *testing.T, response MCPResponse) string {
+	t.Helper()
+	require.Nil(t, response.Error)
+	result, ok := response.Result.(PromptGetResult)
+	require.True(t, ok)
+	require.Len(t, result.Messages, 1)
+	assert.Equal(t, "user", result.Messages[0].Role)
+	return result.Messages[0].Content.Text
+}
+
+func TestPromptsList(t *testing.T) {
+	server, _ := newResourceTestServer(t)
+
+	response := server.HandleRequest(context.Background(), MCPRequest{JSONRPC: "2.0", ID: 1, Method: "prompts/list"})
+	require.Nil(t, response.Error)
+
+	result, ok := response.Result.(PromptsListResult)
+	require.True(t, ok)
+
+	names := make([]string, 0, len(result.Prompts))
+	for _, prompt := range result.Prompts {
+		names = append(names, prompt.Name)
+	}
+	assert.ElementsMatch(t, []string{PromptDiagnoseHighCPU, PromptDiskSpaceReport, PromptWhatsPlaying}, names)
+}
+
+func TestPromptGet(t *testing.T) {
+	server, dataStore := newResourceTestServer(t)
+
+	t.Run("Diagnose high CPU", func(t *testing.T) {
+		usage := 93.5
+		require.NoError(t, dataStore.SetModuleData(types.ModuleCPU, types.CPUData{Usage: &usage}))
+
+		busy, idle, heavy := "busy", "idle", 80.0
+		light := 1.0
+		require.NoError(t, dataStore.SetModuleData(types.ModuleProcesses, types.ProcessesData{
+			{ID: 2, Name: &idle, CPUUsage: &light},
+			{ID: 1, Name: &busy, CPUUsage: &heavy},
+		}))
+
+		text := promptText(t, getPrompt(t, server, PromptDiagnoseHighCPU, map[string]interface{}{"limit": "1"}))
+		assert.Contains(t, text, "- Usage: 93.5%")
+		assert.Contains(t, text, "| 1 | busy | 80.0 |")
+		assert.NotContains(t, text, "idle")
+	})
+
+	t.Run("Disk space report", func(t *testing.T) {
+		require.NoError(t, dataStore.SetModuleData(types.ModuleDisks, types.DisksData{
+			Devices: []types.Disk{
+				{
+					Name: "sda",
+					Partitions: []types.DiskPartition{
+						{Device: "/dev/sda1", MountPoint: "/", Usage: &types.DiskUsage{Total: 100 << 30, Used: 95 << 30, Free: 5 << 30, Percent: 95}},
+						{Device: "/dev/sda2", MountPoint: "/home", Usage: &types.DiskUsage{Total: 100 << 30, Used: 10 << 30, Free: 90 << 30, Percent: 10}},
+					},
+				},
+			},
+		}))
+
+		text := promptText(t, getPrompt(t, server, PromptDiskSpaceReport, nil))
+		assert.Contains(t, text, "| /dev/sda1 | / |  | 95.0 GiB | 5.0 GiB | 100.0 GiB | 95.0% (low space) |")
+		assert.Contains(t, text, "| 10.0% |")
+	})
+
+	t.Run("What's playing", func(t *testing.T) {
+		assert.Contains(t, promptText(t, getPrompt(t, server, PromptWhatsPlaying, nil)), "Nothing is playing.")
+
+		status, title, position, duration := "PLAYING", "Song", 65.0, 200.0
+		require.NoError(t, dataStore.SetModuleData(types.ModuleMedia, types.MediaData{
+			Status: &status, Title: &title, Position: &position, Duration: &duration,
+		}))
+
+		text := promptText(t, getPrompt(t, server, PromptWhatsPlaying, nil))
+		assert.Contains(t, text, "- Title: Song")
+		assert.Contains(t, text, "- Position: 1:05 of 3:20")
+	})
+
+	t.Run("Invalid argument", func(t *testing.T) {
+		response := getPrompt(t, server, PromptDiagnoseHighCPU, map[string]interface{}{"limit": "none"})
+		require.NotNil(t, response.Error)
+		assert.Equal(t, ErrorCodeInvalidParams, response.Error.Code)
+	})
+
+	t.Run("Unknown prompt", func(t *testing.T) {
+		response := getPrompt(t, server, "nope", nil)
+		require.NotNil(t, response.Error)
+		assert.Equal(t, ErrorCodeInvalidParams, response.Error.Code)
+	})
+}
@@ -32,6 +32,11 @@ const (
 	ErrorCodeInternalError  = -32603
 )
 
+// MCP error codes
+const (
+	ErrorCodeResourceNotFound = -32002
+)
+
 // MCP protocol types
 type InitializeParams struct {
 	ProtocolVersion string                 `json:"protocolVersion"`
@@ -51,7 +56,9 @@ type InitializeResult struct {
 }
 
 type ServerCapabilities struct {
-	Tools map[string]interface{} `json:"tools,omitempty"`
+	Tools     map[string]interface{} `json:"tools,omitempty"`
+	Resources map[string]interface{} `json:"resources,omitempty"`
+	Prompts   map[string]interface{} `json:"prompts,omitempty"`
 }
 
 type ServerInfo struct {
@@ -83,6 +90,87 @@ type ContentItem struct {
 	Text string `json:"text,omitempty"`
 }
 
+// MCPNotification represents an MCP JSON-RPC 2.0 notification
+type MCPNotification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+type Resource struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+type ResourceTemplate struct {
+	URITemplate string `json:"uriTemplate"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+type ResourcesListResult struct {
+	Resources []Resource `json:"resources"`
+}
+
+type ResourceTemplatesListResult struct {
+	ResourceTemplates []ResourceTemplate `json:"resourceTemplates"`
+}
+
+// ResourceParams are the params of resources/read, resources/subscribe and
+// resources/unsubscribe
+type ResourceParams struct {
+	URI string `json:"uri"`
+}
+
+type ResourceContents struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Text     string `json:"text,omitempty"`
+}
+
+type ResourceReadResult struct {
+	Contents []ResourceContents `json:"contents"`
+}
+
+type ResourceUpdatedParams struct {
+	URI string `json:"uri"`
+}
+
+type Prompt struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+type PromptsListResult struct {
+	Prompts []Prompt `json:"prompts"`
+}
+
+// PromptGetParams are the params of prompts/get
+type PromptGetParams struct {
+	Name      string            `json:"name"`
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+type PromptMessage struct {
+	Role    string      `json:"role"`
+	Content ContentItem `json:"content"`
+}
+
+type PromptGetResult struct {
+	Description string          `json:"description,omitempty"`
+	Messages    []PromptMessage `json:"messages"`
+}
+
 // NewErrorResponse creates a new error response
 func NewErrorResponse(id interface{}, code int, message string, data interface{}) MCPResponse {
 	return MCPResponse{
@@ -96,6 +184,15 @@ func NewErrorResponse(id interface{}, code int, message string, data interface{}
 	}
 }
 
+// NewNotification creates a new notification
+func NewNotification(method string, params interface{}) MCPNotification {
+	return MCPNotification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	}
+}
+
 // NewSuccessResponse creates a new success response
 func NewSuccessResponse(id interface{}, result interface{}) MCPResponse {
 	return MCPResponse{
@@ -0,0 +1,315 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+const (
+	// resourceScheme is the URI scheme of System Bridge resources
+	resourceScheme = "systembridge://"
+	// resourceMimeType is the MIME type of all resource contents
+	resourceMimeType = "application/json"
+	// settingsResourceURI is the URI of the settings resource
+	settingsResourceURI = resourceScheme + "settings"
+)
+
+// errResourceNotFound is returned when a resource URI does not match a resource
+var errResourceNotFound = errors.New("resource not found")
+
+// moduleResourceURI returns the URI of a data module resource
+func moduleResourceURI(module types.ModuleName) string {
+	return resourceScheme + "data/" + string(module)
+}
+
+// mediaResourceURI returns the URI of a media directory
+func mediaResourceURI(directory string) string {
+	return resourceScheme + "media/" + url.PathEscape(directory)
+}
+
+// handleResourcesList handles the resources/list request
+func (s *MCPServer) handleResourcesList(req MCPRequest) MCPResponse {
+	resources := make([]Resource, 0)
+	for _, updater := range s.dataStore.GetRegisteredModules() {
+		name := updater.Name()
+		resources = append(resources, Resource{
+			URI:         moduleResourceURI(name),
+			Name:        fmt.Sprintf("%s data", name),
+			Description: fmt.Sprintf("Current %s module data. Subscribe to be notified of updates.", name),
+			MimeType:    resourceMimeType,
+		})
+	}
+
+	cfg, err := settings.Load()
+	if err != nil {
+		slog.Error("Failed to load settings", "error", err)
+		return NewErrorResponse(req.ID, ErrorCodeInternalError, "Failed to load settings", nil)
+	}
+
+	if IsToolEnabled(ToolGetSettings, cfg) {
+		resources = append(resources, Resource{
+			URI:         settingsResourceURI,
+			Name:        "Settings",
+			Description: "System Bridge settings",
+			MimeType:    resourceMimeType,
+		})
+	}
+
+	if IsToolEnabled(ToolGetFiles, cfg) {
+		for _, directory := range cfg.Media.Directories {
+			resources = append(resources, Resource{
+				URI:         mediaResourceURI(directory.Name),
+				Name:        directory.Name,
+				Description: fmt.Sprintf("Files in the %s media directory", directory.Name),
+				MimeType:    resourceMimeType,
+			})
+		}
+	}
+
+	return NewSuccessResponse(req.ID, ResourcesListResult{Resources: resources})
+}
+
+// handleResourceTemplatesList handles the resources/templates/list request
+func (s *MCPServer) handleResourceTemplatesList(req MCPRequest) MCPResponse {
+	return NewSuccessResponse(req.ID, ResourceTemplatesListResult{
+		ResourceTemplates: []ResourceTemplate{
+			{
+				URITemplate: resourceScheme + "data/{module}",
+				Name:        "Module data",
+				Description: "Current data for a data module",
+				MimeType:    resourceMimeType,
+			},
+			{
+				URITemplate: resourceScheme + "media/{directory}/{+path}",
+				Name:        "Media file",
+				Description: "Files in a subdirectory of a media directory, or information about a file",
+				MimeType:    resourceMimeType,
+			},
+		},
+	})
+}
+
+// handleResourceRead handles the resources/read request
+func (s *MCPServer) handleResourceRead(ctx context.Context, req MCPRequest) MCPResponse {
+	params, ok := decodeResourceParams(req)
+	if !ok {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Missing resource URI", nil)
+	}
+
+	contents, err := s.readResource(ctx, params.URI)
+	if err != nil {
+		if errors.Is(err, errResourceNotFound) {
+			return NewErrorResponse(req.ID, ErrorCodeResourceNotFound, "Resource not found", map[string]string{"uri": params.URI})
+		}
+		slog.Error("Failed to read MCP resource", "uri", params.URI, "error", err)
+		return NewErrorResponse(req.ID, ErrorCodeInternalError, err.Error(), nil)
+	}
+
+	text, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return NewErrorResponse(req.ID, ErrorCodeInternalError, "Failed to encode resource", nil)
+	}
+
+	return NewSuccessResponse(req.ID, ResourceReadResult{
+		Contents: []ResourceContents{
+			{
+				URI:      params.URI,
+				MimeType: resourceMimeType,
+				Text:     string(text),
+			},
+		},
+	})
+}
+
+// readResource returns the contents of a resource
+func (s *MCPServer) readResource(ctx context.Context, uri string) (interface{}, error) {
+	resourcePath, ok := strings.CutPrefix(uri, resourceScheme)
+	if !ok {
+		return nil, errResourceNotFound
+	}
+
+	if module, ok := strings.CutPrefix(resourcePath, "data/"); ok {
+		m, err := s.dataStore.GetModule(types.ModuleName(module))
+		if err != nil {
+			return nil, errResourceNotFound
+		}
+		return m.Data, nil
+	}
+
+	cfg, err := settings.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	if resourcePath == "settings" {
+		if !IsToolEnabled(ToolGetSettings, cfg) {
+			return nil, errResourceNotFound
+		}
+		return s.dispatchResource(ctx, event.EventGetSettings, nil)
+	}
+
+	if mediaPath, ok := strings.CutPrefix(resourcePath, "media/"); ok {
+		if !IsToolEnabled(ToolGetFiles, cfg) {
+			return nil, errResourceNotFound
+		}
+		return s.readMediaResource(ctx, cfg, mediaPath)
+	}
+
+	return nil, errResourceNotFound
+}
+
+// readMediaResource lists a media directory, or returns information about a
+// file within one. Paths may not leave the media directory.
+func (s *MCPServer) readMediaResource(ctx context.Context, cfg *settings.Settings, mediaPath string) (interface{}, error) {
+	directoryName, subPath, _ := strings.Cut(mediaPath, "/")
+	directoryName, err := url.PathUnescape(directoryName)
+	if err != nil {
+		return nil, errResourceNotFound
+	}
+	subPath, err = url.PathUnescape(subPath)
+	if err != nil {
+		return nil, errResourceNotFound
+	}
+
+	var directory *settings.SettingsMediaDirectory
+	for i := range cfg.Media.Directories {
+		if cfg.Media.Directories[i].Name == directoryName {
+			directory = &cfg.Media.Directories[i]
+			break
+		}
+	}
+	if directory == nil {
+		return nil, errResourceNotFound
+	}
+
+	// Cleaning as an absolute path drops any leading ".." segments
+	subPath = path.Clean("/" + subPath)[1:]
+
+	fullPath := filepath.Join(directory.Path, filepath.FromSlash(subPath))
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return nil, errResourceNotFound
+	}
+
+	if !info.IsDir() {
+		return s.dispatchResource(ctx, event.EventGetFile, map[string]interface{}{"path": fullPath})
+	}
+	return s.dispatchResource(ctx, event.EventGetFiles, map[string]interface{}{
+		"base": directory.Name,
+		"path": filepath.FromSlash(subPath),
+	})
+}
+
+// dispatchResource reads a resource through the router event that serves it
+func (s *MCPServer) dispatchResource(ctx context.Context, eventType event.EventType, data map[string]interface{}) (interface{}, error) {
+	response := s.eventRouter.HandleMessage(connectionID(ctx), event.Message{
+		ID:    generateID(),
+		Event: eventType,
+		Data:  data,
+	})
+	if response.Type == event.ResponseTypeError {
+		if response.Message != "" {
+			return nil, fmt.Errorf("%s", response.Message)
+		}
+		return nil, fmt.Errorf("%s failed: %s", eventType, response.Subtype)
+	}
+	return response.Data, nil
+}
+
+// handleResourceSubscribe handles the resources/subscribe request
+func (s *MCPServer) handleResourceSubscribe(ctx context.Context, req MCPRequest) MCPResponse {
+	params, ok := decodeResourceParams(req)
+	if !ok {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Missing resource URI", nil)
+	}
+
+	sess := sessionFromContext(ctx)
+	if sess == nil {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidRequest, "Subscriptions require a connected session", nil)
+	}
+
+	module, ok := strings.CutPrefix(params.URI, resourceScheme+"data/")
+	if !ok {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Only data module resources support subscriptions", nil)
+	}
+	if _, err := s.dataStore.GetModule(types.ModuleName(module)); err != nil {
+		return NewErrorResponse(req.ID, ErrorCodeResourceNotFound, "Resource not found", map[string]string{"uri": params.URI})
+	}
+
+	sess.subscribe(types.ModuleName(module))
+	slog.Debug("MCP resource subscribed", "session", sess.id, "uri", params.URI)
+
+	return NewSuccessResponse(req.ID, map[string]interface{}{})
+}
+
+// handleResourceUnsubscribe handles the resources/unsubscribe request
+func (s *MCPServer) handleResourceUnsubscribe(ctx context.Context, req MCPRequest) MCPResponse {
+	params, ok := decodeResourceParams(req)
+	if !ok {
+		return NewErrorResponse(req.ID, ErrorCodeInvalidParams, "Missing resource URI", nil)
+	}
+
+	if sess := sessionFromContext(ctx); sess != nil {
+		module, _ := strings.CutPrefix(params.URI, resourceScheme+"data/")
+		sess.unsubscribe(types.ModuleName(module))
+		slog.Debug("MCP resource unsubscribed", "session", sess.id, "uri", params.URI)
+	}
+
+	return NewSuccessResponse(req.ID, map[string]interface{}{})
+}
+
+// handleDataModuleUpdate notifies sessions subscribed to a module's resource
+func (s *MCPServer) handleDataModuleUpdate(e bus.Event) {
+	var module types.Module
+	if err := mapstructure.Decode(e.Data, &module); err != nil {
+		slog.Error("Failed to decode module data", "error", err)
+		return
+	}
+
+	notification := NewNotification("notifications/resources/updated", ResourceUpdatedParams{
+		URI: moduleResourceURI(module.Name),
+	})
+
+	s.sessionsMutex.RLock()
+	subscribers := make([]*session, 0)
+	for _, sess := range s.sessions {
+		if sess.isSubscribed(module.Name) {
+			subscribers = append(subscribers, sess)
+		}
+	}
+	s.sessionsMutex.RUnlock()
+
+	for _, sess := range subscribers {
+		if err := sess.send(notification); err != nil {
+			slog.Debug("Failed to send MCP resource update", "session", sess.id, "error", err)
+		}
+	}
+}
+
+// decodeResourceParams decodes the URI param of a resource request
+func decodeResourceParams(req MCPRequest) (ResourceParams, bool) {
+	var params ResourceParams
+	paramsJSON, err := json.Marshal(req.Params)
+	if err != nil {
+		return params, false
+	}
+	if err := json.Unmarshal(paramsJSON, &params); err != nil {
+		return params, false
+	}
+	return params, params.URI != ""
+}
@@ -0,0 +1,154 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func newResourceTestServer(t *testing.T) (*MCPServer, *data.DataStore) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	return NewMCPServer("test-token", event.NewMessageRouter(), dataStore), dataStore
+}
+
+func TestResourcesList(t *testing.T) {
+	server, _ := newResourceTestServer(t)
+
+	response := server.HandleRequest(context.Background(), MCPRequest{JSONRPC: "2.0", ID: 1, Method: "resources/list"})
+	require.Nil(t, response.Error)
+
+	result, ok := response.Result.(ResourcesListResult)
+	require.True(t, ok)
+
+	uris := make([]string, 0, len(result.Resources))
+	for _, resource := range result.Resources {
+		uris = append(uris, resource.URI)
+	}
+	assert.Contains(t, uris, "systembridge://data/cpu")
+	assert.Contains(t, uris, "systembridge://settings")
+}
+
+func TestResourceRead(t *testing.T) {
+	server, dataStore := newResourceTestServer(t)
+
+	usage := 42.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleCPU, types.CPUData{Usage: &usage}))
+
+	t.Run("Module data", func(t *testing.T) {
+		response := server.HandleRequest(context.Background(), MCPRequest{
+			JSONRPC: "2.0",
+			ID:      1,
+			Method:  "resources/read",
+			Params:  map[string]interface{}{"uri": "systembridge://data/cpu"},
+		})
+		require.Nil(t, response.Error)
+
+		result, ok := response.Result.(ResourceReadResult)
+		require.True(t, ok)
+		require.Len(t, result.Contents, 1)
+		assert.Equal(t, "application/json", result.Contents[0].MimeType)
+		assert.Contains(t, result.Contents[0].Text, `"usage": 42`)
+	})
+
+	t.Run("Unknown resource", func(t *testing.T) {
+		response := server.HandleRequest(context.Background(), MCPRequest{
+			JSONRPC: "2.0",
+			ID:      2,
+			Method:  "resources/read",
+			Params:  map[string]interface{}{"uri": "systembridge://nope"},
+		})
+		require.NotNil(t, response.Error)
+		assert.Equal(t, ErrorCodeResourceNotFound, response.Error.Code)
+	})
+}
+
+func TestReadMediaResourceStaysInDirectory(t *testing.T) {
+	server, _ := newResourceTestServer(t)
+
+	mediaDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(mediaDir, "song.mp3"), []byte("data"), 0o644))
+	cfg := &settings.Settings{
+		Media: settings.SettingsMedia{
+			Directories: []settings.SettingsMediaDirectory{{Name: "Music", Path: mediaDir}},
+		},
+	}
+
+	var requested map[string]interface{}
+	server.eventRouter.RegisterSimpleHandler(event.EventGetFiles, func(connection string, message event.Message) event.MessageResponse {
+		requested = message.Data.(map[string]interface{})
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeFiles}
+	})
+
+	_, err := server.readMediaResource(context.Background(), cfg, "Music/..%2F..")
+	require.NoError(t, err)
+	assert.Equal(t, "Music", requested["base"])
+	assert.Equal(t, "", requested["path"])
+
+	_, err = server.readMediaResource(context.Background(), cfg, "Videos")
+	assert.ErrorIs(t, err, errResourceNotFound)
+}
+
+func TestResourceSubscription(t *testing.T) {
+	server, dataStore := newResourceTestServer(t)
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = server.HandleConnection(w, r)
+	}))
+	defer httpServer.Close()
+
+	conn, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"?token=test-token", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	require.NoError(t, conn.WriteJSON(MCPRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  "resources/subscribe",
+		Params:  map[string]interface{}{"uri": "systembridge://data/memory"},
+	}))
+	var subscribed MCPResponse
+	require.NoError(t, conn.ReadJSON(&subscribed))
+	require.Nil(t, subscribed.Error)
+
+	sessions := server.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, []types.ModuleName{types.ModuleMemory}, sessions[0].Modules)
+
+	percent := 50.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleMemory, types.MemoryData{
+		Virtual: &types.MemoryVirtual{Percent: &percent},
+	}))
+
+	var notification MCPNotification
+	require.NoError(t, conn.ReadJSON(&notification))
+	assert.Equal(t, "notifications/resources/updated", notification.Method)
+
+	params, err := json.Marshal(notification.Params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"uri":"systembridge://data/memory"}`, string(params))
+}
@@ -3,28 +3,43 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"sync"
 
 	"log/slog"
 
+	"github.com/timmo001/system-bridge/bus"
 	"github.com/timmo001/system-bridge/data"
 	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/version"
 )
 
 // MCPServer handles MCP protocol requests
 type MCPServer struct {
-	token       string
-	eventRouter *event.MessageRouter
-	dataStore   *data.DataStore
+	token             string
+	eventRouter       *event.MessageRouter
+	dataStore         *data.DataStore
+	sessions          map[string]*session
+	sessionsMutex     sync.RWMutex
+	httpSessions      map[string]*httpSession
+	httpSessionsMutex sync.RWMutex
 }
 
 // NewMCPServer creates a new MCP server
 func NewMCPServer(token string, eventRouter *event.MessageRouter, dataStore *data.DataStore) *MCPServer {
-	return &MCPServer{
-		token:       token,
-		eventRouter: eventRouter,
-		dataStore:   dataStore,
+	s := &MCPServer{
+		token:        token,
+		eventRouter:  eventRouter,
+		dataStore:    dataStore,
+		sessions:     make(map[string]*session),
+		httpSessions: make(map[string]*httpSession),
 	}
+	SetInstance(s)
+
+	// Notify sessions subscribed to data module resources of updates
+	bus.GetInstance().Subscribe(bus.EventDataModuleUpdate, "mcp", s.handleDataModuleUpdate)
+
+	return s
 }
 
 // HandleRequest processes an MCP JSON-RPC request
@@ -33,18 +48,32 @@ func (s *MCPServer) HandleRequest(ctx context.Context, req MCPRequest) MCPRespon
 
 	switch req.Method {
 	case "initialize":
-		return s.handleInitialize(req)
+		return s.handleInitialize(ctx, req)
 	case "tools/list":
 		return s.handleToolsList(req)
 	case "tools/call":
 		return s.handleToolCall(ctx, req)
+	case "resources/list":
+		return s.handleResourcesList(req)
+	case "resources/templates/list":
+		return s.handleResourceTemplatesList(req)
+	case "resources/read":
+		return s.handleResourceRead(ctx, req)
+	case "resources/subscribe":
+		return s.handleResourceSubscribe(ctx, req)
+	case "resources/unsubscribe":
+		return s.handleResourceUnsubscribe(ctx, req)
+	case "prompts/list":
+		return s.handlePromptsList(req)
+	case "prompts/get":
+		return s.handlePromptGet(req)
 	default:
 		return NewErrorResponse(req.ID, ErrorCodeMethodNotFound, "Method not found", nil)
 	}
 }
 
 // handleInitialize handles the initialize request
-func (s *MCPServer) handleInitialize(req MCPRequest) MCPResponse {
+func (s *MCPServer) handleInitialize(ctx context.Context, req MCPRequest) MCPResponse {
 	var params InitializeParams
 	if req.Params != nil {
 		// Try to decode params
@@ -56,12 +85,26 @@ func (s *MCPServer) handleInitialize(req MCPRequest) MCPResponse {
 
 	slog.Info("MCP client initializing", "client", params.ClientInfo.Name, "version", params.ClientInfo.Version)
 
+	if sess := sessionFromContext(ctx); sess != nil {
+		sess.setClient(event.ClientInfo{
+			Name:    params.ClientInfo.Name,
+			Version: params.ClientInfo.Version,
+		})
+	}
+
 	result := InitializeResult{
 		ProtocolVersion: "2024-11-05",
 		Capabilities: ServerCapabilities{
 			Tools: map[string]interface{}{
 				"listChanged": false,
 			},
+			Resources: map[string]interface{}{
+				"subscribe":   true,
+				"listChanged": false,
+			},
+			Prompts: map[string]interface{}{
+				"listChanged": false,
+			},
 		},
 		ServerInfo: ServerInfo{
 			Name:    "system-bridge",
@@ -74,8 +117,14 @@ func (s *MCPServer) handleInitialize(req MCPRequest) MCPResponse {
 
 // handleToolsList handles the tools/list request
 func (s *MCPServer) handleToolsList(req MCPRequest) MCPResponse {
+	cfg, err := settings.Load()
+	if err != nil {
+		slog.Error("Failed to load settings", "error", err)
+		return NewErrorResponse(req.ID, ErrorCodeInternalError, "Failed to load settings", nil)
+	}
+
 	result := ToolsListResult{
-		Tools: GetToolDefinitions(),
+		Tools: GetEnabledToolDefinitions(cfg),
 	}
 
 	return NewSuccessResponse(req.ID, result)
@@ -0,0 +1,199 @@
+package mcp
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// Transports identifying how an MCP session is connected
+const (
+	// TransportMCP is the WebSocket transport at /api/mcp
+	TransportMCP = "mcp"
+	// TransportMCPHTTP is the Streamable HTTP transport at /api/mcp
+	TransportMCPHTTP = "mcp-http"
+	// TransportMCPStdio is the stdio transport of `system-bridge mcp stdio`
+	TransportMCPStdio = "mcp-stdio"
+)
+
+// sessionKey is the context key holding the caller's session
+type sessionKey struct{}
+
+// session holds the state of a connected MCP client
+type session struct {
+	id          string
+	transport   string
+	address     string
+	connectedAt time.Time
+	// write sends a message to the client and close disconnects it. Both are
+	// called with writeMux held.
+	write       func(message any) error
+	close       func()
+	writeMux    sync.Mutex
+	mutex       sync.RWMutex
+	client      *event.ClientInfo
+	modules     map[types.ModuleName]bool
+	messagesIn  atomic.Uint64
+	messagesOut atomic.Uint64
+}
+
+func newSession(transport string, address string, write func(message any) error, close func()) *session {
+	return &session{
+		id:          uuid.NewString(),
+		transport:   transport,
+		address:     address,
+		connectedAt: time.Now(),
+		write:       write,
+		close:       close,
+		modules:     make(map[types.ModuleName]bool),
+	}
+}
+
+// newWebSocketSession creates a session for a WebSocket connection
+func newWebSocketSession(conn *websocket.Conn) *session {
+	return newSession(TransportMCP, conn.RemoteAddr().String(), conn.WriteJSON, func() {
+		message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "Disconnected by server")
+		if err := conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+			slog.Debug("MCP: Failed to send close message", "remote", conn.RemoteAddr().String(), "error", err)
+		}
+		if err := conn.Close(); err != nil {
+			slog.Debug("MCP: Error closing connection", "remote", conn.RemoteAddr().String(), "error", err)
+		}
+	})
+}
+
+// sessionFromContext returns the session a request was received on, if any
+func sessionFromContext(ctx context.Context) *session {
+	sess, _ := ctx.Value(sessionKey{}).(*session)
+	return sess
+}
+
+// connectionID returns the connection ID used for router events and audit logs
+func connectionID(ctx context.Context) string {
+	if sess := sessionFromContext(ctx); sess != nil {
+		return "mcp:" + sess.address
+	}
+	return "mcp"
+}
+
+// setClient stores the client metadata from the initialize request
+func (c *session) setClient(client event.ClientInfo) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.client = &client
+}
+
+// subscribe adds a data module resource subscription
+func (c *session) subscribe(module types.ModuleName) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.modules[module] = true
+}
+
+// unsubscribe removes a data module resource subscription
+func (c *session) unsubscribe(module types.ModuleName) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.modules, module)
+}
+
+// isSubscribed reports whether the session is subscribed to a data module resource
+func (c *session) isSubscribed(module types.ModuleName) bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.modules[module]
+}
+
+// send writes a message to the client
+func (c *session) send(message any) error {
+	c.writeMux.Lock()
+	defer c.writeMux.Unlock()
+	if err := c.write(message); err != nil {
+		return err
+	}
+	c.messagesOut.Add(1)
+	return nil
+}
+
+// snapshot returns the public view of the session
+func (c *session) snapshot() event.ClientSession {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	sess := event.ClientSession{
+		Transport:   c.transport,
+		Address:     c.address,
+		SessionID:   c.id,
+		ConnectedAt: c.connectedAt,
+		Modules:     make([]types.ModuleName, 0, len(c.modules)),
+		MessagesIn:  c.messagesIn.Load(),
+		MessagesOut: c.messagesOut.Load(),
+	}
+	for module := range c.modules {
+		sess.Modules = append(sess.Modules, module)
+	}
+	slices.Sort(sess.Modules)
+	if c.client != nil {
+		client := *c.client
+		sess.Client = &client
+	}
+	return sess
+}
+
+// addSession registers a connected client
+func (s *MCPServer) addSession(sess *session) {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+	s.sessions[sess.id] = sess
+}
+
+// removeSession unregisters a disconnected client
+func (s *MCPServer) removeSession(sess *session) {
+	s.sessionsMutex.Lock()
+	defer s.sessionsMutex.Unlock()
+	delete(s.sessions, sess.id)
+}
+
+// Sessions returns all connected sessions, oldest first
+func (s *MCPServer) Sessions() []event.ClientSession {
+	s.sessionsMutex.RLock()
+	defer s.sessionsMutex.RUnlock()
+
+	sessions := make([]event.ClientSession, 0, len(s.sessions))
+	for _, sess := range s.sessions {
+		sessions = append(sessions, sess.snapshot())
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ConnectedAt.Before(sessions[j].ConnectedAt)
+	})
+	return sessions
+}
+
+// Disconnect closes the connection with the given session ID
+func (s *MCPServer) Disconnect(sessionID string) bool {
+	s.sessionsMutex.Lock()
+	sess, ok := s.sessions[sessionID]
+	delete(s.sessions, sessionID)
+	s.sessionsMutex.Unlock()
+	s.removeHTTPSession(sessionID)
+
+	if !ok {
+		return false
+	}
+
+	slog.Info("MCP: Disconnecting client", "remote", sess.address, "session", sess.id, "transport", sess.transport)
+
+	sess.writeMux.Lock()
+	defer sess.writeMux.Unlock()
+	sess.close()
+	return true
+}
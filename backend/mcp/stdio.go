@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"log/slog"
+
+	"github.com/gorilla/websocket"
+)
+
+// maxStdioMessageSize is the largest newline-delimited message read from stdin
+const maxStdioMessageSize = 4 * 1024 * 1024
+
+// ServeStdio serves MCP over newline-delimited JSON-RPC messages read from r
+// and written to w, until r is closed or the context is cancelled
+func (s *MCPServer) ServeStdio(ctx context.Context, r io.Reader, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	done := make(chan struct{})
+	sess := newSession(TransportMCPStdio, "stdio", encoder.Encode, func() {
+		close(done)
+	})
+	s.addSession(sess)
+	defer s.removeSession(sess)
+
+	slog.Info("MCP client connected", "remote", sess.address, "session", sess.id, "transport", sess.transport)
+
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxStdioMessageSize)
+		for scanner.Scan() {
+			line := append([]byte(nil), scanner.Bytes()...)
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			case <-done:
+				return
+			}
+		}
+		readErr <- scanner.Err()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-done:
+			return nil
+		case err := <-readErr:
+			slog.Info("MCP client disconnected", "remote", sess.address, "session", sess.id)
+			return err
+		case line := <-lines:
+			if len(line) == 0 {
+				continue
+			}
+			if response, ok := s.handleMessage(sess, line); ok {
+				s.sendResponse(sess, response)
+			}
+		}
+	}
+}
+
+// ProxyStdio relays newline-delimited JSON-RPC messages between r and w and
+// the MCP WebSocket endpoint of a running backend
+func ProxyStdio(ctx context.Context, endpoint string, token string, r io.Reader, w io.Writer) error {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, endpoint, header)
+	if resp != nil && resp.Body != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", endpoint, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			slog.Debug("Error closing MCP proxy connection", "error", err)
+		}
+	}()
+
+	// Relay backend messages to stdout
+	remoteErr := make(chan error, 1)
+	go func() {
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				remoteErr <- err
+				return
+			}
+			if _, err := fmt.Fprintf(w, "%s\n", message); err != nil {
+				remoteErr <- err
+				return
+			}
+		}
+	}()
+
+	// Relay stdin messages to the backend
+	localErr := make(chan error, 1)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxStdioMessageSize)
+		for scanner.Scan() {
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+			if err := conn.WriteMessage(websocket.TextMessage, scanner.Bytes()); err != nil {
+				localErr <- err
+				return
+			}
+		}
+		localErr <- scanner.Err()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-remoteErr:
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			return nil
+		}
+		return err
+	case err := <-localErr:
+		// stdin closed: let the backend know we are done
+		message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+		if closeErr := conn.WriteMessage(websocket.CloseMessage, message); closeErr != nil && !errors.Is(closeErr, websocket.ErrCloseSent) {
+			slog.Debug("Failed to send MCP proxy close message", "error", closeErr)
+		}
+		// Wait for the backend to close so in-flight responses are delivered
+		select {
+		case <-remoteErr:
+		case <-time.After(time.Second):
+		}
+		return err
+	}
+}
@@ -0,0 +1,60 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeStdio(t *testing.T) {
+	server, _ := newResourceTestServer(t)
+
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.ServeStdio(context.Background(), stdinReader, stdoutWriter)
+	}()
+
+	stdout := bufio.NewReader(stdoutReader)
+	readResponse := func() MCPResponse {
+		line, err := stdout.ReadBytes('\n')
+		require.NoError(t, err)
+		var response MCPResponse
+		require.NoError(t, json.Unmarshal(line, &response))
+		return response
+	}
+
+	_, err := io.WriteString(stdinWriter, `{"jsonrpc":"2.0","id":1,"method":"initialize"}`+"\n")
+	require.NoError(t, err)
+	initialized := readResponse()
+	assert.Nil(t, initialized.Error)
+	assert.EqualValues(t, 1, initialized.ID)
+
+	// Notifications get no response, so the next line answers the request after it
+	_, err = io.WriteString(stdinWriter, `{"jsonrpc":"2.0","method":"notifications/initialized"}`+"\n"+`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`+"\n")
+	require.NoError(t, err)
+	listed := readResponse()
+	assert.Nil(t, listed.Error)
+	assert.EqualValues(t, 2, listed.ID)
+
+	sessions := server.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, TransportMCPStdio, sessions[0].Transport)
+
+	require.NoError(t, stdinWriter.Close())
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ServeStdio did not return after stdin was closed")
+	}
+	assert.Empty(t, server.Sessions())
+}
@@ -0,0 +1,326 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"log/slog"
+)
+
+const (
+	// SessionIDHeader carries the session ID of Streamable HTTP requests
+	SessionIDHeader = "Mcp-Session-Id"
+	// maxHTTPMessageSize is the largest JSON-RPC message accepted over HTTP
+	maxHTTPMessageSize = 4 * 1024 * 1024
+	// httpStreamBuffer is the number of server messages held for a session's
+	// SSE stream before new messages are dropped
+	httpStreamBuffer = 64
+	// httpSessionIdleTimeout is how long a Streamable HTTP session without an
+	// open stream is kept after its last request
+	httpSessionIdleTimeout = 30 * time.Minute
+)
+
+// httpSession holds the Streamable HTTP state of a session
+type httpSession struct {
+	messages   chan any
+	done       chan struct{}
+	closeOnce  sync.Once
+	streaming  atomic.Int32
+	lastActive atomic.Int64
+}
+
+// newHTTPSession creates a session for the Streamable HTTP transport. Server
+// messages are queued for the session's SSE stream.
+func newHTTPSession(address string) (*session, *httpSession) {
+	h := &httpSession{
+		messages: make(chan any, httpStreamBuffer),
+		done:     make(chan struct{}),
+	}
+	h.touch()
+
+	sess := newSession(TransportMCPHTTP, address, func(message any) error {
+		select {
+		case h.messages <- message:
+			return nil
+		default:
+			return fmt.Errorf("stream buffer full")
+		}
+	}, func() {
+		h.closeOnce.Do(func() { close(h.done) })
+	})
+	return sess, h
+}
+
+// touch records activity on the session
+func (h *httpSession) touch() {
+	h.lastActive.Store(time.Now().UnixNano())
+}
+
+// idle reports whether the session has expired
+func (h *httpSession) idle(now time.Time) bool {
+	return h.streaming.Load() == 0 && now.Sub(time.Unix(0, h.lastActive.Load())) > httpSessionIdleTimeout
+}
+
+// handleStreamableHTTP serves the Streamable HTTP transport
+func (s *MCPServer) handleStreamableHTTP(w http.ResponseWriter, r *http.Request) {
+	// Browsers send an Origin header, which is checked to prevent DNS rebinding
+	if origin := r.Header.Get("Origin"); origin != "" && !allowedOrigin(origin, r.Host) {
+		slog.Warn("MCP HTTP request rejected: origin not allowed", "origin", origin)
+		writeHTTPError(w, http.StatusForbidden, "Origin not allowed")
+		return
+	}
+
+	if requestToken(r) != s.token {
+		slog.Warn("MCP HTTP request rejected: invalid token")
+		writeHTTPError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		s.handleHTTPPost(w, r)
+	case http.MethodGet:
+		s.handleHTTPStream(w, r)
+	case http.MethodDelete:
+		s.handleHTTPDelete(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST, DELETE")
+		writeHTTPError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// allowedOrigin reports whether a browser origin may use the Streamable HTTP
+// transport. Loopback origins are allowed, as are origins addressing the
+// server by the same IP address as the request. Host names other than
+// localhost are rejected because they can be rebound to a local address.
+func allowedOrigin(origin string, requestHost string) bool {
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	hostname := u.Hostname()
+	if strings.EqualFold(hostname, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(hostname)
+	if ip == nil {
+		return false
+	}
+	return ip.IsLoopback() || strings.EqualFold(u.Host, requestHost)
+}
+
+// handleHTTPPost handles a JSON-RPC message sent by a client
+func (s *MCPServer) handleHTTPPost(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxHTTPMessageSize))
+	if err != nil {
+		writeHTTPError(w, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+
+	var req MCPRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		slog.Error("Failed to parse MCP request", "error", err, "raw_message", string(body))
+		writeJSONRPC(w, http.StatusBadRequest, NewErrorResponse(nil, ErrorCodeParseError, "Parse error", nil))
+		return
+	}
+
+	var sess *session
+	if req.Method == "initialize" {
+		s.expireHTTPSessions()
+
+		var h *httpSession
+		sess, h = newHTTPSession(r.RemoteAddr)
+		s.httpSessionsMutex.Lock()
+		s.httpSessions[sess.id] = h
+		s.httpSessionsMutex.Unlock()
+		s.addSession(sess)
+
+		slog.Info("MCP client connected", "remote", sess.address, "session", sess.id, "transport", sess.transport)
+	} else {
+		var ok bool
+		if sess, _, ok = s.httpSessionFromRequest(w, r); !ok {
+			return
+		}
+	}
+	w.Header().Set(SessionIDHeader, sess.id)
+
+	sess.messagesIn.Add(1)
+	slog.Debug("MCP request parsed", "method", req.Method, "id", req.ID, "session", sess.id)
+
+	// Notifications and responses are accepted without a reply
+	if req.ID == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), sessionKey{}, sess)
+	response := s.HandleRequest(ctx, req)
+	sess.messagesOut.Add(1)
+
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		if err := writeSSE(w, response); err != nil {
+			slog.Error("Failed to send MCP response", "error", err)
+		}
+		return
+	}
+	writeJSONRPC(w, http.StatusOK, response)
+}
+
+// handleHTTPStream opens an SSE stream for server notifications
+func (s *MCPServer) handleHTTPStream(w http.ResponseWriter, r *http.Request) {
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		w.Header().Set("Allow", "POST, DELETE")
+		writeHTTPError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	sess, h, ok := s.httpSessionFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeHTTPError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	h.streaming.Add(1)
+	defer func() {
+		h.streaming.Add(-1)
+		h.touch()
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set(SessionIDHeader, sess.id)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	slog.Debug("MCP HTTP stream opened", "session", sess.id)
+
+	for {
+		select {
+		case <-r.Context().Done():
+			slog.Debug("MCP HTTP stream closed", "session", sess.id)
+			return
+		case <-h.done:
+			return
+		case message := <-h.messages:
+			if err := writeSSE(w, message); err != nil {
+				slog.Debug("Failed to write MCP HTTP stream", "session", sess.id, "error", err)
+				return
+			}
+		}
+	}
+}
+
+// handleHTTPDelete terminates a session
+func (s *MCPServer) handleHTTPDelete(w http.ResponseWriter, r *http.Request) {
+	sess, _, ok := s.httpSessionFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	s.Disconnect(sess.id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// httpSessionFromRequest returns the session named by the request's session
+// header, writing an error response if there is none
+func (s *MCPServer) httpSessionFromRequest(w http.ResponseWriter, r *http.Request) (*session, *httpSession, bool) {
+	sessionID := r.Header.Get(SessionIDHeader)
+	if sessionID == "" {
+		writeHTTPError(w, http.StatusBadRequest, "Missing "+SessionIDHeader+" header")
+		return nil, nil, false
+	}
+
+	s.httpSessionsMutex.RLock()
+	h, ok := s.httpSessions[sessionID]
+	s.httpSessionsMutex.RUnlock()
+
+	s.sessionsMutex.RLock()
+	sess, exists := s.sessions[sessionID]
+	s.sessionsMutex.RUnlock()
+
+	if !ok || !exists {
+		writeHTTPError(w, http.StatusNotFound, "Session not found")
+		return nil, nil, false
+	}
+
+	h.touch()
+	return sess, h, true
+}
+
+// removeHTTPSession forgets the Streamable HTTP state of a session
+func (s *MCPServer) removeHTTPSession(sessionID string) {
+	s.httpSessionsMutex.Lock()
+	defer s.httpSessionsMutex.Unlock()
+	delete(s.httpSessions, sessionID)
+}
+
+// expireHTTPSessions disconnects Streamable HTTP sessions that have been idle
+// for longer than httpSessionIdleTimeout
+func (s *MCPServer) expireHTTPSessions() {
+	now := time.Now()
+
+	s.httpSessionsMutex.RLock()
+	expired := make([]string, 0)
+	for sessionID, h := range s.httpSessions {
+		if h.idle(now) {
+			expired = append(expired, sessionID)
+		}
+	}
+	s.httpSessionsMutex.RUnlock()
+
+	for _, sessionID := range expired {
+		slog.Debug("MCP HTTP session expired", "session", sessionID)
+		s.Disconnect(sessionID)
+	}
+}
+
+// writeSSE writes a message as a server-sent event
+func writeSSE(w http.ResponseWriter, message any) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: message\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	if flusher, ok := w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// writeJSONRPC writes a JSON-RPC response as the HTTP response body
+func writeJSONRPC(w http.ResponseWriter, status int, response MCPResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		slog.Error("Failed to encode response", "error", err)
+	}
+}
+
+// writeHTTPError writes a JSON error body
+func writeHTTPError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(map[string]string{"error": message}); err != nil {
+		slog.Error("Failed to encode response", "error", err)
+	}
+}
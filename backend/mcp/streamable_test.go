@@ -0,0 +1,136 @@
+package mcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postMCP(t *testing.T, url string, sessionID string, accept string, body string) *http.Response {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer test-token")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", accept)
+	if sessionID != "" {
+		req.Header.Set(SessionIDHeader, sessionID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = resp.Body.Close()
+	})
+	return resp
+}
+
+func TestStreamableHTTP(t *testing.T) {
+	server, _ := newResourceTestServer(t)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	resp := postMCP(t, httpServer.URL, "", "application/json", `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"clientInfo":{"name":"test-client","version":"1.0.0"}}}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	sessionID := resp.Header.Get(SessionIDHeader)
+	require.NotEmpty(t, sessionID)
+
+	var initialized MCPResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&initialized))
+	require.Nil(t, initialized.Error)
+
+	sessions := server.Sessions()
+	require.Len(t, sessions, 1)
+	assert.Equal(t, TransportMCPHTTP, sessions[0].Transport)
+
+	t.Run("Notification is accepted", func(t *testing.T) {
+		resp := postMCP(t, httpServer.URL, sessionID, "application/json", `{"jsonrpc":"2.0","method":"notifications/initialized"}`)
+		assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	})
+
+	t.Run("Missing session", func(t *testing.T) {
+		resp := postMCP(t, httpServer.URL, "", "application/json", `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Unknown session", func(t *testing.T) {
+		resp := postMCP(t, httpServer.URL, "does-not-exist", "application/json", `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("SSE response", func(t *testing.T) {
+		resp := postMCP(t, httpServer.URL, sessionID, "application/json, text/event-stream", `{"jsonrpc":"2.0","id":3,"method":"tools/list"}`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(resp.Body)
+		eventLine, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, "event: message\n", eventLine)
+
+		dataLine, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		var listed MCPResponse
+		require.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(dataLine, "data: ")), &listed))
+		assert.Nil(t, listed.Error)
+		assert.EqualValues(t, 3, listed.ID)
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		resp, err := http.Post(httpServer.URL, "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"initialize"}`))
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Origin", func(t *testing.T) {
+		origins := map[string]int{
+			"http://localhost:5173":    http.StatusOK,
+			"http://127.0.0.1:9170":    http.StatusOK,
+			"http://[::1]:9170":        http.StatusOK,
+			httpServer.URL:             http.StatusOK,
+			"http://evil.example.com":  http.StatusForbidden,
+			"http://192.168.1.50:9170": http.StatusForbidden,
+			"null":                     http.StatusForbidden,
+		}
+		for origin, status := range origins {
+			req, err := http.NewRequest(http.MethodPost, httpServer.URL, strings.NewReader(`{"jsonrpc":"2.0","id":5,"method":"tools/list"}`))
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer test-token")
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Accept", "application/json")
+			req.Header.Set(SessionIDHeader, sessionID)
+			req.Header.Set("Origin", origin)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			_ = resp.Body.Close()
+			assert.Equal(t, status, resp.StatusCode, origin)
+		}
+	})
+
+	t.Run("Delete terminates the session", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodDelete, httpServer.URL, nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer test-token")
+		req.Header.Set(SessionIDHeader, sessionID)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Empty(t, server.Sessions())
+
+		resp = postMCP(t, httpServer.URL, sessionID, "application/json", `{"jsonrpc":"2.0","id":4,"method":"tools/list"}`)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -1,10 +1,140 @@
 package mcp
 
+import (
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// Tool names
+const (
+	ToolGetData            = "system_bridge_get_data"
+	ToolSendNotification   = "system_bridge_send_notification"
+	ToolMediaControl       = "system_bridge_media_control"
+	ToolOpen               = "system_bridge_open"
+	ToolKeyboardKeypress   = "system_bridge_keyboard_keypress"
+	ToolKeyboardText       = "system_bridge_keyboard_text"
+	ToolKeyboardSequence   = "system_bridge_keyboard_sequence"
+	ToolGetKeyboardKeys    = "system_bridge_get_keyboard_keys"
+	ToolMouseMove          = "system_bridge_mouse_move"
+	ToolMouseClick         = "system_bridge_mouse_click"
+	ToolMouseScroll        = "system_bridge_mouse_scroll"
+	ToolMouseDrag          = "system_bridge_mouse_drag"
+	ToolGetMousePosition   = "system_bridge_get_mouse_position"
+	ToolGetDirectories     = "system_bridge_get_directories"
+	ToolGetDirectory       = "system_bridge_get_directory"
+	ToolGetFiles           = "system_bridge_get_files"
+	ToolGetFile            = "system_bridge_get_file"
+	ToolMediaLibrarySearch = "system_bridge_media_library_search"
+	ToolValidateDirectory  = "system_bridge_validate_directory"
+	ToolCommandExecute     = "system_bridge_command_execute"
+	ToolCommandStart       = "system_bridge_command_start"
+	ToolCommandGetJob      = "system_bridge_command_get_job"
+	ToolRunMacro           = "system_bridge_run_macro"
+	ToolGetSettings        = "system_bridge_get_settings"
+	ToolUpdateSettings     = "system_bridge_update_settings"
+	ToolPowerHibernate     = "system_bridge_power_hibernate"
+	ToolPowerLock          = "system_bridge_power_lock"
+	ToolPowerLogout        = "system_bridge_power_logout"
+	ToolPowerRestart       = "system_bridge_power_restart"
+	ToolPowerShutdown      = "system_bridge_power_shutdown"
+	ToolPowerSleep         = "system_bridge_power_sleep"
+	ToolExitApplication    = "system_bridge_exit_application"
+)
+
+// dangerousTools can change system state in ways that are hard to undo, so
+// they are not exposed unless enabled in the MCP settings
+var dangerousTools = map[string]bool{
+	ToolOpen:             true,
+	ToolKeyboardKeypress: true,
+	ToolKeyboardText:     true,
+	ToolKeyboardSequence: true,
+	ToolMouseMove:        true,
+	ToolMouseClick:       true,
+	ToolMouseScroll:      true,
+	ToolMouseDrag:        true,
+	ToolUpdateSettings:   true,
+	ToolPowerHibernate:   true,
+	ToolPowerLock:        true,
+	ToolPowerLogout:      true,
+	ToolPowerRestart:     true,
+	ToolPowerShutdown:    true,
+	ToolPowerSleep:       true,
+	ToolExitApplication:  true,
+	// Macros can include steps for any of the tools above
+	ToolRunMacro: true,
+}
+
+// IsToolEnabled reports whether a tool is exposed over MCP. Tools without an
+// entry in the MCP settings fall back to their default, which is disabled for
+// dangerous tools.
+func IsToolEnabled(name string, cfg *settings.Settings) bool {
+	if cfg != nil {
+		if enabled, ok := cfg.MCP.Tools[name]; ok {
+			return enabled
+		}
+	}
+	return !dangerousTools[name]
+}
+
+// GetEnabledToolDefinitions returns the MCP tools enabled in the settings
+func GetEnabledToolDefinitions(cfg *settings.Settings) []Tool {
+	tools := make([]Tool, 0)
+	for _, tool := range GetToolDefinitions() {
+		if IsToolEnabled(tool.Name, cfg) {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// emptySchema is the input schema for tools that take no arguments
+func emptySchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+}
+
+// mousePositionProperties returns the schema properties of a pointer position
+func mousePositionProperties() map[string]interface{} {
+	return map[string]interface{}{
+		"x": map[string]interface{}{
+			"type":        "integer",
+			"description": "Horizontal position in pixels",
+		},
+		"y": map[string]interface{}{
+			"type":        "integer",
+			"description": "Vertical position in pixels",
+		},
+		"display": map[string]interface{}{
+			"type":        "string",
+			"description": "ID or name of a display from the displays module. When set, x and y are relative to the display's top left corner.",
+		},
+	}
+}
+
+// withMouseRelative adds the relative flag to pointer position properties
+func withMouseRelative(properties map[string]interface{}) map[string]interface{} {
+	properties["relative"] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Move by x and y from the current position instead of to a position",
+	}
+	return properties
+}
+
+// mouseButtonProperty returns the schema property of a mouse button
+func mouseButtonProperty() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"description": "Mouse button, defaulting to left",
+		"enum":        []string{"left", "right", "middle"},
+	}
+}
+
 // GetToolDefinitions returns all available MCP tools
 func GetToolDefinitions() []Tool {
 	return []Tool{
 		{
-			Name:        "system_bridge_get_data",
+			Name:        ToolGetData,
 			Description: "Get system information from data modules (cpu, memory, disks, battery, displays, gpus, media, network, processes, system, sensors)",
 			InputSchema: map[string]interface{}{
 				"type": "object",
@@ -25,7 +155,7 @@ func GetToolDefinitions() []Tool {
 			},
 		},
 		{
-			Name:        "system_bridge_send_notification",
+			Name:        ToolSendNotification,
 			Description: "Send a desktop notification to the system",
 			InputSchema: map[string]interface{}{
 				"type": "object",
@@ -42,12 +172,28 @@ func GetToolDefinitions() []Tool {
 						"type":        "string",
 						"description": "Icon name (optional)",
 					},
+					"duration": map[string]interface{}{
+						"type":        "integer",
+						"description": "Duration to show the notification for in milliseconds (optional)",
+					},
+					"actionUrl": map[string]interface{}{
+						"type":        "string",
+						"description": "URL to open when the notification is clicked (optional)",
+					},
+					"actionPath": map[string]interface{}{
+						"type":        "string",
+						"description": "File or folder path to open when the notification is clicked (optional)",
+					},
+					"sound": map[string]interface{}{
+						"type":        "string",
+						"description": "Path to a sound file to play (optional)",
+					},
 				},
 				"required": []string{"title", "message"},
 			},
 		},
 		{
-			Name:        "system_bridge_media_control",
+			Name:        ToolMediaControl,
 			Description: "Control media playback on the system",
 			InputSchema: map[string]interface{}{
 				"type": "object",
@@ -55,11 +201,475 @@ func GetToolDefinitions() []Tool {
 					"action": map[string]interface{}{
 						"type":        "string",
 						"description": "Media control action to perform (must be uppercase)",
-						"enum":        []string{"PLAY", "PAUSE", "STOP", "NEXT", "PREVIOUS", "VOLUME_UP", "VOLUME_DOWN", "MUTE"},
+						"enum":        []string{"PLAY", "PAUSE", "STOP", "NEXT", "PREVIOUS", "VOLUME_UP", "VOLUME_DOWN", "MUTE", "SEEK", "SET_VOLUME", "SET_SHUFFLE", "SET_REPEAT", "SET_RATE"},
+					},
+					"player": map[string]interface{}{
+						"type":        "string",
+						"description": "Name of the player to control, from the players in the media module. Defaults to the active player.",
+					},
+					"position": map[string]interface{}{
+						"type":        "number",
+						"description": "Position to seek to in seconds for SEEK, or the offset to seek by when relative is true",
+					},
+					"relative": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Seek by position from the current position instead of to it",
+					},
+					"volume": map[string]interface{}{
+						"type":        "number",
+						"description": "Volume to set for SET_VOLUME",
+						"minimum":     0,
+						"maximum":     100,
+					},
+					"shuffle": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Shuffle state to set for SET_SHUFFLE",
+					},
+					"repeat": map[string]interface{}{
+						"type":        "string",
+						"description": "Repeat mode to set for SET_REPEAT",
+						"enum":        []string{"NONE", "TRACK", "PLAYLIST"},
+					},
+					"rate": map[string]interface{}{
+						"type":             "number",
+						"description":      "Playback rate to set for SET_RATE, where 1 is normal speed",
+						"exclusiveMinimum": 0,
 					},
 				},
 				"required": []string{"action"},
 			},
 		},
+		{
+			Name:        ToolOpen,
+			Description: "Open a file path or URL with the system's default application",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": map[string]interface{}{
+						"type":        "string",
+						"description": "File or folder path to open",
+					},
+					"url": map[string]interface{}{
+						"type":        "string",
+						"description": "URL to open",
+					},
+				},
+			},
+		},
+		{
+			Name:        ToolKeyboardKeypress,
+			Description: "Press a key on the keyboard, optionally with modifiers",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"key": map[string]interface{}{
+						"type":        "string",
+						"description": "Key to press (e.g. a, enter, f5, audio_play)",
+					},
+					"modifiers": map[string]interface{}{
+						"type":        "array",
+						"description": "Modifier keys to hold while pressing the key",
+						"items": map[string]interface{}{
+							"type": "string",
+							"enum": []string{"alt", "ctrl", "shift", "cmd"},
+						},
+					},
+					"delay": map[string]interface{}{
+						"type":        "integer",
+						"description": "Delay before pressing the key in milliseconds",
+					},
+				},
+				"required": []string{"key"},
+			},
+		},
+		{
+			Name:        ToolKeyboardText,
+			Description: "Type text using the keyboard",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"text": map[string]interface{}{
+						"type":        "string",
+						"description": "Text to type",
+					},
+					"delay": map[string]interface{}{
+						"type":        "integer",
+						"description": "Delay before typing in milliseconds",
+					},
+				},
+				"required": []string{"text"},
+			},
+		},
+		{
+			Name:        ToolKeyboardSequence,
+			Description: "Send a sequence of keyboard steps, such as holding shift while tapping keys. Keys still held at the end are released.",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"steps": map[string]interface{}{
+						"type":        "array",
+						"description": "Steps to run in order",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"type": map[string]interface{}{
+									"type": "string",
+									"enum": []string{"key_down", "key_up", "tap", "text", "sleep"},
+								},
+								"key": map[string]interface{}{
+									"type":        "string",
+									"description": "Key for key_down, key_up and tap steps, from system_bridge_get_keyboard_keys",
+								},
+								"modifiers": map[string]interface{}{
+									"type":        "array",
+									"description": "Modifier keys to hold while tapping the key",
+									"items": map[string]interface{}{
+										"type": "string",
+										"enum": []string{"alt", "ctrl", "shift", "cmd"},
+									},
+								},
+								"text": map[string]interface{}{
+									"type":        "string",
+									"description": "Text to type for text steps",
+								},
+								"duration": map[string]interface{}{
+									"type":        "integer",
+									"description": "Time to wait in milliseconds for sleep steps",
+								},
+							},
+							"required": []string{"type"},
+						},
+					},
+				},
+				"required": []string{"steps"},
+			},
+		},
+		{
+			Name:        ToolGetKeyboardKeys,
+			Description: "List the key names and modifiers that keyboard tools accept",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolMouseMove,
+			Description: "Move the mouse pointer to a position, or by an offset",
+			InputSchema: map[string]interface{}{
+				"type":       "object",
+				"properties": withMouseRelative(mousePositionProperties()),
+				"required":   []string{"x", "y"},
+			},
+		},
+		{
+			Name:        ToolMouseClick,
+			Description: "Click a mouse button, optionally moving the pointer first",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"button": mouseButtonProperty(),
+					"double": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Double click",
+					},
+					"position": map[string]interface{}{
+						"type":        "object",
+						"description": "Position to move the pointer to before clicking",
+						"properties":  mousePositionProperties(),
+						"required":    []string{"x", "y"},
+					},
+				},
+			},
+		},
+		{
+			Name:        ToolMouseScroll,
+			Description: "Scroll the mouse wheel at the pointer position",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"direction": map[string]interface{}{
+						"type": "string",
+						"enum": []string{"up", "down", "left", "right"},
+					},
+					"amount": map[string]interface{}{
+						"type":        "integer",
+						"description": "Number of scroll steps, defaulting to 1",
+						"minimum":     1,
+					},
+				},
+				"required": []string{"direction"},
+			},
+		},
+		{
+			Name:        ToolMouseDrag,
+			Description: "Drag with a mouse button held from one position to another",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"from": map[string]interface{}{
+						"type":        "object",
+						"description": "Position to start from, defaulting to the pointer position",
+						"properties":  mousePositionProperties(),
+						"required":    []string{"x", "y"},
+					},
+					"to": map[string]interface{}{
+						"type":        "object",
+						"description": "Position to drag to",
+						"properties":  mousePositionProperties(),
+						"required":    []string{"x", "y"},
+					},
+					"button": mouseButtonProperty(),
+				},
+				"required": []string{"to"},
+			},
+		},
+		{
+			Name:        ToolGetMousePosition,
+			Description: "Get the mouse pointer position and the display it is on",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolGetDirectories,
+			Description: "List the base directories that can be browsed, including the user's media directories",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolGetDirectory,
+			Description: "Get a base directory by its key",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"base": map[string]interface{}{
+						"type":        "string",
+						"description": "Key of the base directory, as returned by system_bridge_get_directories",
+					},
+				},
+				"required": []string{"base"},
+			},
+		},
+		{
+			Name:        ToolGetFiles,
+			Description: "List the files in a base directory or one of its subdirectories",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"base": map[string]interface{}{
+						"type":        "string",
+						"description": "Key of the base directory, as returned by system_bridge_get_directories",
+					},
+					"path": map[string]interface{}{
+						"type":        "string",
+						"description": "Subdirectory path relative to the base directory (optional)",
+					},
+				},
+				"required": []string{"base"},
+			},
+		},
+		{
+			Name:        ToolGetFile,
+			Description: "Get information about a file",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": map[string]interface{}{
+						"type":        "string",
+						"description": "Path to the file",
+					},
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			Name:        ToolMediaLibrarySearch,
+			Description: "Search the music, videos and pictures in the configured media directories by their tags",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"query": map[string]interface{}{
+						"type":        "string",
+						"description": "Words that must all appear in the title, artist, album, genre or path",
+					},
+					"type": map[string]interface{}{
+						"type":        "string",
+						"enum":        []string{"audio", "video", "image"},
+						"description": "Only return items of this type",
+					},
+					"artist": map[string]interface{}{
+						"type":        "string",
+						"description": "Only return items by this artist or album artist",
+					},
+					"album": map[string]interface{}{
+						"type":        "string",
+						"description": "Only return items from this album",
+					},
+					"limit": map[string]interface{}{
+						"type":        "integer",
+						"description": "Most items to return (default 100, at most 1000)",
+					},
+					"offset": map[string]interface{}{
+						"type":        "integer",
+						"description": "Items to skip, for paging",
+					},
+				},
+			},
+		},
+		{
+			Name:        ToolValidateDirectory,
+			Description: "Check whether a path is a valid directory",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": map[string]interface{}{
+						"type":        "string",
+						"description": "Directory path to validate",
+					},
+				},
+				"required": []string{"path"},
+			},
+		},
+		{
+			Name:        ToolCommandExecute,
+			Description: "Run a command from the user's command allowlist and return its output",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"commandID": map[string]interface{}{
+						"type":        "string",
+						"description": "ID of the allowlisted command, as listed in the commands settings",
+					},
+					"params": map[string]interface{}{
+						"type":        "object",
+						"description": "Values for the command's declared parameters, keyed by parameter name",
+					},
+				},
+				"required": []string{"commandID"},
+			},
+		},
+		{
+			Name:        ToolCommandStart,
+			Description: "Start a command from the user's command allowlist in the background and return its job, for commands that take a long time",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"commandID": map[string]interface{}{
+						"type":        "string",
+						"description": "ID of the allowlisted command, as listed in the commands settings",
+					},
+					"params": map[string]interface{}{
+						"type":        "object",
+						"description": "Values for the command's declared parameters, keyed by parameter name",
+					},
+				},
+				"required": []string{"commandID"},
+			},
+		},
+		{
+			Name:        ToolCommandGetJob,
+			Description: "Get the status and output of a command job",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"jobID": map[string]interface{}{
+						"type":        "string",
+						"description": "ID of the job, as returned when the command was started",
+					},
+				},
+				"required": []string{"jobID"},
+			},
+		},
+		{
+			Name:        ToolRunMacro,
+			Description: "Run one of the user's macros, a named sequence of actions, and return the result of each step",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"macroID": map[string]interface{}{
+						"type":        "string",
+						"description": "ID of the macro, as listed in the macros settings",
+					},
+				},
+				"required": []string{"macroID"},
+			},
+		},
+		{
+			Name:        ToolGetSettings,
+			Description: "Get the System Bridge settings, including the command allowlist",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolUpdateSettings,
+			Description: "Update the System Bridge settings. Sections that are not provided are left unchanged, and sections that are provided are replaced as a whole, so send every field of a section from system_bridge_get_settings with your changes.",
+			InputSchema: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"autostart": map[string]interface{}{
+						"type":        "boolean",
+						"description": "Start System Bridge on login",
+					},
+					"logLevel": map[string]interface{}{
+						"type": "string",
+						"enum": []string{"DEBUG", "INFO", "WARN", "ERROR"},
+					},
+					"hotkeys": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type": "object",
+							"properties": map[string]interface{}{
+								"name": map[string]interface{}{"type": "string"},
+								"key":  map[string]interface{}{"type": "string"},
+							},
+						},
+					},
+					"commands": map[string]interface{}{
+						"type":        "object",
+						"description": "Command allowlist settings",
+					},
+					"media": map[string]interface{}{
+						"type":        "object",
+						"description": "Media directory settings",
+					},
+					"grpc": map[string]interface{}{
+						"type":        "object",
+						"description": "gRPC API settings",
+					},
+					"mcp": map[string]interface{}{
+						"type":        "object",
+						"description": "MCP settings",
+					},
+				},
+			},
+		},
+		{
+			Name:        ToolPowerHibernate,
+			Description: "Hibernate the system",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolPowerLock,
+			Description: "Lock the system",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolPowerLogout,
+			Description: "Log out the current user",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolPowerRestart,
+			Description: "Restart the system",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolPowerShutdown,
+			Description: "Shut down the system",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolPowerSleep,
+			Description: "Put the system to sleep",
+			InputSchema: emptySchema(),
+		},
+		{
+			Name:        ToolExitApplication,
+			Description: "Exit the System Bridge application",
+			InputSchema: emptySchema(),
+		},
 	}
 }
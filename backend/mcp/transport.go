@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"log/slog"
 
@@ -19,20 +20,34 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// HandleConnection handles a new MCP WebSocket connection
-func (s *MCPServer) HandleConnection(w http.ResponseWriter, r *http.Request) error {
-	// Check for token in query parameters or headers
-	token := r.URL.Query().Get("token")
-	if token == "" {
-		token = r.Header.Get("Authorization")
-		// Remove "Bearer " prefix if present
-		if len(token) > 7 && token[:7] == "Bearer " {
-			token = token[7:]
+// ServeHTTP serves /api/mcp. WebSocket upgrade requests use the WebSocket
+// transport and all other requests use the Streamable HTTP transport.
+func (s *MCPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		if err := s.HandleConnection(w, r); err != nil {
+			slog.Error("MCP connection error", "error", err)
 		}
+		return
 	}
+	s.handleStreamableHTTP(w, r)
+}
 
+// requestToken returns the API token of a request, from the token query
+// parameter, a Bearer Authorization header or the X-API-Token header
+func requestToken(r *http.Request) string {
+	if token := r.URL.Query().Get("token"); token != "" {
+		return token
+	}
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+		return token
+	}
+	return r.Header.Get("X-API-Token")
+}
+
+// HandleConnection handles a new MCP WebSocket connection
+func (s *MCPServer) HandleConnection(w http.ResponseWriter, r *http.Request) error {
 	// Validate token
-	if token != s.token {
+	if requestToken(r) != s.token {
 		slog.Warn("MCP connection rejected: invalid token")
 		http.Error(w, "Unauthorized", http.StatusUnauthorized)
 		return nil
@@ -45,21 +60,25 @@ func (s *MCPServer) HandleConnection(w http.ResponseWriter, r *http.Request) err
 		return err
 	}
 
-	slog.Info("MCP client connected", "remote", conn.RemoteAddr().String())
+	sess := newWebSocketSession(conn)
+	s.addSession(sess)
+
+	slog.Info("MCP client connected", "remote", sess.address, "session", sess.id, "transport", sess.transport)
 
 	// Handle messages in a goroutine
-	go s.handleMessages(conn)
+	go s.handleMessages(conn, sess)
 
 	return nil
 }
 
 // handleMessages handles incoming messages from a WebSocket connection
-func (s *MCPServer) handleMessages(conn *websocket.Conn) {
+func (s *MCPServer) handleMessages(conn *websocket.Conn, sess *session) {
 	defer func() {
+		s.removeSession(sess)
 		if err := conn.Close(); err != nil {
-			slog.Error("Error closing MCP connection", "error", err)
+			slog.Debug("Error closing MCP connection", "error", err)
 		}
-		slog.Info("MCP client disconnected", "remote", conn.RemoteAddr().String())
+		slog.Info("MCP client disconnected", "remote", sess.address, "session", sess.id)
 	}()
 
 	for {
@@ -72,39 +91,42 @@ func (s *MCPServer) handleMessages(conn *websocket.Conn) {
 			break
 		}
 
-		// Log raw message for debugging
-		slog.Debug("MCP raw message received", "message", string(message))
-
-		// Parse request
-		var req MCPRequest
-		if err := json.Unmarshal(message, &req); err != nil {
-			slog.Error("Failed to parse MCP request", "error", err, "raw_message", string(message))
-			response := NewErrorResponse(nil, ErrorCodeParseError, "Parse error", nil)
-			s.sendResponse(conn, response)
-			continue
+		if response, ok := s.handleMessage(sess, message); ok {
+			s.sendResponse(sess, response)
 		}
+	}
+}
 
-		slog.Debug("MCP request parsed", "method", req.Method, "id", req.ID)
+// handleMessage parses and handles a JSON-RPC message received on a session.
+// It returns false when the message needs no response.
+func (s *MCPServer) handleMessage(sess *session, message []byte) (MCPResponse, bool) {
+	sess.messagesIn.Add(1)
 
-		// Check if this is a notification (no ID means no response expected)
-		if req.ID == nil {
-			slog.Debug("Received notification (no response needed)", "method", req.Method)
-			// For notifications, just log and continue without responding
-			continue
-		}
+	// Log raw message for debugging
+	slog.Debug("MCP raw message received", "message", string(message))
+
+	// Parse request
+	var req MCPRequest
+	if err := json.Unmarshal(message, &req); err != nil {
+		slog.Error("Failed to parse MCP request", "error", err, "raw_message", string(message))
+		return NewErrorResponse(nil, ErrorCodeParseError, "Parse error", nil), true
+	}
 
-		// Handle request
-		ctx := context.Background()
-		response := s.HandleRequest(ctx, req)
+	slog.Debug("MCP request parsed", "method", req.Method, "id", req.ID)
 
-		// Send response
-		s.sendResponse(conn, response)
+	// Check if this is a notification (no ID means no response expected)
+	if req.ID == nil {
+		slog.Debug("Received notification (no response needed)", "method", req.Method)
+		return MCPResponse{}, false
 	}
+
+	ctx := context.WithValue(context.Background(), sessionKey{}, sess)
+	return s.HandleRequest(ctx, req), true
 }
 
-// sendResponse sends a response to the WebSocket client
-func (s *MCPServer) sendResponse(conn *websocket.Conn, response MCPResponse) {
-	if err := conn.WriteJSON(response); err != nil {
+// sendResponse sends a response to the client of a session
+func (s *MCPServer) sendResponse(sess *session, response MCPResponse) {
+	if err := sess.send(response); err != nil {
 		slog.Error("Failed to send MCP response", "error", err)
 	}
 }
@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	systembridgev1 "github.com/timmo001/system-bridge/proto/systembridge/v1"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// unmarshalOptions ignores fields the protobuf definitions do not know about,
+// so newer backend types do not break older generated code
+var unmarshalOptions = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+// moduleToProto converts a data module into its typed protobuf message
+func moduleToProto(module types.Module) (*systembridgev1.ModuleData, error) {
+	result := &systembridgev1.ModuleData{
+		Module:  string(module.Name),
+		Updated: module.Updated,
+	}
+
+	if module.Data == nil {
+		return result, nil
+	}
+
+	switch module.Name {
+	case types.ModuleBattery:
+		data := &systembridgev1.BatteryData{}
+		result.Data = &systembridgev1.ModuleData_Battery{Battery: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleCPU:
+		data := &systembridgev1.CPUData{}
+		result.Data = &systembridgev1.ModuleData_Cpu{Cpu: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleDisks:
+		data := &systembridgev1.DisksData{}
+		result.Data = &systembridgev1.ModuleData_Disks{Disks: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleDisplays:
+		data := &systembridgev1.DisplaysData{}
+		result.Data = &systembridgev1.ModuleData_Displays{Displays: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleGPUs:
+		data := &systembridgev1.GPUsData{}
+		result.Data = &systembridgev1.ModuleData_Gpus{Gpus: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleMedia:
+		data := &systembridgev1.MediaData{}
+		result.Data = &systembridgev1.ModuleData_Media{Media: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleMemory:
+		data := &systembridgev1.MemoryData{}
+		result.Data = &systembridgev1.ModuleData_Memory{Memory: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleNetworks:
+		data := &systembridgev1.NetworksData{}
+		result.Data = &systembridgev1.ModuleData_Networks{Networks: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleProcesses:
+		data := &systembridgev1.ProcessesData{}
+		result.Data = &systembridgev1.ModuleData_Processes{Processes: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleSensors:
+		data := &systembridgev1.SensorsData{}
+		result.Data = &systembridgev1.ModuleData_Sensors{Sensors: data}
+		return result, decodeModuleData(module.Data, data)
+	case types.ModuleSystem:
+		data := &systembridgev1.SystemData{}
+		result.Data = &systembridgev1.ModuleData_System{System: data}
+		return result, decodeModuleData(module.Data, data)
+	default:
+		return nil, fmt.Errorf("unknown module: %s", module.Name)
+	}
+}
+
+// decodeModuleData fills a protobuf message from module data. Modules whose
+// data is a list are generated as messages with a single "items" field.
+func decodeModuleData(value any, msg proto.Message) error {
+	var payload any = value
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		payload = map[string]any{"items": value}
+	}
+	return decodeJSON(payload, msg)
+}
+
+// decodeJSON converts a JSON-encodable value into a protobuf message
+func decodeJSON(value any, msg proto.Message) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode data: %w", err)
+	}
+	if string(b) == "null" {
+		return nil
+	}
+	if err := unmarshalOptions.Unmarshal(b, msg); err != nil {
+		return fmt.Errorf("failed to decode data: %w", err)
+	}
+	return nil
+}
+
+// encodeJSON converts a protobuf request into the map form event handlers
+// decode with mapstructure
+func encodeJSON(msg proto.Message) (map[string]any, error) {
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode request: %w", err)
+	}
+	result := make(map[string]any)
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode request: %w", err)
+	}
+	return result, nil
+}
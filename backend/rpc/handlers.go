@@ -0,0 +1,301 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/timmo001/system-bridge/event"
+	systembridgev1 "github.com/timmo001/system-bridge/proto/systembridge/v1"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+// dispatch routes a request through the event router and converts error
+// responses into gRPC status errors
+func (s *Server) dispatch(ctx context.Context, eventType event.EventType, req proto.Message) (event.MessageResponse, error) {
+	if _, ok := s.eventRouter.Handlers[eventType]; !ok {
+		return event.MessageResponse{}, status.Errorf(codes.Unimplemented, "no handler registered for %s", eventType)
+	}
+
+	data, err := encodeJSON(req)
+	if err != nil {
+		return event.MessageResponse{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := s.eventRouter.HandleMessage(connectionID(ctx), event.Message{
+		ID:    uuid.NewString(),
+		Event: eventType,
+		Data:  data,
+	})
+	if response.Type == event.ResponseTypeError {
+		message := response.Message
+		if message == "" {
+			message = string(response.Subtype)
+		}
+		return response, status.Error(errorCode(response.Subtype), message)
+	}
+
+	return response, nil
+}
+
+// action dispatches a request for a handler that does not return data
+func (s *Server) action(ctx context.Context, eventType event.EventType, req proto.Message) (*systembridgev1.ActionResponse, error) {
+	response, err := s.dispatch(ctx, eventType, req)
+	if err != nil {
+		return nil, err
+	}
+	return &systembridgev1.ActionResponse{Message: response.Message}, nil
+}
+
+// errorCode maps an event response subtype to a gRPC status code
+func errorCode(subtype event.ResponseSubtype) codes.Code {
+	switch subtype {
+	case event.ResponseSubtypeBadToken, event.ResponseSubtypeMissingToken:
+		return codes.Unauthenticated
+	case event.ResponseSubtypeCommandNotFound:
+		return codes.NotFound
+	case event.ResponseSubtypeUnknownEvent:
+		return codes.Unimplemented
+	case event.ResponseSubtypeNone, "":
+		return codes.Internal
+	default:
+		// BAD_* and MISSING_* subtypes describe invalid requests
+		return codes.InvalidArgument
+	}
+}
+
+// resolveModules validates requested module names, defaulting to all registered modules
+func (s *Server) resolveModules(requested []string) ([]types.ModuleName, error) {
+	registered := make([]types.ModuleName, 0)
+	for _, u := range s.dataStore.GetRegisteredModules() {
+		registered = append(registered, u.Name())
+	}
+
+	if len(requested) == 0 {
+		return registered, nil
+	}
+
+	modules := make([]types.ModuleName, 0, len(requested))
+	for _, name := range requested {
+		module := types.ModuleName(name)
+		if !slices.Contains(registered, module) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown module: %s", name)
+		}
+		if !slices.Contains(modules, module) {
+			modules = append(modules, module)
+		}
+	}
+
+	return modules, nil
+}
+
+// GetData returns the current data for the requested modules
+func (s *Server) GetData(ctx context.Context, req *systembridgev1.GetDataRequest) (*systembridgev1.GetDataResponse, error) {
+	modules, err := s.resolveModules(req.GetModules())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &systembridgev1.GetDataResponse{}
+	for _, name := range modules {
+		module, err := s.dataStore.GetModule(name)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "module not registered: %s", name)
+		}
+		moduleData, err := moduleToProto(module)
+		if err != nil {
+			slog.Error("gRPC: Failed to convert module data", "module", name, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert module data: %s", name)
+		}
+		response.Modules = append(response.Modules, moduleData)
+	}
+
+	return response, nil
+}
+
+func (s *Server) ExitApplication(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventExitApplication, req)
+}
+
+func (s *Server) GetSettings(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.SettingsResponse, error) {
+	response, err := s.dispatch(ctx, event.EventGetSettings, req)
+	if err != nil {
+		return nil, err
+	}
+	return settingsResponse(response)
+}
+
+func (s *Server) UpdateSettings(ctx context.Context, req *systembridgev1.UpdateSettingsRequest) (*systembridgev1.SettingsResponse, error) {
+	if req.GetSettings() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing settings")
+	}
+	response, err := s.dispatch(ctx, event.EventUpdateSettings, req.GetSettings())
+	if err != nil {
+		return nil, err
+	}
+	return settingsResponse(response)
+}
+
+func settingsResponse(response event.MessageResponse) (*systembridgev1.SettingsResponse, error) {
+	result := &structpb.Struct{}
+	if err := decodeJSON(response.Data, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &systembridgev1.SettingsResponse{Settings: result}, nil
+}
+
+func (s *Server) GetDirectories(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.GetDirectoriesResponse, error) {
+	response, err := s.dispatch(ctx, event.EventGetDirectories, req)
+	if err != nil {
+		return nil, err
+	}
+	result := &systembridgev1.GetDirectoriesResponse{}
+	if err := decodeJSON(map[string]any{"directories": response.Data}, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
+func (s *Server) GetDirectory(ctx context.Context, req *systembridgev1.GetDirectoryRequest) (*systembridgev1.Directory, error) {
+	response, err := s.dispatch(ctx, event.EventGetDirectory, req)
+	if err != nil {
+		return nil, err
+	}
+	result := &systembridgev1.Directory{}
+	if err := decodeJSON(response.Data, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
+func (s *Server) GetFiles(ctx context.Context, req *systembridgev1.GetFilesRequest) (*systembridgev1.GetFilesResponse, error) {
+	response, err := s.dispatch(ctx, event.EventGetFiles, req)
+	if err != nil {
+		return nil, err
+	}
+	result := &systembridgev1.GetFilesResponse{}
+	if err := decodeJSON(map[string]any{"files": response.Data}, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
+func (s *Server) GetFile(ctx context.Context, req *systembridgev1.GetFileRequest) (*systembridgev1.FileInfo, error) {
+	response, err := s.dispatch(ctx, event.EventGetFile, req)
+	if err != nil {
+		return nil, err
+	}
+	result := &systembridgev1.FileInfo{}
+	if err := decodeJSON(response.Data, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
+func (s *Server) ValidateDirectory(ctx context.Context, req *systembridgev1.ValidateDirectoryRequest) (*systembridgev1.ValidateDirectoryResponse, error) {
+	response, err := s.dispatch(ctx, event.EventValidateDirectory, req)
+	if err != nil {
+		return nil, err
+	}
+	result := &systembridgev1.ValidateDirectoryResponse{}
+	if err := decodeJSON(response.Data, result); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return result, nil
+}
+
+func (s *Server) Open(ctx context.Context, req *systembridgev1.OpenRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventOpen, req)
+}
+
+func (s *Server) KeyboardKeypress(ctx context.Context, req *systembridgev1.KeyboardKeypressRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventKeyboardKeypress, req)
+}
+
+func (s *Server) KeyboardText(ctx context.Context, req *systembridgev1.KeyboardTextRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventKeyboardText, req)
+}
+
+func (s *Server) MediaControl(ctx context.Context, req *systembridgev1.MediaControlRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventMediaControl, req)
+}
+
+func (s *Server) Notification(ctx context.Context, req *systembridgev1.NotificationRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventNotification, req)
+}
+
+func (s *Server) PowerHibernate(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerHibernate, req)
+}
+
+func (s *Server) PowerLock(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerLock, req)
+}
+
+func (s *Server) PowerLogout(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerLogout, req)
+}
+
+func (s *Server) PowerRestart(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerRestart, req)
+}
+
+func (s *Server) PowerShutdown(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerShutdown, req)
+}
+
+func (s *Server) PowerSleep(ctx context.Context, req *systembridgev1.EmptyRequest) (*systembridgev1.ActionResponse, error) {
+	return s.action(ctx, event.EventPowerSleep, req)
+}
+
+// CommandExecute runs an allowlisted command and returns its result once it
+// completes. Unlike the WebSocket API there is no separate completion callback.
+func (s *Server) CommandExecute(ctx context.Context, req *systembridgev1.CommandExecuteRequest) (*systembridgev1.CommandExecuteResponse, error) {
+	if req.GetCommandId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing command ID")
+	}
+
+	cfg, err := settings.Load()
+	if err != nil {
+		slog.Error("Failed to load settings", "error", err)
+		return nil, status.Error(codes.Internal, "failed to load settings")
+	}
+
+	params := make(map[string]any, len(req.GetParams()))
+	for name, value := range req.GetParams() {
+		params[name] = value
+	}
+
+	result, err := command.ExecuteSync(ctx, command.ExecuteRequest{
+		CommandID:  req.GetCommandId(),
+		Params:     params,
+		RequestID:  uuid.NewString(),
+		Connection: connectionID(ctx),
+	}, cfg)
+	if err != nil {
+		if errors.Is(err, command.ErrCommandNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, command.ErrConcurrencyLimit) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &systembridgev1.CommandExecuteResponse{
+		CommandId: result.CommandID,
+		ExitCode:  int32(result.ExitCode),
+		Stdout:    result.Stdout,
+		Stderr:    result.Stderr,
+		Error:     result.Error,
+	}, nil
+}
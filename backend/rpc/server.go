@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	systembridgev1 "github.com/timmo001/system-bridge/proto/systembridge/v1"
+)
+
+// Server implements the SystemBridge gRPC service on top of the event router
+type Server struct {
+	systembridgev1.UnimplementedSystemBridgeServer
+
+	token       string
+	eventRouter *event.MessageRouter
+	dataStore   *data.DataStore
+}
+
+// Ensure Server implements the generated service interface
+var _ systembridgev1.SystemBridgeServer = (*Server)(nil)
+
+// NewServer creates a new gRPC server with the SystemBridge service registered
+func NewServer(token string, eventRouter *event.MessageRouter, dataStore *data.DataStore) *grpc.Server {
+	s := &Server{
+		token:       token,
+		eventRouter: eventRouter,
+		dataStore:   dataStore,
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(s.unaryAuthInterceptor),
+		grpc.StreamInterceptor(s.streamAuthInterceptor),
+	)
+	systembridgev1.RegisterSystemBridgeServer(grpcServer, s)
+
+	return grpcServer
+}
+
+// unaryAuthInterceptor rejects unary calls without a valid API token
+func (s *Server) unaryAuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.authenticate(ctx); err != nil {
+		slog.Warn("gRPC: Rejected unauthenticated call", "method", info.FullMethod, "peer", peerAddress(ctx))
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamAuthInterceptor rejects streaming calls without a valid API token
+func (s *Server) streamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authenticate(ss.Context()); err != nil {
+		slog.Warn("gRPC: Rejected unauthenticated stream", "method", info.FullMethod, "peer", peerAddress(ss.Context()))
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authenticate checks the API token from the "token", "x-api-token" or
+// "authorization" metadata keys
+func (s *Server) authenticate(ctx context.Context) error {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing API token")
+	}
+
+	token := firstMetadataValue(md, "token")
+	if token == "" {
+		token = firstMetadataValue(md, "x-api-token")
+	}
+	if token == "" {
+		token = strings.TrimPrefix(firstMetadataValue(md, "authorization"), "Bearer ")
+	}
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "missing API token")
+	}
+	if token != s.token {
+		return status.Error(codes.Unauthenticated, "invalid API token")
+	}
+
+	return nil
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// peerAddress returns the remote address of the caller
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// connectionID identifies a gRPC caller to event handlers
+func connectionID(ctx context.Context) string {
+	return "grpc:" + peerAddress(ctx)
+}
@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	event_handler "github.com/timmo001/system-bridge/event/handler"
+	systembridgev1 "github.com/timmo001/system-bridge/proto/systembridge/v1"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func newTestClient(t *testing.T) (systembridgev1.SystemBridgeClient, *data.DataStore, *event.MessageRouter) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	router := event.NewMessageRouter()
+	event_handler.RegisterValidateDirectoryHandler(router)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewServer("test-token", router, dataStore)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+
+	return systembridgev1.NewSystemBridgeClient(conn), dataStore, router
+}
+
+func authContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return metadata.AppendToOutgoingContext(ctx, "token", "test-token")
+}
+
+func TestAuthentication(t *testing.T) {
+	client, _, _ := newTestClient(t)
+
+	t.Run("Missing token", func(t *testing.T) {
+		_, err := client.GetData(context.Background(), &systembridgev1.GetDataRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Invalid token", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-token", "wrong")
+		_, err := client.GetData(ctx, &systembridgev1.GetDataRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("Bearer token", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer test-token")
+		_, err := client.GetData(ctx, &systembridgev1.GetDataRequest{Modules: []string{"cpu"}})
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetData(t *testing.T) {
+	client, dataStore, _ := newTestClient(t)
+
+	usage := 42.5
+	require.NoError(t, dataStore.SetModuleData(types.ModuleCPU, types.CPUData{Usage: &usage}))
+	require.NoError(t, dataStore.SetModuleData(types.ModuleDisplays, types.DisplaysData{
+		{ID: "0", Name: "Primary", ResolutionHorizontal: 1920, ResolutionVertical: 1080},
+	}))
+
+	t.Run("Typed module data", func(t *testing.T) {
+		response, err := client.GetData(authContext(t), &systembridgev1.GetDataRequest{Modules: []string{"cpu", "displays"}})
+		require.NoError(t, err)
+		require.Len(t, response.GetModules(), 2)
+
+		cpu := response.GetModules()[0]
+		assert.Equal(t, "cpu", cpu.GetModule())
+		assert.Equal(t, 42.5, cpu.GetCpu().GetUsage())
+
+		displays := response.GetModules()[1].GetDisplays().GetItems()
+		require.Len(t, displays, 1)
+		assert.Equal(t, "Primary", displays[0].GetName())
+		assert.Equal(t, int64(1920), displays[0].GetResolutionHorizontal())
+	})
+
+	t.Run("Unknown module", func(t *testing.T) {
+		_, err := client.GetData(authContext(t), &systembridgev1.GetDataRequest{Modules: []string{"nope"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestEventHandlers(t *testing.T) {
+	client, _, router := newTestClient(t)
+
+	t.Run("Request is routed to the event handler", func(t *testing.T) {
+		response, err := client.ValidateDirectory(authContext(t), &systembridgev1.ValidateDirectoryRequest{Path: t.TempDir()})
+		require.NoError(t, err)
+		assert.True(t, response.GetValid())
+	})
+
+	t.Run("Error responses map to status codes", func(t *testing.T) {
+		router.RegisterSimpleHandler(event.EventOpen, func(connection string, message event.Message) event.MessageResponse {
+			assert.Contains(t, connection, "grpc:")
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeMissingPathURL,
+				Message: "No path or URL provided",
+			}
+		})
+
+		_, err := client.Open(authContext(t), &systembridgev1.OpenRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "No path or URL provided")
+	})
+
+	t.Run("Unregistered handler", func(t *testing.T) {
+		_, err := client.PowerSleep(authContext(t), &systembridgev1.EmptyRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	client, dataStore, _ := newTestClient(t)
+
+	stream, err := client.Subscribe(authContext(t), &systembridgev1.SubscribeRequest{Modules: []string{"memory"}})
+	require.NoError(t, err)
+
+	// The current data is sent first
+	snapshot, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "memory", snapshot.GetModule())
+
+	// Updates for other modules are filtered out
+	usage := 10.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleCPU, types.CPUData{Usage: &usage}))
+
+	percent := 55.0
+	require.NoError(t, dataStore.SetModuleData(types.ModuleMemory, types.MemoryData{
+		Virtual: &types.MemoryVirtual{Percent: &percent},
+	}))
+
+	update, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "memory", update.GetModule())
+	assert.Equal(t, 55.0, update.GetMemory().GetVirtual().GetPercent())
+}
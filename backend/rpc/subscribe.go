@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/timmo001/system-bridge/bus"
+	systembridgev1 "github.com/timmo001/system-bridge/proto/systembridge/v1"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// subscribeBuffer is the number of updates buffered per stream before dropping
+const subscribeBuffer = 64
+
+// Subscribe streams the current data for the requested modules, followed by
+// every update published on the event bus until the client disconnects
+func (s *Server) Subscribe(req *systembridgev1.SubscribeRequest, stream grpc.ServerStreamingServer[systembridgev1.ModuleData]) error {
+	modules, err := s.resolveModules(req.GetModules())
+	if err != nil {
+		return err
+	}
+
+	updates := make(chan *systembridgev1.ModuleData, subscribeBuffer)
+
+	// Subscribe before sending the snapshot so no update is missed in between
+	subscriberID := "grpc-" + uuid.NewString()
+	eventBus := bus.GetInstance()
+	eventBus.Subscribe(bus.EventDataModuleUpdate, subscriberID, func(e bus.Event) {
+		var module types.Module
+		if err := mapstructure.Decode(e.Data, &module); err != nil {
+			slog.Error("Failed to decode module data", "error", err)
+			return
+		}
+		if !slices.Contains(modules, module.Name) {
+			return
+		}
+
+		moduleData, err := moduleToProto(module)
+		if err != nil {
+			slog.Error("gRPC: Failed to convert module data", "module", module.Name, "error", err)
+			return
+		}
+
+		select {
+		case updates <- moduleData:
+		default:
+			slog.Warn("gRPC: Subscriber buffer full, dropping update", "subscriber", subscriberID, "module", module.Name)
+		}
+	})
+	defer eventBus.Unsubscribe(bus.EventDataModuleUpdate, subscriberID)
+
+	slog.Info("gRPC: Client subscribed", "peer", peerAddress(stream.Context()), "modules", modules)
+
+	for _, name := range modules {
+		module, err := s.dataStore.GetModule(name)
+		if err != nil {
+			return status.Errorf(codes.NotFound, "module not registered: %s", name)
+		}
+		moduleData, err := moduleToProto(module)
+		if err != nil {
+			slog.Error("gRPC: Failed to convert module data", "module", name, "error", err)
+			continue
+		}
+		if err := stream.Send(moduleData); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			slog.Info("gRPC: Client unsubscribed", "peer", peerAddress(stream.Context()))
+			return nil
+		case moduleData := <-updates:
+			if err := stream.Send(moduleData); err != nil {
+				return err
+			}
+		}
+	}
+}
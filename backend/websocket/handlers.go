@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
-	"sync"
 
 	"log/slog"
 
@@ -48,6 +47,7 @@ func (ws *WebsocketServer) handleMessages(conn *websocket.Conn) {
 			}
 			break
 		}
+		ws.countMessageIn(conn.RemoteAddr().String())
 
 		var msg WebSocketRequest
 		if err := json.Unmarshal(message, &msg); err != nil {
@@ -64,7 +64,7 @@ func (ws *WebsocketServer) handleMessages(conn *websocket.Conn) {
 		}
 
 		// Handle different event types
-		slog.Debug("Received message", "event", msg.Event, "id", msg.ID)
+		slog.Debug("Received message", "event", msg.Event, "id", msg.ID, "client", ws.ClientName(conn.RemoteAddr().String()))
 		// Pass message to event handlers
 		response := ws.EventRouter.HandleMessage(conn.RemoteAddr().String(), event.Message{
 			ID:    msg.ID,
@@ -99,17 +99,13 @@ func (ws *WebsocketServer) AddConnection(conn *websocket.Conn) {
 	if connInfo, ok := ws.connections[addr]; ok {
 		slog.Debug("WS: Replacing existing connection", "addr", addr)
 		// Remove the connection directly since we already hold the lock
-		delete(ws.connections, addr)
-		delete(ws.dataListeners, addr)
+		ws.deleteConnection(addr)
 		_ = connInfo.conn.Close()
 	}
 
-	ws.connections[addr] = &connectionInfo{
-		conn:     conn,
-		writeMux: sync.Mutex{},
-	}
+	ws.connections[addr] = newConnectionInfo(conn)
 
-	slog.Debug("WS: Connection added successfully", "addr", addr, "total_connections", len(ws.connections))
+	slog.Debug("WS: Connection added successfully", "addr", addr, "session", ws.connections[addr].sessionID, "total_connections", len(ws.connections))
 }
 
 // RemoveConnection removes a WebSocket connection
@@ -117,9 +113,10 @@ func (ws *WebsocketServer) RemoveConnection(conn *websocket.Conn) {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
 	addr := conn.RemoteAddr().String()
-	slog.Debug("WS: Removing connection", "addr", addr)
-	delete(ws.connections, addr)
-	delete(ws.dataListeners, addr)
+	if connInfo, ok := ws.connections[addr]; ok {
+		slog.Debug("WS: Removing connection", "addr", addr, "session", connInfo.sessionID, "client", connInfo.clientName())
+	}
+	ws.deleteConnection(addr)
 	slog.Debug("WS: Connection removed", "addr", addr, "total_connections", len(ws.connections))
 }
 
@@ -155,6 +152,63 @@ func (ws *WebsocketServer) UnregisterDataListener(addr string) {
 	delete(ws.dataListeners, addr)
 }
 
+// RegisterHotkeyListener allows a client to receive HOTKEY_PRESSED messages
+func (ws *WebsocketServer) RegisterHotkeyListener(addr string) RegisterResponse {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	connInfo, ok := ws.connections[addr]
+	if !ok || connInfo.hotkeyListener {
+		slog.Debug("WS: Hotkey listener already exists", "addr", addr)
+		return RegisterResponseExists
+	}
+
+	slog.Debug("WS: Registering hotkey listener", "addr", addr)
+	connInfo.hotkeyListener = true
+	return RegisterResponseAdded
+}
+
+// UnregisterHotkeyListener stops a client receiving HOTKEY_PRESSED messages
+func (ws *WebsocketServer) UnregisterHotkeyListener(addr string) {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	slog.Debug("WS: Unregistering hotkey listener", "addr", addr)
+	if connInfo, ok := ws.connections[addr]; ok {
+		connInfo.hotkeyListener = false
+	}
+}
+
+// handleHotkeyPressed sends hotkey presses from the event bus to hotkey
+// listeners
+func (ws *WebsocketServer) handleHotkeyPressed(e bus.Event) {
+	if e.Type != bus.EventHotkeyPressed {
+		return
+	}
+
+	var pressed bus.HotkeyPressed
+	if err := mapstructure.Decode(e.Data, &pressed); err != nil {
+		slog.Error("Failed to decode hotkey press", "error", err)
+		return
+	}
+
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	response := event.MessageResponse{
+		ID:      "system",
+		Type:    event.ResponseTypeHotkeyPressed,
+		Subtype: event.ResponseSubtypeNone,
+		Data:    pressed,
+	}
+	for addr, connInfo := range ws.connections {
+		if connInfo.hotkeyListener {
+			slog.Debug("WS: Sending hotkey press to listener", "addr", addr, "name", pressed.Name)
+			ws.SendMessageWithLock(connInfo, response, true)
+		}
+	}
+}
+
 // BroadcastModuleUpdate sends a module data update to all connected clients
 func (ws *WebsocketServer) BroadcastModuleUpdate(module types.Module, addr *string) {
 	ws.mutex.Lock()
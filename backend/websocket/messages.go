@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/gorilla/websocket"
@@ -34,8 +35,7 @@ func (ws *WebsocketServer) SendMessageWithLock(connInfo *connectionInfo, message
 			// If we already hold the lock, do the cleanup synchronously
 			addr := connInfo.conn.RemoteAddr().String()
 			if existingConnInfo, ok := ws.connections[addr]; ok && existingConnInfo.conn == connInfo.conn {
-				delete(ws.connections, addr)
-				delete(ws.dataListeners, addr)
+				ws.deleteConnection(addr)
 			}
 		} else {
 			// Otherwise, spawn a goroutine to acquire the lock
@@ -44,12 +44,13 @@ func (ws *WebsocketServer) SendMessageWithLock(connInfo *connectionInfo, message
 				defer ws.mutex.Unlock()
 				connInfo, ok := ws.connections[addr]
 				if ok && connInfo.conn == failedConn {
-					delete(ws.connections, addr)
-					delete(ws.dataListeners, addr)
+					ws.deleteConnection(addr)
 				}
 			}(connInfo.conn.RemoteAddr().String(), connInfo.conn)
 		}
+		return
 	}
+	connInfo.messagesOut.Add(1)
 }
 
 func (ws *WebsocketServer) SendMessageToAddress(address string, message event.MessageResponse) bool {
@@ -74,6 +75,18 @@ func (ws *WebsocketServer) ConnectionExists(address string) bool {
 	return ok
 }
 
+// ConnectionContext returns a context that is canceled when the connection
+// closes
+func (ws *WebsocketServer) ConnectionContext(address string) (context.Context, bool) {
+	ws.mutex.RLock()
+	connInfo, ok := ws.connections[address]
+	ws.mutex.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return connInfo.ctx, true
+}
+
 func (ws *WebsocketServer) SendError(conn *websocket.Conn, req WebSocketRequest, subtype event.ResponseSubtype, message string) {
 	response := event.MessageResponse{
 		ID:      req.ID,
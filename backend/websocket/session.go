@@ -0,0 +1,152 @@
+package websocket
+
+import (
+	"log/slog"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/timmo001/system-bridge/event"
+)
+
+// TransportWebSocket identifies sessions connected to the WebSocket API
+const TransportWebSocket = "websocket"
+
+// clientName returns the name the client reported in its handshake, if any
+func (c *connectionInfo) clientName() string {
+	if c.client == nil {
+		return ""
+	}
+	return c.client.Name
+}
+
+// SetClientInfo stores the client metadata from a HELLO handshake on the
+// connection's session and returns the session ID
+func (ws *WebsocketServer) SetClientInfo(addr string, client event.ClientInfo) (string, bool) {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	connInfo, ok := ws.connections[addr]
+	if !ok {
+		return "", false
+	}
+	connInfo.client = &client
+
+	slog.Info(
+		"WS: Client identified",
+		"addr", addr,
+		"session", connInfo.sessionID,
+		"client", client.Name,
+		"version", client.Version,
+		"platform", client.Platform,
+	)
+
+	return connInfo.sessionID, true
+}
+
+// GetSession returns the session for a connection
+func (ws *WebsocketServer) GetSession(addr string) (event.ClientSession, bool) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	connInfo, ok := ws.connections[addr]
+	if !ok {
+		return event.ClientSession{}, false
+	}
+	return ws.session(addr, connInfo), true
+}
+
+// Sessions returns all connected sessions, oldest first
+func (ws *WebsocketServer) Sessions() []event.ClientSession {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	sessions := make([]event.ClientSession, 0, len(ws.connections))
+	for addr, connInfo := range ws.connections {
+		sessions = append(sessions, ws.session(addr, connInfo))
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ConnectedAt.Before(sessions[j].ConnectedAt)
+	})
+	return sessions
+}
+
+// session builds a session snapshot. The caller must hold the mutex.
+func (ws *WebsocketServer) session(addr string, connInfo *connectionInfo) event.ClientSession {
+	session := event.ClientSession{
+		Transport:   TransportWebSocket,
+		Address:     addr,
+		SessionID:   connInfo.sessionID,
+		ConnectedAt: connInfo.connectedAt,
+		Modules:     slices.Clone(ws.dataListeners[addr]),
+		MessagesIn:  connInfo.messagesIn.Load(),
+		MessagesOut: connInfo.messagesOut.Load(),
+	}
+	if connInfo.client != nil {
+		client := *connInfo.client
+		session.Client = &client
+	}
+	return session
+}
+
+// Disconnect closes the connection with the given session ID
+func (ws *WebsocketServer) Disconnect(sessionID string) bool {
+	ws.mutex.Lock()
+	var (
+		addr     string
+		connInfo *connectionInfo
+	)
+	for a, c := range ws.connections {
+		if c.sessionID == sessionID {
+			addr, connInfo = a, c
+			break
+		}
+	}
+	if connInfo == nil {
+		ws.mutex.Unlock()
+		return false
+	}
+	ws.deleteConnection(addr)
+	ws.mutex.Unlock()
+
+	slog.Info("WS: Disconnecting client", "addr", addr, "session", sessionID, "client", connInfo.clientName())
+
+	// Test connections have no underlying connection
+	if connInfo.conn == nil {
+		return true
+	}
+
+	connInfo.writeMux.Lock()
+	defer connInfo.writeMux.Unlock()
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "Disconnected by server")
+	if err := connInfo.conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		slog.Debug("WS: Failed to send close message", "addr", addr, "error", err)
+	}
+	if err := connInfo.conn.Close(); err != nil {
+		slog.Debug("WS: Error closing connection", "addr", addr, "error", err)
+	}
+	return true
+}
+
+// ClientName returns the name a connection reported in its HELLO handshake,
+// or an empty string if it has not sent one
+func (ws *WebsocketServer) ClientName(addr string) string {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	if connInfo, ok := ws.connections[addr]; ok {
+		return connInfo.clientName()
+	}
+	return ""
+}
+
+// countMessageIn records a message received from a connection
+func (ws *WebsocketServer) countMessageIn(addr string) {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+
+	if connInfo, ok := ws.connections[addr]; ok {
+		connInfo.messagesIn.Add(1)
+	}
+}
@@ -1,9 +1,13 @@
 package websocket
 
 import (
+	"context"
 	"net/http"
 	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/timmo001/system-bridge/bus"
 	"github.com/timmo001/system-bridge/data"
@@ -24,8 +28,31 @@ type WebSocketRequest struct {
 
 // connectionInfo holds connection data with write synchronization
 type connectionInfo struct {
-	conn     *websocket.Conn
-	writeMux sync.Mutex
+	conn        *websocket.Conn
+	writeMux    sync.Mutex
+	sessionID   string
+	connectedAt time.Time
+	client      *event.ClientInfo
+	messagesIn  atomic.Uint64
+	messagesOut atomic.Uint64
+	// hotkeyListener is whether the connection receives HOTKEY_PRESSED
+	// messages. It is guarded by the server mutex.
+	hotkeyListener bool
+	// ctx is canceled when the connection is removed, stopping work it
+	// started, such as macros
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newConnectionInfo(conn *websocket.Conn) *connectionInfo {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &connectionInfo{
+		conn:        conn,
+		sessionID:   uuid.NewString(),
+		connectedAt: time.Now(),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
 }
 
 type WebsocketServer struct {
@@ -57,6 +84,7 @@ func NewWebsocketServer(token string, dataStore *data.DataStore, eventRouter *ev
 	eb := bus.GetInstance()
 	eb.Subscribe(bus.EventGetDataModule, "websocket", ws.handleGetDataModule)
 	eb.Subscribe(bus.EventDataModuleUpdate, "websocket", ws.handleDataModuleUpdate)
+	eb.Subscribe(bus.EventHotkeyPressed, "websocket", ws.handleHotkeyPressed)
 
 	return ws
 }
@@ -67,15 +95,22 @@ func (ws *WebsocketServer) AddTestConnection(address string) {
 	defer ws.mutex.Unlock()
 	// Add a connectionInfo with nil conn - we only need it to pass ConnectionExists checks
 	// The nil conn will be handled gracefully in SendMessage for testing
-	ws.connections[address] = &connectionInfo{
-		conn: nil,
-	}
+	ws.connections[address] = newConnectionInfo(nil)
 }
 
 // RemoveTestConnection removes a test connection by address
 func (ws *WebsocketServer) RemoveTestConnection(address string) {
 	ws.mutex.Lock()
 	defer ws.mutex.Unlock()
-	delete(ws.connections, address)
-	delete(ws.dataListeners, address)
+	ws.deleteConnection(address)
+}
+
+// deleteConnection removes a connection and cancels its context. The caller
+// must hold the server mutex.
+func (ws *WebsocketServer) deleteConnection(addr string) {
+	if connInfo, ok := ws.connections[addr]; ok {
+		connInfo.cancel()
+	}
+	delete(ws.connections, addr)
+	delete(ws.dataListeners, addr)
 }
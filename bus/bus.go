@@ -17,6 +17,8 @@ const (
 	EventGetDataModule EventType = "GET_DATA_MODULE"
 	// EventDataModuleUpdate is the event type for data module updates
 	EventDataModuleUpdate EventType = "DATA_MODULE_UPDATE"
+	// EventHotkeyPressed is the event type for global hotkey presses
+	EventHotkeyPressed EventType = "HOTKEY_PRESSED"
 )
 
 // Event represents an event in the system
@@ -31,6 +33,12 @@ type GetDataRequest struct {
 	Modules    []types.ModuleName `json:"modules" mapstructure:"modules"`
 }
 
+// HotkeyPressed is the data of a hotkey pressed event
+type HotkeyPressed struct {
+	Name string `json:"name" mapstructure:"name"`
+	Key  string `json:"key" mapstructure:"key"`
+}
+
 // Handler is a function that handles events
 type Handler func(event Event)
 
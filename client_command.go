@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	api_http "github.com/timmo001/system-bridge/backend/http"
+	"github.com/timmo001/system-bridge/utils"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+	"github.com/urfave/cli/v3"
+)
+
+// parseCommandParams parses name=value command parameters
+func parseCommandParams(values []string) (map[string]any, error) {
+	params := make(map[string]any, len(values))
+	for _, value := range values {
+		name, paramValue, found := strings.Cut(value, "=")
+		if !found || name == "" {
+			return nil, fmt.Errorf("parameter %q must be in the form name=value", value)
+		}
+		params[name] = paramValue
+	}
+	return params, nil
+}
+
+// runClientCommand runs an allowlisted command on the running backend. The
+// command's output is written to stdout and stderr, and its exit code is
+// returned as the exit code of the CLI.
+func runClientCommand(ctx context.Context, commandID string, body api_http.CommandExecuteRequest, asJSON bool) error {
+	token, err := utils.LoadToken()
+	if err != nil {
+		return fmt.Errorf("error loading token: %w", err)
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("http://127.0.0.1:%d/api/commands/%s/execute", utils.GetPort(), url.PathEscape(commandID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Token", token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach the backend, is it running? %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errorBody map[string]string
+		if err := json.NewDecoder(resp.Body).Decode(&errorBody); err != nil || errorBody["error"] == "" {
+			return fmt.Errorf("command request failed: %s", resp.Status)
+		}
+		return fmt.Errorf("command request failed: %s", errorBody["error"])
+	}
+
+	if body.Async {
+		var job command.Job
+		if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		if asJSON {
+			return printJSON(job)
+		}
+		fmt.Println(job.ID)
+		return nil
+	}
+
+	var result command.ExecuteResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if asJSON {
+		if err := printJSON(result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprint(os.Stdout, result.Stdout)
+		fmt.Fprint(os.Stderr, result.Stderr)
+	}
+
+	if result.Error != "" {
+		return cli.Exit(result.Error, 1)
+	}
+	if result.ExitCode != 0 {
+		return cli.Exit("", result.ExitCode)
+	}
+	return nil
+}
+
+// printJSON prints a value as indented JSON
+func printJSON(v any) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal output: %w", err)
+	}
+	fmt.Println(string(out))
+	return nil
+}
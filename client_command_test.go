@@ -0,0 +1,20 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommandParams(t *testing.T) {
+	params, err := parseCommandParams([]string{"level=40", "label=a=b,c", "empty="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"level": "40", "label": "a=b,c", "empty": ""}, params)
+
+	_, err = parseCommandParams([]string{"level"})
+	assert.Error(t, err)
+
+	_, err = parseCommandParams([]string{"=40"})
+	assert.Error(t, err)
+}
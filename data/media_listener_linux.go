@@ -0,0 +1,78 @@
+//go:build linux
+
+package data
+
+import (
+	"context"
+	"time"
+
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/mpris"
+)
+
+const (
+	// mediaListenerDebounce gathers the burst of signals a player sends when
+	// its track changes into a single update
+	mediaListenerDebounce = 100 * time.Millisecond
+	// mediaListenerRetry is how long to wait before reconnecting to the bus
+	mediaListenerRetry = 10 * time.Second
+)
+
+// StartMediaListener starts a background goroutine that watches MPRIS players
+// on the session bus and triggers the media module update when one appears,
+// goes away or changes. It returns whether the listener started, in which case
+// the media module does not need polling.
+func StartMediaListener(dataStore *DataStore) bool {
+	if dataStore == nil {
+		return false
+	}
+
+	client, err := mpris.SessionBusPrivate()
+	if err != nil {
+		slog.Info("Media listener unavailable", "error", err)
+		return false
+	}
+
+	changes := make(chan struct{}, 1)
+	changed := func() {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+
+	go func() {
+		for range changes {
+			time.Sleep(mediaListenerDebounce)
+			select {
+			case <-changes:
+			default:
+			}
+			if err := dataStore.TriggerModuleUpdate(types.ModuleMedia); err != nil {
+				slog.Warn("Failed to trigger media update from MPRIS event", "error", err)
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			err := client.Watch(context.Background(), changed)
+			_ = client.Close()
+			slog.Info("Media listener stopped, reconnecting", "error", err, "retry", mediaListenerRetry)
+
+			for {
+				time.Sleep(mediaListenerRetry)
+				if client, err = mpris.SessionBusPrivate(); err == nil {
+					break
+				}
+				slog.Debug("Failed to reconnect media listener", "error", err)
+			}
+			// Players may have changed while disconnected
+			changed()
+		}
+	}()
+
+	return true
+}
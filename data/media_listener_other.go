@@ -2,12 +2,15 @@
 
 package data
 
-// StartPlayerctlListener is a no-op on non-Linux platforms.
+// StartMediaListener is a no-op on non-Linux platforms, where the media module
+// is polled instead.
 // TODO(Windows): Consider using GlobalSystemMediaTransportControlsSessionManager (GSMTC)
 // from Windows.Media.Control to subscribe to session events:
 // - CurrentSessionChanged
 // - MediaPropertiesChanged
 // - PlaybackInfoChanged
-// This would allow push updates similar to MPRIS playerctl on Linux.
+// This would allow push updates similar to MPRIS on Linux.
 // See: https://learn.microsoft.com/windows/uwp/audio-video-camera/system-media-transport-controls
-func StartPlayerctlListener(_ *DataStore) {}
+func StartMediaListener(_ *DataStore) bool {
+	return false
+}
@@ -1,21 +1,131 @@
 package media
 
 import (
+	"log/slog"
 	"time"
 
+	"github.com/timmo001/system-bridge/artwork"
+	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/types"
 )
 
+// loadSettings loads the settings that choose the active player
+var loadSettings = settings.Load
+
 // GetMediaData gets media information from the system
 func GetMediaData() (types.MediaData, error) {
 	// Get current timestamp
-	now := float64(time.Now().Unix())
+	now := time.Now()
+
+	// Get platform-specific media players
+	players := getPlayers()
+
+	policy := settings.MediaPlayerPolicyPlaying
+	var preferred []string
+	if cfg, err := loadSettings(); err != nil {
+		slog.Warn("Failed to load settings for media player policy", "error", err)
+	} else {
+		if cfg.Media.PlayerPolicy != "" {
+			policy = cfg.Media.PlayerPolicy
+		}
+		preferred = cfg.Media.PreferredPlayers
+	}
+
+	rewriteThumbnails(players, artwork.GetInstance())
 
-	// Initialize media data with default values
-	mediaData := types.MediaData{
-		UpdatedAt: &now,
+	return buildMediaData(players, policy, preferred, activity.update(players, now), now), nil
+}
+
+// rewriteThumbnails points the players' thumbnails at the artwork cache, as
+// clients often cannot reach the URLs players report
+func rewriteThumbnails(players []types.MediaPlayer, cache *artwork.Cache) {
+	if cache == nil {
+		return
+	}
+	for i := range players {
+		if players[i].Thumbnail == nil || *players[i].Thumbnail == "" {
+			continue
+		}
+		if url, ok := cache.URL(*players[i].Thumbnail); ok {
+			players[i].Thumbnail = &url
+		}
 	}
+}
 
-	// Get platform-specific media data
-	return getMediaData(mediaData)
+// buildMediaData marks the active player and copies it to the top level
+func buildMediaData(players []types.MediaPlayer, policy settings.SettingsMediaPlayerPolicy, preferred []string, startedAt map[string]time.Time, now time.Time) types.MediaData {
+	updatedAt := float64(now.Unix())
+	if players == nil {
+		players = []types.MediaPlayer{}
+	}
+
+	active := selectActive(players, policy, preferred, startedAt)
+	if active < 0 {
+		return types.MediaData{UpdatedAt: &updatedAt, Players: players}
+	}
+
+	players[active].IsActive = true
+	mediaData := mediaDataFromPlayer(players[active])
+	mediaData.UpdatedAt = &updatedAt
+	mediaData.Players = players
+	return mediaData
+}
+
+// playerFromMediaData converts media data for a single player into a player
+func playerFromMediaData(name string, m types.MediaData) types.MediaPlayer {
+	return types.MediaPlayer{
+		Name:                 name,
+		AlbumArtist:          m.AlbumArtist,
+		AlbumTitle:           m.AlbumTitle,
+		Artist:               m.Artist,
+		Duration:             m.Duration,
+		IsFastForwardEnabled: m.IsFastForwardEnabled,
+		IsNextEnabled:        m.IsNextEnabled,
+		IsPauseEnabled:       m.IsPauseEnabled,
+		IsPlayEnabled:        m.IsPlayEnabled,
+		IsPreviousEnabled:    m.IsPreviousEnabled,
+		IsRewindEnabled:      m.IsRewindEnabled,
+		IsStopEnabled:        m.IsStopEnabled,
+		PlaybackRate:         m.PlaybackRate,
+		Position:             m.Position,
+		Repeat:               m.Repeat,
+		Shuffle:              m.Shuffle,
+		Status:               m.Status,
+		Subtitle:             m.Subtitle,
+		Thumbnail:            m.Thumbnail,
+		Title:                m.Title,
+		TrackNumber:          m.TrackNumber,
+		Type:                 m.Type,
+		Volume:               m.Volume,
+	}
+}
+
+// mediaDataFromPlayer converts a player into the top level media data
+func mediaDataFromPlayer(p types.MediaPlayer) types.MediaData {
+	name := p.Name
+	return types.MediaData{
+		AlbumArtist:          p.AlbumArtist,
+		AlbumTitle:           p.AlbumTitle,
+		Artist:               p.Artist,
+		Duration:             p.Duration,
+		IsFastForwardEnabled: p.IsFastForwardEnabled,
+		IsNextEnabled:        p.IsNextEnabled,
+		IsPauseEnabled:       p.IsPauseEnabled,
+		IsPlayEnabled:        p.IsPlayEnabled,
+		IsPreviousEnabled:    p.IsPreviousEnabled,
+		IsRewindEnabled:      p.IsRewindEnabled,
+		IsStopEnabled:        p.IsStopEnabled,
+		PlaybackRate:         p.PlaybackRate,
+		Position:             p.Position,
+		Repeat:               p.Repeat,
+		Shuffle:              p.Shuffle,
+		Status:               p.Status,
+		Subtitle:             p.Subtitle,
+		Thumbnail:            p.Thumbnail,
+		Title:                p.Title,
+		TrackNumber:          p.TrackNumber,
+		Type:                 p.Type,
+		Volume:               p.Volume,
+		Player:               &name,
+	}
 }
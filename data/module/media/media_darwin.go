@@ -10,6 +10,20 @@ import (
 	"github.com/timmo001/system-bridge/types"
 )
 
+// getPlayers returns the player that is playing, as only one is reported
+func getPlayers() []types.MediaPlayer {
+	mediaData, err := getMediaData(types.MediaData{})
+	if err != nil || mediaData.Title == nil {
+		return []types.MediaPlayer{}
+	}
+
+	name := "default"
+	if mediaData.Type != nil && *mediaData.Type != "" {
+		name = *mediaData.Type
+	}
+	return []types.MediaPlayer{playerFromMediaData(name, mediaData)}
+}
+
 func getMediaData(mediaData types.MediaData) (types.MediaData, error) {
 	// On macOS, we'll use osascript to get media information
 	cmd := exec.Command("osascript", "-e", `
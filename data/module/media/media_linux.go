@@ -3,195 +3,96 @@
 package media
 
 import (
-	"encoding/json"
-	"os"
-	"os/exec"
-	"strconv"
-	"strings"
-
 	"log/slog"
+	"strings"
 
 	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/mpris"
 )
 
-func getMediaData(mediaData types.MediaData) (types.MediaData, error) {
-	// On Linux, we'll use playerctl to get media information
-	cmd := exec.Command("playerctl", "metadata", "--format", `{"title":"{{title}}","artist":"{{artist}}","album":"{{album}}","duration":"{{mpris:length}}","position":"{{position}}","status":"{{status}}","playerName":"{{playerName}}","volume":"{{volume}}","shuffle":"{{shuffle}}","loopStatus":"{{loopStatus}}"}`, "--all-players")
-	output, err := cmd.Output()
+func getPlayers() []types.MediaPlayer {
+	players := make([]types.MediaPlayer, 0)
+
+	client, err := mpris.SessionBus()
 	if err != nil {
-		// Info logging for playerctl errors (expected when no media player running)
-		slog.Info("playerctl unavailable or no players", "error", err.Error())
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			slog.Debug("playerctl stderr:", "stderr", string(exitErr.Stderr))
-		}
-		slog.Debug("ENV: DBUS_SESSION_BUS_ADDRESS=", "address", os.Getenv("DBUS_SESSION_BUS_ADDRESS"))
-		slog.Debug("ENV: XDG_RUNTIME_DIR=", "dir", os.Getenv("XDG_RUNTIME_DIR"))
-		slog.Debug("ENV: DISPLAY=", "display", os.Getenv("DISPLAY"))
-	} else {
-		slog.Debug("playerctl output:", "output", string(output))
+		// Expected when there is no session bus, such as when running as a
+		// service
+		slog.Info("Media players unavailable", "error", err)
+		return players
 	}
 
-	if err == nil {
-		// playerctl --all-players may emit multiple JSON objects separated by newlines.
-		// Parse one or many lines and select the most relevant (prefer Playing, then Paused, else first).
-		type playerMetadata struct {
-			Title      string `json:"title"`
-			Artist     string `json:"artist"`
-			Album      string `json:"album"`
-			Duration   string `json:"duration"`
-			Position   string `json:"position"`
-			Status     string `json:"status"`
-			PlayerName string `json:"playerName"`
-			Volume     string `json:"volume"`
-			Shuffle    string `json:"shuffle"`
-			LoopStatus string `json:"loopStatus"`
-		}
-
-		parseSingle := func(b []byte) (*playerMetadata, error) {
-			var m playerMetadata
-			if err := json.Unmarshal(b, &m); err != nil {
-				return nil, err
-			}
-			return &m, nil
-		}
-
-		// Try single JSON first
-		chosen, singleErr := parseSingle(output)
-		if singleErr != nil {
-			// Fall back to multi-line parsing
-			lines := strings.Split(strings.TrimSpace(string(output)), "\n")
-			var candidates []playerMetadata
-			for _, line := range lines {
-				line = strings.TrimSpace(line)
-				if line == "" {
-					continue
-				}
-				if m, err := parseSingle([]byte(line)); err == nil {
-					candidates = append(candidates, *m)
-				}
-			}
-
-			if len(candidates) == 0 {
-				// Keep previous warning behavior with raw output to aid debugging
-				slog.Warn("JSON unmarshal error:", "error", singleErr.Error(), "raw_output", string(output))
-				return mediaData, nil
-			}
-
-			// Select preferred candidate
-			var selected *playerMetadata
-			// Prefer Playing
-			for i := range candidates {
-				if strings.EqualFold(candidates[i].Status, "playing") {
-					selected = &candidates[i]
-					break
-				}
-			}
-			// Then Paused
-			if selected == nil {
-				for i := range candidates {
-					if strings.EqualFold(candidates[i].Status, "paused") {
-						selected = &candidates[i]
-						break
-					}
-				}
-			}
-			// Else first
-			if selected == nil {
-				selected = &candidates[0]
-			}
-			chosen = selected
-		}
-
-		// Apply chosen metadata to mediaData
-		if chosen != nil {
-			mediaData.Title = &chosen.Title
-			mediaData.Artist = &chosen.Artist
-			mediaData.AlbumTitle = &chosen.Album
-
-			// Normalize status to HA-expected constants
-			if chosen.Status != "" {
-				s := strings.ToUpper(chosen.Status)
-				switch s {
-				case "PLAYING", "PAUSED", "STOPPED", "CHANGING":
-					// ok
-				default:
-					// best-effort mapping
-					if strings.EqualFold(chosen.Status, "play") || strings.EqualFold(chosen.Status, "playing") {
-						s = "PLAYING"
-					} else if strings.EqualFold(chosen.Status, "pause") || strings.EqualFold(chosen.Status, "paused") {
-						s = "PAUSED"
-					} else if strings.EqualFold(chosen.Status, "stop") || strings.EqualFold(chosen.Status, "stopped") {
-						s = "STOPPED"
-					} else {
-						s = "STOPPED"
-					}
-				}
-				mediaData.Status = &s
-			}
-			mediaData.Type = &chosen.PlayerName
+	mprisPlayers, err := client.Players()
+	if err != nil {
+		slog.Info("Failed to list media players", "error", err)
+		return players
+	}
 
-			// Convert duration and position to float64 (duration is in microseconds)
-			if duration, err := strconv.ParseFloat(chosen.Duration, 64); err == nil {
-				dur := duration / 1e6 // convert microseconds to seconds
-				mediaData.Duration = &dur
-			}
-			if position, err := strconv.ParseFloat(chosen.Position, 64); err == nil {
-				pos := position / 1e6 // convert microseconds to seconds
-				mediaData.Position = &pos
-			}
+	for _, player := range mprisPlayers {
+		players = append(players, playerFromMPRIS(player))
+	}
+	return players
+}
 
-			// Parse volume string to float64 if not empty
-			if chosen.Volume != "" {
-				if vol, err := strconv.ParseFloat(chosen.Volume, 64); err == nil {
-					mediaData.Volume = &vol
-				}
-			}
+// playerFromMPRIS converts the state of an MPRIS player into a player
+func playerFromMPRIS(p mpris.Player) types.MediaPlayer {
+	playerType := mpris.BaseName(p.Name)
+	title := p.Metadata.Title()
+	artist := p.Metadata.Artist()
+	album := p.Metadata.Album()
+
+	player := types.MediaPlayer{
+		Name:         p.Name,
+		Title:        &title,
+		Artist:       &artist,
+		AlbumTitle:   &album,
+		Type:         &playerType,
+		Volume:       p.Volume,
+		Shuffle:      p.Shuffle,
+		PlaybackRate: p.Rate,
+	}
+	if artURL := p.Metadata.ArtURL(); artURL != "" {
+		player.Thumbnail = &artURL
+	}
 
-			// Set control states based on status
-			isPlaying := strings.ToLower(chosen.Status) == "playing"
-			mediaData.IsPlayEnabled = &[]bool{!isPlaying}[0]
-			mediaData.IsPauseEnabled = &[]bool{isPlaying}[0]
-			mediaData.IsStopEnabled = &[]bool{true}[0]
+	// Normalize status to HA-expected constants
+	status := "STOPPED"
+	switch p.PlaybackStatus {
+	case "Playing":
+		status = "PLAYING"
+	case "Paused":
+		status = "PAUSED"
+	}
+	player.Status = &status
 
-			// Set shuffle and repeat states
-			if chosen.Shuffle != "" {
-				isShuffle := strings.ToLower(chosen.Shuffle) == "on"
-				mediaData.Shuffle = &isShuffle
-			}
-			if chosen.LoopStatus != "" {
-				// Normalize repeat to HA-expected constants: NONE/TRACK/LIST
-				ls := strings.ToUpper(chosen.LoopStatus)
-				switch ls {
-				case "NONE":
-					// ok
-				case "TRACK", "ONE":
-					ls = "TRACK"
-				case "LIST", "ALL", "PLAYLIST", "ALBUM":
-					ls = "LIST"
-				default:
-					ls = "NONE"
-				}
-				mediaData.Repeat = &ls
-			}
-		}
+	// Convert duration and position from microseconds to seconds
+	if length, ok := p.Metadata.Length(); ok {
+		duration := float64(length) / 1e6
+		player.Duration = &duration
+	}
+	if p.Position != nil {
+		position := float64(*p.Position) / 1e6
+		player.Position = &position
 	}
 
-	// If playerctl fails or no media is playing, check for browser media
-	if mediaData.Title == nil {
-		cmd = exec.Command("xdotool", "search", "--name", "YouTube|Netflix|Spotify|VLC", "getwindowname")
-		output, err = cmd.Output()
-		if err == nil {
-			title := strings.TrimSpace(string(output))
-			if title != "" {
-				mediaData.Title = &title
-				mediaData.Type = &[]string{"browser"}[0]
-				mediaData.Status = &[]string{"playing"}[0]
-				mediaData.IsPlayEnabled = &[]bool{true}[0]
-				mediaData.IsPauseEnabled = &[]bool{true}[0]
-				mediaData.IsStopEnabled = &[]bool{true}[0]
-			}
+	// Normalize repeat to HA-expected constants: NONE/TRACK/LIST
+	if p.LoopStatus != "" {
+		repeat := "NONE"
+		switch strings.ToLower(p.LoopStatus) {
+		case "track":
+			repeat = "TRACK"
+		case "playlist":
+			repeat = "LIST"
 		}
+		player.Repeat = &repeat
 	}
 
-	return mediaData, nil
+	// Set control states based on status and what the player supports
+	isPlaying := status == "PLAYING"
+	player.IsPlayEnabled = &[]bool{p.CanPlay && !isPlaying}[0]
+	player.IsPauseEnabled = &[]bool{p.CanPause && isPlaying}[0]
+	player.IsStopEnabled = &[]bool{p.CanControl}[0]
+	player.IsNextEnabled = &p.CanGoNext
+	player.IsPreviousEnabled = &p.CanGoPrevious
+
+	return player
 }
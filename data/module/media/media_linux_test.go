@@ -0,0 +1,65 @@
+//go:build linux
+
+package media
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/timmo001/system-bridge/utils/mpris"
+)
+
+func TestPlayerFromMPRIS(t *testing.T) {
+	volume := 0.5
+	shuffle := true
+	position := int64(30_000_000)
+
+	spotify := playerFromMPRIS(mpris.Player{
+		Name:           "spotify",
+		PlaybackStatus: "Playing",
+		LoopStatus:     "Playlist",
+		Shuffle:        &shuffle,
+		Volume:         &volume,
+		Position:       &position,
+		Metadata: mpris.Metadata{
+			"mpris:length": dbus.MakeVariant(int64(180_000_000)),
+			"mpris:artUrl": dbus.MakeVariant("https://i.scdn.co/image/1"),
+			"xesam:title":  dbus.MakeVariant("Song"),
+			"xesam:artist": dbus.MakeVariant([]string{"Band"}),
+		},
+		CanControl: true,
+		CanPlay:    true,
+		CanPause:   true,
+		CanGoNext:  true,
+	})
+	assert.Equal(t, "spotify", spotify.Name)
+	assert.Equal(t, "Song", *spotify.Title)
+	assert.Equal(t, "Band", *spotify.Artist)
+	assert.Equal(t, "https://i.scdn.co/image/1", *spotify.Thumbnail)
+	assert.Equal(t, "PLAYING", *spotify.Status)
+	assert.InDelta(t, 180, *spotify.Duration, 0.001)
+	assert.InDelta(t, 30, *spotify.Position, 0.001)
+	assert.InDelta(t, 0.5, *spotify.Volume, 0.001)
+	assert.True(t, *spotify.Shuffle)
+	assert.Equal(t, "LIST", *spotify.Repeat)
+	assert.True(t, *spotify.IsPauseEnabled)
+	assert.False(t, *spotify.IsPlayEnabled)
+	assert.True(t, *spotify.IsNextEnabled)
+	assert.False(t, *spotify.IsPreviousEnabled)
+
+	firefox := playerFromMPRIS(mpris.Player{
+		Name:           "firefox.instance_1_42",
+		PlaybackStatus: "Paused",
+		Metadata:       mpris.Metadata{"xesam:title": dbus.MakeVariant("Video")},
+		CanPlay:        true,
+		CanPause:       true,
+	})
+	assert.Equal(t, "firefox.instance_1_42", firefox.Name)
+	assert.Equal(t, "firefox", *firefox.Type)
+	assert.Equal(t, "PAUSED", *firefox.Status)
+	assert.Nil(t, firefox.Thumbnail)
+	assert.Nil(t, firefox.Duration)
+	assert.Nil(t, firefox.Repeat)
+	assert.True(t, *firefox.IsPlayEnabled)
+}
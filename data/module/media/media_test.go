@@ -0,0 +1,28 @@
+package media
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/timmo001/system-bridge/artwork"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func TestRewriteThumbnails(t *testing.T) {
+	remote := "https://i.scdn.co/image/1"
+	unsupported := "spotify:image:1"
+	players := []types.MediaPlayer{
+		{Name: "spotify", Thumbnail: &remote},
+		{Name: "vlc", Thumbnail: &unsupported},
+		{Name: "firefox"},
+	}
+
+	rewriteThumbnails(players, nil)
+	assert.Equal(t, remote, *players[0].Thumbnail)
+
+	rewriteThumbnails(players, artwork.NewCache(t.TempDir()))
+	assert.True(t, strings.HasPrefix(*players[0].Thumbnail, artwork.URLPrefix))
+	assert.Equal(t, unsupported, *players[1].Thumbnail)
+	assert.Nil(t, players[2].Thumbnail)
+}
@@ -51,6 +51,20 @@ func locateNowPlayingExe() (string, error) {
 	return "", errors.New("NowPlaying.exe not found")
 }
 
+// getPlayers returns the player that is playing, as only one is reported
+func getPlayers() []types.MediaPlayer {
+	mediaData, err := getMediaData(types.MediaData{})
+	if err != nil || mediaData.Title == nil {
+		return []types.MediaPlayer{}
+	}
+
+	name := "default"
+	if mediaData.Type != nil && *mediaData.Type != "" {
+		name = *mediaData.Type
+	}
+	return []types.MediaPlayer{playerFromMediaData(name, mediaData)}
+}
+
 func getMediaData(mediaData types.MediaData) (types.MediaData, error) {
 	exePath, err := locateNowPlayingExe()
 	if err != nil {
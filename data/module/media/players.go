@@ -0,0 +1,113 @@
+package media
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// playerActivity tracks when each player last started playing, for the
+// last_active policy
+type playerActivity struct {
+	mu        sync.Mutex
+	playing   map[string]bool
+	startedAt map[string]time.Time
+}
+
+var activity = &playerActivity{
+	playing:   make(map[string]bool),
+	startedAt: make(map[string]time.Time),
+}
+
+// update records the players that started playing since the last update and
+// returns when each player last started playing. Players that are gone are
+// forgotten.
+func (a *playerActivity) update(players []types.MediaPlayer, now time.Time) map[string]time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	playing := make(map[string]bool, len(players))
+	startedAt := make(map[string]time.Time, len(players))
+	for _, player := range players {
+		isPlaying := statusRank(player) == 0
+		playing[player.Name] = isPlaying
+		started, ok := a.startedAt[player.Name]
+		if isPlaying && !a.playing[player.Name] {
+			started, ok = now, true
+		}
+		if ok {
+			startedAt[player.Name] = started
+		}
+	}
+	a.playing = playing
+	a.startedAt = startedAt
+	return startedAt
+}
+
+// selectActive returns the index of the active player under a policy, or -1
+// when there are no players
+func selectActive(players []types.MediaPlayer, policy settings.SettingsMediaPlayerPolicy, preferred []string, startedAt map[string]time.Time) int {
+	if len(players) == 0 {
+		return -1
+	}
+
+	indexes := make([]int, len(players))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		pa, pb := players[a], players[b]
+		switch policy {
+		case settings.MediaPlayerPolicyPreferred:
+			return cmp.Or(
+				cmp.Compare(preferenceRank(pa, preferred), preferenceRank(pb, preferred)),
+				cmp.Compare(statusRank(pa), statusRank(pb)),
+			)
+		case settings.MediaPlayerPolicyLastActive:
+			return cmp.Or(
+				cmp.Compare(min(statusRank(pa), 1), min(statusRank(pb), 1)),
+				startedAt[pb.Name].Compare(startedAt[pa.Name]),
+				cmp.Compare(preferenceRank(pa, preferred), preferenceRank(pb, preferred)),
+			)
+		default:
+			return cmp.Or(
+				cmp.Compare(statusRank(pa), statusRank(pb)),
+				cmp.Compare(preferenceRank(pa, preferred), preferenceRank(pb, preferred)),
+			)
+		}
+	})
+	return indexes[0]
+}
+
+// statusRank ranks playing players first, then paused ones
+func statusRank(player types.MediaPlayer) int {
+	if player.Status == nil {
+		return 2
+	}
+	switch strings.ToUpper(*player.Status) {
+	case "PLAYING":
+		return 0
+	case "PAUSED":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// preferenceRank returns the position of the first preferred player name the
+// player's name starts with, or the number of preferred players when none do
+func preferenceRank(player types.MediaPlayer, preferred []string) int {
+	name := strings.ToLower(player.Name)
+	for i, prefix := range preferred {
+		if prefix != "" && strings.HasPrefix(name, strings.ToLower(prefix)) {
+			return i
+		}
+	}
+	return len(preferred)
+}
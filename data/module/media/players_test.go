@@ -0,0 +1,97 @@
+package media
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func testPlayer(name, status string) types.MediaPlayer {
+	title := name + " title"
+	return types.MediaPlayer{Name: name, Status: &status, Title: &title}
+}
+
+func TestSelectActive(t *testing.T) {
+	base := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+	players := []types.MediaPlayer{
+		testPlayer("firefox.instance_1_42", "PAUSED"),
+		testPlayer("spotify", "PLAYING"),
+		testPlayer("vlc", "PLAYING"),
+	}
+	startedAt := map[string]time.Time{
+		"spotify":               base,
+		"vlc":                   base.Add(time.Minute),
+		"firefox.instance_1_42": base.Add(2 * time.Minute),
+	}
+
+	tests := []struct {
+		name      string
+		policy    settings.SettingsMediaPlayerPolicy
+		preferred []string
+		expected  string
+	}{
+		{"Playing before paused", settings.MediaPlayerPolicyPlaying, nil, "spotify"},
+		{"Empty policy is playing", "", nil, "spotify"},
+		{"Playing ties broken by preference", settings.MediaPlayerPolicyPlaying, []string{"vlc"}, "vlc"},
+		{"Playing ignores preferred paused player", settings.MediaPlayerPolicyPlaying, []string{"firefox"}, "spotify"},
+		{"Last active playing player", settings.MediaPlayerPolicyLastActive, nil, "vlc"},
+		{"Preferred by prefix whatever the status", settings.MediaPlayerPolicyPreferred, []string{"Firefox", "spotify"}, "firefox.instance_1_42"},
+		{"Preferred falls back to playing", settings.MediaPlayerPolicyPreferred, []string{"mpv"}, "spotify"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			active := selectActive(players, tt.policy, tt.preferred, startedAt)
+			require.GreaterOrEqual(t, active, 0)
+			assert.Equal(t, tt.expected, players[active].Name)
+		})
+	}
+
+	assert.Equal(t, -1, selectActive(nil, settings.MediaPlayerPolicyPlaying, nil, nil))
+}
+
+func TestPlayerActivity(t *testing.T) {
+	a := &playerActivity{playing: map[string]bool{}, startedAt: map[string]time.Time{}}
+	base := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+
+	startedAt := a.update([]types.MediaPlayer{testPlayer("spotify", "PLAYING"), testPlayer("vlc", "PAUSED")}, base)
+	assert.Equal(t, map[string]time.Time{"spotify": base}, startedAt)
+
+	// Still playing keeps the original start, and vlc starts later
+	later := base.Add(time.Minute)
+	startedAt = a.update([]types.MediaPlayer{testPlayer("spotify", "PLAYING"), testPlayer("vlc", "PLAYING")}, later)
+	assert.Equal(t, map[string]time.Time{"spotify": base, "vlc": later}, startedAt)
+
+	// Pausing keeps the start, restarting moves it, and closed players are forgotten
+	latest := base.Add(2 * time.Minute)
+	a.update([]types.MediaPlayer{testPlayer("spotify", "PAUSED")}, latest)
+	startedAt = a.update([]types.MediaPlayer{testPlayer("spotify", "PLAYING")}, latest)
+	assert.Equal(t, map[string]time.Time{"spotify": latest}, startedAt)
+}
+
+func TestBuildMediaData(t *testing.T) {
+	now := time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC)
+
+	mediaData := buildMediaData([]types.MediaPlayer{
+		testPlayer("firefox", "PAUSED"),
+		testPlayer("spotify", "PLAYING"),
+	}, settings.MediaPlayerPolicyPlaying, nil, nil, now)
+
+	require.NotNil(t, mediaData.Player)
+	assert.Equal(t, "spotify", *mediaData.Player)
+	assert.Equal(t, "spotify title", *mediaData.Title)
+	assert.Equal(t, float64(now.Unix()), *mediaData.UpdatedAt)
+	require.Len(t, mediaData.Players, 2)
+	assert.False(t, mediaData.Players[0].IsActive)
+	assert.True(t, mediaData.Players[1].IsActive)
+
+	empty := buildMediaData(nil, settings.MediaPlayerPolicyPlaying, nil, nil, now)
+	assert.Nil(t, empty.Player)
+	assert.Nil(t, empty.Title)
+	assert.NotNil(t, empty.Players)
+	assert.NotNil(t, empty.UpdatedAt)
+}
@@ -202,18 +202,37 @@ func RunUpdateTaskProcessor(dataStore *DataStore) {
 		return false
 	}
 
-	mediaPlaying := isPlaying()
-	mediaInterval := 30 * time.Second
-	if mediaPlaying {
-		mediaInterval = 10 * time.Second
+	// Start platform-specific listeners (e.g., MPRIS on Linux) to trigger updates
+	mediaListening := StartMediaListener(dataStore)
+
+	// mediaTicker returns a ticker for media updates (30s idle, 10s when
+	// playing). Listeners report every change except the position moving
+	// during playback, so media is only polled while playing when listening.
+	mediaTicker := func(playing bool) (*time.Ticker, time.Duration) {
+		switch {
+		case playing:
+			return time.NewTicker(10 * time.Second), 10 * time.Second
+		case mediaListening:
+			return nil, 0
+		default:
+			return time.NewTicker(30 * time.Second), 30 * time.Second
+		}
+	}
+	// mediaTick is nil, and never fires, without a media ticker
+	mediaTick := func(ticker *time.Ticker) <-chan time.Time {
+		if ticker == nil {
+			return nil
+		}
+		return ticker.C
 	}
 
-	// Media-specific ticker for updates (30s idle, 10s when playing)
-	mediaTicker := time.NewTicker(mediaInterval)
-	defer mediaTicker.Stop()
-
-	// Start platform-specific listeners (e.g., playerctl on Linux) to trigger updates
-	StartPlayerctlListener(dataStore)
+	mediaPlaying := isPlaying()
+	currentMediaTicker, _ := mediaTicker(mediaPlaying)
+	defer func() {
+		if currentMediaTicker != nil {
+			currentMediaTicker.Stop()
+		}
+	}()
 
 	// Run continuously
 	for {
@@ -235,17 +254,15 @@ func RunUpdateTaskProcessor(dataStore *DataStore) {
 			curPlaying := isPlaying()
 			if curPlaying != mediaPlaying {
 				mediaPlaying = curPlaying
-				mediaTicker.Stop()
-				if mediaPlaying {
-					mediaInterval = 10 * time.Second
-				} else {
-					mediaInterval = 30 * time.Second
+				if currentMediaTicker != nil {
+					currentMediaTicker.Stop()
 				}
-				mediaTicker = time.NewTicker(mediaInterval)
-				slog.Info("Adjusted media update interval", "playing", mediaPlaying, "interval", mediaInterval)
+				var mediaInterval time.Duration
+				currentMediaTicker, mediaInterval = mediaTicker(mediaPlaying)
+				slog.Info("Adjusted media update interval", "playing", mediaPlaying, "interval", mediaInterval, "listening", mediaListening)
 			}
-		case <-mediaTicker.C:
-			// Add task for media module every 30 seconds
+		case <-mediaTick(currentMediaTicker):
+			// Add task for media module on the media interval
 			modules := dataStore.GetRegisteredModules()
 			for _, updater := range modules {
 				if updater == nil {
@@ -0,0 +1,27 @@
+package event
+
+import (
+	"time"
+
+	"github.com/timmo001/system-bridge/types"
+)
+
+// ClientInfo describes a client, as reported in its HELLO handshake
+type ClientInfo struct {
+	Name     string   `json:"name" mapstructure:"name"`
+	Version  string   `json:"version" mapstructure:"version"`
+	Platform string   `json:"platform" mapstructure:"platform"`
+	Features []string `json:"features" mapstructure:"features"`
+}
+
+// ClientSession describes a connected client session
+type ClientSession struct {
+	Transport   string             `json:"transport" mapstructure:"transport"`
+	Address     string             `json:"address" mapstructure:"address"`
+	SessionID   string             `json:"sessionId" mapstructure:"sessionId"`
+	Client      *ClientInfo        `json:"client,omitempty" mapstructure:"client,omitempty"`
+	ConnectedAt time.Time          `json:"connectedAt" mapstructure:"connectedAt"`
+	Modules     []types.ModuleName `json:"modules" mapstructure:"modules"`
+	MessagesIn  uint64             `json:"messagesIn" mapstructure:"messagesIn"`
+	MessagesOut uint64             `json:"messagesOut" mapstructure:"messagesOut"`
+}
@@ -240,10 +240,21 @@ func TestEventTypes(t *testing.T) {
 		assert.Equal(t, EventType("GET_DIRECTORY"), EventGetDirectory)
 		assert.Equal(t, EventType("GET_FILES"), EventGetFiles)
 		assert.Equal(t, EventType("GET_FILE"), EventGetFile)
+		assert.Equal(t, EventType("GET_MEDIA_FILE_URL"), EventGetMediaFileURL)
 		assert.Equal(t, EventType("GET_SETTINGS"), EventGetSettings)
 		assert.Equal(t, EventType("KEYBOARD_KEYPRESS"), EventKeyboardKeypress)
 		assert.Equal(t, EventType("KEYBOARD_TEXT"), EventKeyboardText)
+		assert.Equal(t, EventType("KEYBOARD_SEQUENCE"), EventKeyboardSequence)
+		assert.Equal(t, EventType("GET_KEYBOARD_KEYS"), EventGetKeyboardKeys)
+		assert.Equal(t, EventType("MOUSE_MOVE"), EventMouseMove)
+		assert.Equal(t, EventType("MOUSE_CLICK"), EventMouseClick)
+		assert.Equal(t, EventType("MOUSE_SCROLL"), EventMouseScroll)
+		assert.Equal(t, EventType("MOUSE_DRAG"), EventMouseDrag)
+		assert.Equal(t, EventType("GET_MOUSE_POSITION"), EventGetMousePosition)
 		assert.Equal(t, EventType("MEDIA_CONTROL"), EventMediaControl)
+		assert.Equal(t, EventType("MEDIA_LIBRARY_SEARCH"), EventMediaLibrarySearch)
+		assert.Equal(t, EventType("GET_MEDIA_LIBRARY_ARTISTS"), EventGetMediaLibraryArtists)
+		assert.Equal(t, EventType("GET_MEDIA_LIBRARY_ALBUMS"), EventGetMediaLibraryAlbums)
 		assert.Equal(t, EventType("NOTIFICATION"), EventNotification)
 		assert.Equal(t, EventType("OPEN"), EventOpen)
 		assert.Equal(t, EventType("POWER_HIBERNATE"), EventPowerHibernate)
@@ -4,28 +4,52 @@ package event
 type EventType string
 
 const (
-	EventExitApplication        EventType = "EXIT_APPLICATION"
-	EventGetData                EventType = "GET_DATA"
-	EventGetDirectories         EventType = "GET_DIRECTORIES"
-	EventGetDirectory           EventType = "GET_DIRECTORY"
-	EventGetFiles               EventType = "GET_FILES"
-	EventGetFile                EventType = "GET_FILE"
-	EventGetSettings            EventType = "GET_SETTINGS"
-	EventKeyboardKeypress       EventType = "KEYBOARD_KEYPRESS"
-	EventKeyboardText           EventType = "KEYBOARD_TEXT"
-	EventMediaControl           EventType = "MEDIA_CONTROL"
-	EventNotification           EventType = "NOTIFICATION"
-	EventOpen                   EventType = "OPEN"
-	EventPowerHibernate         EventType = "POWER_HIBERNATE"
-	EventPowerLock              EventType = "POWER_LOCK"
-	EventPowerLogout            EventType = "POWER_LOGOUT"
-	EventPowerRestart           EventType = "POWER_RESTART"
-	EventPowerShutdown          EventType = "POWER_SHUTDOWN"
-	EventPowerSleep             EventType = "POWER_SLEEP"
-	EventRegisterDataListener   EventType = "REGISTER_DATA_LISTENER"
-	EventUnregisterDataListener EventType = "UNREGISTER_DATA_LISTENER"
-	EventDataUpdate             EventType = "DATA_UPDATE"
-	EventCommandExecute         EventType = "COMMAND_EXECUTE"
-	EventUpdateSettings         EventType = "UPDATE_SETTINGS"
-	EventValidateDirectory      EventType = "VALIDATE_DIRECTORY"
+	EventDisconnectClient         EventType = "DISCONNECT_CLIENT"
+	EventExitApplication          EventType = "EXIT_APPLICATION"
+	EventGetClients               EventType = "GET_CLIENTS"
+	EventGetData                  EventType = "GET_DATA"
+	EventGetDirectories           EventType = "GET_DIRECTORIES"
+	EventGetDirectory             EventType = "GET_DIRECTORY"
+	EventGetFiles                 EventType = "GET_FILES"
+	EventGetFile                  EventType = "GET_FILE"
+	EventGetMediaFileURL          EventType = "GET_MEDIA_FILE_URL"
+	EventGetSettings              EventType = "GET_SETTINGS"
+	EventHello                    EventType = "HELLO"
+	EventKeyboardKeypress         EventType = "KEYBOARD_KEYPRESS"
+	EventKeyboardText             EventType = "KEYBOARD_TEXT"
+	EventKeyboardSequence         EventType = "KEYBOARD_SEQUENCE"
+	EventGetKeyboardKeys          EventType = "GET_KEYBOARD_KEYS"
+	EventMouseMove                EventType = "MOUSE_MOVE"
+	EventMouseClick               EventType = "MOUSE_CLICK"
+	EventMouseScroll              EventType = "MOUSE_SCROLL"
+	EventMouseDrag                EventType = "MOUSE_DRAG"
+	EventGetMousePosition         EventType = "GET_MOUSE_POSITION"
+	EventMediaControl             EventType = "MEDIA_CONTROL"
+	EventMediaLibrarySearch       EventType = "MEDIA_LIBRARY_SEARCH"
+	EventGetMediaLibraryArtists   EventType = "GET_MEDIA_LIBRARY_ARTISTS"
+	EventGetMediaLibraryAlbums    EventType = "GET_MEDIA_LIBRARY_ALBUMS"
+	EventNotification             EventType = "NOTIFICATION"
+	EventOpen                     EventType = "OPEN"
+	EventPowerHibernate           EventType = "POWER_HIBERNATE"
+	EventPowerLock                EventType = "POWER_LOCK"
+	EventPowerLogout              EventType = "POWER_LOGOUT"
+	EventPowerRestart             EventType = "POWER_RESTART"
+	EventPowerShutdown            EventType = "POWER_SHUTDOWN"
+	EventPowerSleep               EventType = "POWER_SLEEP"
+	EventRegisterDataListener     EventType = "REGISTER_DATA_LISTENER"
+	EventUnregisterDataListener   EventType = "UNREGISTER_DATA_LISTENER"
+	EventRegisterHotkeyListener   EventType = "REGISTER_HOTKEY_LISTENER"
+	EventUnregisterHotkeyListener EventType = "UNREGISTER_HOTKEY_LISTENER"
+	EventDataUpdate               EventType = "DATA_UPDATE"
+	EventCommandExecute           EventType = "COMMAND_EXECUTE"
+	EventCommandCancel            EventType = "COMMAND_CANCEL"
+	EventCommandListRunning       EventType = "COMMAND_LIST_RUNNING"
+	EventCommandHistory           EventType = "COMMAND_HISTORY"
+	EventCommandGetJob            EventType = "COMMAND_GET_JOB"
+	EventGetSchedules             EventType = "GET_SCHEDULES"
+	EventCreateSchedule           EventType = "CREATE_SCHEDULE"
+	EventDeleteSchedule           EventType = "DELETE_SCHEDULE"
+	EventRunMacro                 EventType = "RUN_MACRO"
+	EventUpdateSettings           EventType = "UPDATE_SETTINGS"
+	EventValidateDirectory        EventType = "VALIDATE_DIRECTORY"
 )
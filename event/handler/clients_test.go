@@ -0,0 +1,87 @@
+package event_handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func TestClientsHandlers(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+	_ = bus.NewEventBus()
+
+	router := event.NewMessageRouter()
+	ws := websocket.NewWebsocketServer("test-token", dataStore, router)
+	ws.AddTestConnection("clients-conn")
+	defer ws.RemoveTestConnection("clients-conn")
+
+	RegisterGetClientsHandler(router)
+	RegisterDisconnectClientHandler(router)
+
+	_, ok := ws.SetClientInfo("clients-conn", event.ClientInfo{Name: "Dashboard"})
+	require.True(t, ok)
+	ws.RegisterDataListener("clients-conn", []types.ModuleName{types.ModuleCPU})
+
+	t.Run("Lists connected clients", func(t *testing.T) {
+		response := router.HandleMessage("clients-conn", event.Message{
+			ID:    "clients-1",
+			Event: event.EventGetClients,
+		})
+
+		require.Equal(t, event.ResponseTypeClients, response.Type)
+		sessions, ok := response.Data.([]event.ClientSession)
+		require.True(t, ok)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, websocket.TransportWebSocket, sessions[0].Transport)
+		assert.Equal(t, "clients-conn", sessions[0].Address)
+		assert.NotEmpty(t, sessions[0].SessionID)
+		require.NotNil(t, sessions[0].Client)
+		assert.Equal(t, "Dashboard", sessions[0].Client.Name)
+		assert.Equal(t, []types.ModuleName{types.ModuleCPU}, sessions[0].Modules)
+	})
+
+	t.Run("Missing session ID", func(t *testing.T) {
+		response := router.HandleMessage("clients-conn", event.Message{
+			ID:    "clients-2",
+			Event: event.EventDisconnectClient,
+			Data:  map[string]any{},
+		})
+
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeMissingValue, response.Subtype)
+	})
+
+	t.Run("Unknown session ID", func(t *testing.T) {
+		response := router.HandleMessage("clients-conn", event.Message{
+			ID:    "clients-3",
+			Event: event.EventDisconnectClient,
+			Data:  map[string]any{"sessionId": "does-not-exist"},
+		})
+
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeClientNotFound, response.Subtype)
+	})
+
+	t.Run("Disconnects client", func(t *testing.T) {
+		session, ok := ws.GetSession("clients-conn")
+		require.True(t, ok)
+
+		response := router.HandleMessage("clients-conn", event.Message{
+			ID:    "clients-4",
+			Event: event.EventDisconnectClient,
+			Data:  map[string]any{"sessionId": session.SessionID},
+		})
+
+		assert.Equal(t, event.ResponseTypeClientDisconnected, response.Type)
+		assert.False(t, ws.ConnectionExists("clients-conn"))
+	})
+}
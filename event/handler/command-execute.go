@@ -5,6 +5,7 @@ import (
 	"log/slog"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/backend/websocket"
 	"github.com/timmo001/system-bridge/event"
 	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/utils/handlers/command"
@@ -16,7 +17,9 @@ func RegisterCommandExecuteHandler(router *event.MessageRouter) {
 
 		// Decode request data
 		var requestData struct {
-			CommandID string `json:"commandID" mapstructure:"commandID"`
+			CommandID string         `json:"commandID" mapstructure:"commandID"`
+			Stream    bool           `json:"stream" mapstructure:"stream"`
+			Params    map[string]any `json:"params" mapstructure:"params"`
 		}
 		err := mapstructure.Decode(message.Data, &requestData)
 		if err != nil {
@@ -55,12 +58,24 @@ func RegisterCommandExecuteHandler(router *event.MessageRouter) {
 		// Create execute request
 		executeReq := command.ExecuteRequest{
 			CommandID:  requestData.CommandID,
+			Stream:     requestData.Stream,
+			Params:     requestData.Params,
 			RequestID:  message.ID,
 			Connection: connection,
 		}
 
+		// Results are sent back over WebSocket connections. Other callers, such
+		// as the scheduler, fetch them by job ID.
+		var sink command.ResultSink
+		if ws := websocket.GetInstance(); ws != nil && ws.ConnectionExists(connection) {
+			sink = command.WebSocketSink{
+				Connection: connection,
+				RequestID:  message.ID,
+			}
+		}
+
 		// Execute command (async)
-		err = command.Execute(executeReq, cfg)
+		jobID, err := command.Execute(executeReq, cfg, sink)
 		if err != nil {
 			slog.Error("Failed to execute command", "error", err, "commandID", requestData.CommandID)
 
@@ -72,8 +87,10 @@ func RegisterCommandExecuteHandler(router *event.MessageRouter) {
 				subtype = event.ResponseSubtypeBadPath
 			} else if errors.Is(err, command.ErrWorkingDirInvalid) {
 				subtype = event.ResponseSubtypeBadDirectory
-			} else if errors.Is(err, command.ErrCommandEmpty) {
+			} else if errors.Is(err, command.ErrCommandEmpty) || errors.Is(err, command.ErrInvalidParameter) {
 				subtype = event.ResponseSubtypeBadRequest
+			} else if errors.Is(err, command.ErrConcurrencyLimit) {
+				subtype = event.ResponseSubtypeCommandLimitReached
 			}
 
 			return event.MessageResponse{
@@ -91,6 +108,7 @@ func RegisterCommandExecuteHandler(router *event.MessageRouter) {
 			Subtype: event.ResponseSubtypeNone,
 			Data: map[string]string{
 				"commandID": requestData.CommandID,
+				"jobID":     jobID,
 			},
 			Message: "Command is executing",
 		}
@@ -0,0 +1,57 @@
+package event_handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+func RegisterCommandGetJobHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventCommandGetJob, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received command get job event", "message", message, "connection", connection)
+
+		var data CommandJobRequestData
+		if err := mapstructure.Decode(message.Data, &data); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		if data.JobID == "" {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeMissingValue,
+				Message: "No job ID provided",
+			}
+		}
+
+		job, err := command.GetJob(data.JobID)
+		if err != nil {
+			subtype := event.ResponseSubtypeNone
+			if errors.Is(err, command.ErrJobNotFound) {
+				subtype = event.ResponseSubtypeJobNotFound
+			}
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: subtype,
+				Message: err.Error(),
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeCommandJob,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    job,
+			Message: "Command job",
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+type CommandHistoryRequestData struct {
+	CommandID string `json:"commandID" mapstructure:"commandID"`
+	Limit     int    `json:"limit" mapstructure:"limit"`
+}
+
+func RegisterCommandHistoryHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventCommandHistory, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received command history event", "message", message, "connection", connection)
+
+		var data CommandHistoryRequestData
+		if message.Data != nil {
+			if err := mapstructure.WeakDecode(message.Data, &data); err != nil {
+				return event.MessageResponse{
+					ID:      message.ID,
+					Type:    event.ResponseTypeError,
+					Subtype: event.ResponseSubtypeBadRequest,
+					Message: "Invalid request data format: " + err.Error(),
+				}
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeCommandHistory,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    command.History(data.CommandID, data.Limit),
+			Message: "Command history",
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+func RegisterCommandListRunningHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventCommandListRunning, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received command list running event", "message", message, "connection", connection)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeCommandRunning,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    command.ListRunning(),
+			Message: "Running commands",
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package event_handler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+)
+
+func TestCommandJobHandlers(t *testing.T) {
+	tmpDir := t.TempDir()
+	commandPath := filepath.Join(tmpDir, "job-command.sh")
+	err := os.WriteFile(commandPath, []byte("#!/bin/sh\necho job output\n"), 0755)
+	require.NoError(t, err)
+
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", tmpDir)
+	viper.Reset()
+
+	testSettings, err := settings.Load()
+	require.NoError(t, err)
+	testSettings.Commands.Allowlist = []settings.SettingsCommandDefinition{
+		{
+			ID:        "job-command",
+			Name:      "Job Command",
+			Command:   commandPath,
+			Arguments: []string{},
+		},
+	}
+	require.NoError(t, testSettings.Save())
+
+	setupTestWebSocket(t)
+
+	router := event.NewMessageRouter()
+	RegisterCommandExecuteHandler(router)
+	RegisterCommandCancelHandler(router)
+	RegisterCommandGetJobHandler(router)
+	RegisterCommandHistoryHandler(router)
+	RegisterCommandListRunningHandler(router)
+
+	response := router.HandleMessage("test-conn-1", event.Message{
+		ID:    "jobs-1",
+		Event: event.EventCommandExecute,
+		Data:  map[string]interface{}{"commandID": "job-command"},
+	})
+	require.Equal(t, event.ResponseTypeCommandExecuting, response.Type)
+	jobID := response.Data.(map[string]string)["jobID"]
+	require.NotEmpty(t, jobID)
+
+	t.Run("Get job after it finishes", func(t *testing.T) {
+		var job command.Job
+		require.Eventually(t, func() bool {
+			response := router.HandleMessage("test-conn-2", event.Message{
+				ID:    "jobs-2",
+				Event: event.EventCommandGetJob,
+				Data:  map[string]interface{}{"jobID": jobID},
+			})
+			if response.Type != event.ResponseTypeCommandJob {
+				return false
+			}
+			job = response.Data.(command.Job)
+			return job.Status != command.JobStatusRunning
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, command.JobStatusCompleted, job.Status)
+		assert.Equal(t, "job output\n", job.Stdout)
+	})
+
+	t.Run("History", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-2", event.Message{
+			ID:    "jobs-3",
+			Event: event.EventCommandHistory,
+			Data:  map[string]interface{}{"commandID": "job-command", "limit": 5},
+		})
+		require.Equal(t, event.ResponseTypeCommandHistory, response.Type)
+		history := response.Data.([]command.Job)
+		require.NotEmpty(t, history)
+		assert.Equal(t, jobID, history[0].ID)
+	})
+
+	t.Run("List running", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-2", event.Message{
+			ID:    "jobs-4",
+			Event: event.EventCommandListRunning,
+		})
+		require.Equal(t, event.ResponseTypeCommandRunning, response.Type)
+		assert.IsType(t, []command.Job{}, response.Data)
+	})
+
+	t.Run("Cancel missing job ID", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-2", event.Message{
+			ID:    "jobs-5",
+			Event: event.EventCommandCancel,
+			Data:  map[string]interface{}{},
+		})
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeMissingValue, response.Subtype)
+	})
+
+	t.Run("Cancel finished job", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-2", event.Message{
+			ID:    "jobs-6",
+			Event: event.EventCommandCancel,
+			Data:  map[string]interface{}{"jobID": jobID},
+		})
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeJobNotFound, response.Subtype)
+	})
+}
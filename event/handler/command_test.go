@@ -226,6 +226,7 @@ func TestCommandEventTypes(t *testing.T) {
 	t.Run("Command response type constants", func(t *testing.T) {
 		assert.Equal(t, event.ResponseType("COMMAND_EXECUTING"), event.ResponseTypeCommandExecuting)
 		assert.Equal(t, event.ResponseType("COMMAND_COMPLETED"), event.ResponseTypeCommandCompleted)
+		assert.Equal(t, event.ResponseType("COMMAND_OUTPUT"), event.ResponseTypeCommandOutput)
 	})
 
 	t.Run("Command response subtype constant", func(t *testing.T) {
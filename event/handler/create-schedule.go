@@ -0,0 +1,55 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/scheduler"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+func RegisterCreateScheduleHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventCreateSchedule, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received create schedule event", "message", message, "connection", connection)
+
+		var data settings.SettingsSchedule
+		if err := mapstructure.Decode(message.Data, &data); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		sched := scheduler.GetInstance()
+		if sched == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Scheduler not available",
+			}
+		}
+
+		schedule, err := sched.Create(data)
+		if err != nil {
+			slog.Error("Failed to create schedule", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: err.Error(),
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeScheduleCreated,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    schedule,
+			Message: "Schedule created",
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package event_handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/scheduler"
+)
+
+type DeleteScheduleRequestData struct {
+	ID string `json:"id" mapstructure:"id"`
+}
+
+func RegisterDeleteScheduleHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventDeleteSchedule, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received delete schedule event", "message", message, "connection", connection)
+
+		var data DeleteScheduleRequestData
+		if err := mapstructure.Decode(message.Data, &data); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		if data.ID == "" {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeMissingValue,
+				Message: "No schedule ID provided",
+			}
+		}
+
+		sched := scheduler.GetInstance()
+		if sched == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Scheduler not available",
+			}
+		}
+
+		if err := sched.Delete(data.ID); err != nil {
+			subtype := event.ResponseSubtypeNone
+			if errors.Is(err, scheduler.ErrScheduleNotFound) {
+				subtype = event.ResponseSubtypeScheduleNotFound
+			}
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: subtype,
+				Message: err.Error(),
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeScheduleDeleted,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    map[string]string{"id": data.ID},
+			Message: "Schedule deleted",
+		}
+	})
+}
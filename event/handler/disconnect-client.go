@@ -0,0 +1,55 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/backend/clients"
+	"github.com/timmo001/system-bridge/event"
+)
+
+type DisconnectClientRequestData struct {
+	SessionID string `json:"sessionId" mapstructure:"sessionId"`
+}
+
+func RegisterDisconnectClientHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventDisconnectClient, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received disconnect client event", "message", message, "connection", connection)
+
+		var data DisconnectClientRequestData
+		if err := mapstructure.Decode(message.Data, &data); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		if data.SessionID == "" {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeMissingValue,
+				Message: "No session ID provided",
+			}
+		}
+
+		if !clients.Disconnect(data.SessionID) {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeClientNotFound,
+				Message: "Client not found",
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeClientDisconnected,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    data,
+			Message: "Client disconnected",
+		}
+	})
+}
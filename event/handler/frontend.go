@@ -11,5 +11,9 @@ func settingsToFrontend(s *settings.Settings) map[string]interface{} {
 		"hotkeys":   s.Hotkeys,
 		"logLevel":  string(s.LogLevel),
 		"media":     s.Media,
+		"grpc":      s.GRPC,
+		"mcp":       s.MCP,
+		"schedules": s.Schedules,
+		"macros":    s.Macros,
 	}
 }
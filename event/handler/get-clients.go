@@ -0,0 +1,22 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/backend/clients"
+	"github.com/timmo001/system-bridge/event"
+)
+
+func RegisterGetClientsHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetClients, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get clients event", "message", message)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeClients,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    clients.List(),
+			Message: "Got clients",
+		}
+	})
+}
@@ -0,0 +1,22 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/keyboard"
+)
+
+func RegisterGetKeyboardKeysHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetKeyboardKeys, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get keyboard keys event", "message", message)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeKeyboardKeys,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    keyboard.GetKeys(),
+			Message: "Got keyboard keys",
+		}
+	})
+}
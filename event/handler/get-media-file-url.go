@@ -0,0 +1,91 @@
+package event_handler
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils"
+	"github.com/timmo001/system-bridge/utils/mediaurl"
+)
+
+type GetMediaFileURLRequestData struct {
+	Base string `json:"base" mapstructure:"base"`
+	Path string `json:"path" mapstructure:"path"`
+	// Download serves the file as an attachment instead of inline
+	Download bool `json:"download" mapstructure:"download"`
+	// ExpiresIn is how many seconds the URL lasts, defaulting to 4 hours
+	ExpiresIn int `json:"expiresIn" mapstructure:"expiresIn"`
+}
+
+type GetMediaFileURLResponseData struct {
+	// URL is the path and query to request from the server
+	URL string `json:"url"`
+	// Expires is when the URL expires, in seconds since the epoch
+	Expires int64 `json:"expires"`
+}
+
+func RegisterGetMediaFileURLHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetMediaFileURL, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get media file URL event", "message", message)
+
+		data := GetMediaFileURLRequestData{}
+		err := mapstructure.Decode(message.Data, &data)
+		if err != nil {
+			slog.Error("Failed to decode get media file URL event data", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to decode get media file URL event data",
+			}
+		}
+
+		// Validate request data
+		if data.Base == "" || data.Path == "" {
+			slog.Error("No base or path provided for get media file URL")
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "No base or path provided for get media file URL",
+			}
+		}
+		expiresIn := mediaurl.DefaultExpiry
+		if data.ExpiresIn != 0 {
+			expiresIn = time.Duration(data.ExpiresIn) * time.Second
+		}
+		if expiresIn <= 0 || expiresIn > mediaurl.MaxExpiry {
+			slog.Error("Invalid expiry provided for get media file URL", "expiresIn", data.ExpiresIn)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "expiresIn must be between 1 second and 7 days",
+			}
+		}
+
+		token, err := utils.LoadToken()
+		if err != nil {
+			slog.Error("Failed to load token for media file URL", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to sign media file URL",
+			}
+		}
+
+		expires := time.Now().Add(expiresIn)
+		url := mediaurl.Sign(token, mediaurl.File{Base: data.Base, Path: data.Path, Download: data.Download}, expires)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMediaFileURL,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    GetMediaFileURLResponseData{URL: url, Expires: expires.Unix()},
+			Message: "Got media file URL",
+		}
+	})
+}
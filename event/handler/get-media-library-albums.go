@@ -0,0 +1,51 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/medialibrary"
+)
+
+type GetMediaLibraryAlbumsRequestData struct {
+	// Artist lists only the albums of an artist, ignoring case
+	Artist string `json:"artist" mapstructure:"artist"`
+}
+
+func RegisterGetMediaLibraryAlbumsHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetMediaLibraryAlbums, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get media library albums event", "message", message)
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Media library not available",
+			}
+		}
+
+		data := GetMediaLibraryAlbumsRequestData{}
+		if message.Data != nil {
+			if err := mapstructure.Decode(message.Data, &data); err != nil {
+				slog.Error("Failed to decode get media library albums event data", "error", err)
+				return event.MessageResponse{
+					ID:      message.ID,
+					Type:    event.ResponseTypeError,
+					Subtype: event.ResponseSubtypeBadRequest,
+					Message: "Failed to decode get media library albums event data",
+				}
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMediaLibraryAlbums,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    library.Albums(data.Artist),
+			Message: "Got media library albums",
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/medialibrary"
+)
+
+func RegisterGetMediaLibraryArtistsHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetMediaLibraryArtists, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get media library artists event", "message", message)
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Media library not available",
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMediaLibraryArtists,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    library.Artists(),
+			Message: "Got media library artists",
+		}
+	})
+}
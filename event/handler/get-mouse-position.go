@@ -0,0 +1,23 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/mouse"
+)
+
+func RegisterGetMousePositionHandler(router *event.MessageRouter, dataStore *data.DataStore) {
+	router.RegisterSimpleHandler(event.EventGetMousePosition, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received get mouse position event", "message", message)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMousePosition,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    mouse.GetPosition(getDisplays(dataStore)),
+			Message: "Got mouse position",
+		}
+	})
+}
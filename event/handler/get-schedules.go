@@ -0,0 +1,43 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/scheduler"
+)
+
+func RegisterGetSchedulesHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventGetSchedules, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received get schedules event", "message", message, "connection", connection)
+
+		sched := scheduler.GetInstance()
+		if sched == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Scheduler not available",
+			}
+		}
+
+		schedules, err := sched.List()
+		if err != nil {
+			slog.Error("Failed to list schedules", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to list schedules: " + err.Error(),
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeSchedules,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    schedules,
+			Message: "Got schedules",
+		}
+	})
+}
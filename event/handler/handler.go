@@ -6,15 +6,29 @@ import (
 )
 
 func RegisterHandlers(router *event.MessageRouter, dataStore *data.DataStore) {
+	RegisterDisconnectClientHandler(router)
 	RegisterExitApplicationHandler(router)
+	RegisterGetClientsHandler(router)
 	RegisterGetDataHandler(router)
 	RegisterGetDirectoriesHandler(router)
 	RegisterGetFilesHandler(router)
 	RegisterGetFileHandler(router)
+	RegisterGetMediaFileURLHandler(router)
+	RegisterMediaLibrarySearchHandler(router)
+	RegisterGetMediaLibraryArtistsHandler(router)
+	RegisterGetMediaLibraryAlbumsHandler(router)
 	RegisterGetDirectoryHandler(router)
 	RegisterGetSettingsHandler(router)
+	RegisterHelloHandler(router, dataStore)
 	RegisterKeyboardKeypressHandler(router)
 	RegisterKeyboardTextHandler(router)
+	RegisterKeyboardSequenceHandler(router)
+	RegisterGetKeyboardKeysHandler(router)
+	RegisterMouseMoveHandler(router, dataStore)
+	RegisterMouseClickHandler(router, dataStore)
+	RegisterMouseScrollHandler(router)
+	RegisterMouseDragHandler(router, dataStore)
+	RegisterGetMousePositionHandler(router, dataStore)
 	RegisterMediaControlHandler(router, dataStore)
 	RegisterNotificationHandler(router)
 	RegisterOpenHandler(router)
@@ -26,7 +40,17 @@ func RegisterHandlers(router *event.MessageRouter, dataStore *data.DataStore) {
 	RegisterPowerSleepHandler(router)
 	RegisterRegisterDataListenerHandler(router)
 	RegisterUnregisterDataListenerHandler(router)
+	RegisterRegisterHotkeyListenerHandler(router)
+	RegisterUnregisterHotkeyListenerHandler(router)
+	RegisterCommandCancelHandler(router)
 	RegisterCommandExecuteHandler(router)
+	RegisterCommandGetJobHandler(router)
+	RegisterCommandHistoryHandler(router)
+	RegisterCommandListRunningHandler(router)
+	RegisterGetSchedulesHandler(router)
+	RegisterCreateScheduleHandler(router)
+	RegisterDeleteScheduleHandler(router)
+	RegisterRunMacroHandler(router)
 	RegisterUpdateSettingsHandler(router)
 	RegisterValidateDirectoryHandler(router)
 }
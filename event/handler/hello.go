@@ -0,0 +1,117 @@
+package event_handler
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
+	"github.com/timmo001/system-bridge/version"
+)
+
+// ServerFeatures are the optional protocol features this server supports.
+// Clients list the features they support in HELLO and the intersection is
+// returned as the negotiated set.
+var ServerFeatures = []string{
+	"command_execute",
+	"command_output_stream",
+	"data_listener",
+	"directory_validation",
+}
+
+// Encodings are the message encodings this server supports
+var Encodings = []string{"json"}
+
+type HelloRequestData = event.ClientInfo
+
+type HelloLimits struct {
+	MaxCommandOutputSize  int64 `json:"maxCommandOutputSize" mapstructure:"maxCommandOutputSize"`
+	CommandTimeoutSeconds int64 `json:"commandTimeoutSeconds" mapstructure:"commandTimeoutSeconds"`
+}
+
+type HelloResponseData struct {
+	APIVersion         string             `json:"apiVersion" mapstructure:"apiVersion"`
+	Version            string             `json:"version" mapstructure:"version"`
+	SessionID          string             `json:"sessionId,omitempty" mapstructure:"sessionId,omitempty"`
+	Events             []event.EventType  `json:"events" mapstructure:"events"`
+	Modules            []types.ModuleName `json:"modules" mapstructure:"modules"`
+	Encodings          []string           `json:"encodings" mapstructure:"encodings"`
+	Features           []string           `json:"features" mapstructure:"features"`
+	NegotiatedFeatures []string           `json:"negotiatedFeatures" mapstructure:"negotiatedFeatures"`
+	Limits             HelloLimits        `json:"limits" mapstructure:"limits"`
+}
+
+func RegisterHelloHandler(router *event.MessageRouter, dataStore *data.DataStore) {
+	router.RegisterSimpleHandler(event.EventHello, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received hello event", "message", message)
+
+		var client HelloRequestData
+		if err := mapstructure.Decode(message.Data, &client); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		var sessionID string
+		if ws := websocket.GetInstance(); ws != nil {
+			sessionID, _ = ws.SetClientInfo(connection, client)
+		}
+
+		slog.Info(
+			"Client hello",
+			"connection", connection,
+			"session", sessionID,
+			"client", client.Name,
+			"version", client.Version,
+			"platform", client.Platform,
+			"features", client.Features,
+		)
+
+		events := make([]event.EventType, 0, len(router.Handlers))
+		for eventType := range router.Handlers {
+			events = append(events, eventType)
+		}
+		slices.Sort(events)
+
+		modules := make([]types.ModuleName, 0)
+		for _, updater := range dataStore.GetRegisteredModules() {
+			modules = append(modules, updater.Name())
+		}
+		slices.Sort(modules)
+
+		negotiated := make([]string, 0)
+		for _, feature := range client.Features {
+			if slices.Contains(ServerFeatures, feature) && !slices.Contains(negotiated, feature) {
+				negotiated = append(negotiated, feature)
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeHello,
+			Subtype: event.ResponseSubtypeNone,
+			Data: HelloResponseData{
+				APIVersion:         version.APIVersion(),
+				Version:            version.Version,
+				SessionID:          sessionID,
+				Events:             events,
+				Modules:            modules,
+				Encodings:          Encodings,
+				Features:           ServerFeatures,
+				NegotiatedFeatures: negotiated,
+				Limits: HelloLimits{
+					MaxCommandOutputSize:  command.MaxOutputSize,
+					CommandTimeoutSeconds: int64(command.DefaultCommandTimeout.Seconds()),
+				},
+			},
+			Message: "Hello",
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package event_handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/version"
+)
+
+func TestHelloHandler(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+
+	router := event.NewMessageRouter()
+	ws := websocket.NewWebsocketServer("test-token", dataStore, router)
+	ws.AddTestConnection("hello-conn")
+	defer ws.RemoveTestConnection("hello-conn")
+
+	RegisterHelloHandler(router, dataStore)
+	RegisterGetDataHandler(router)
+
+	t.Run("Negotiates features and stores client info", func(t *testing.T) {
+		response := router.HandleMessage("hello-conn", event.Message{
+			ID:    "hello-1",
+			Event: event.EventHello,
+			Data: map[string]any{
+				"name":     "Test Client",
+				"version":  "1.2.3",
+				"platform": "linux",
+				"features": []string{"data_listener", "unknown_feature"},
+			},
+		})
+
+		require.Equal(t, event.ResponseTypeHello, response.Type)
+		assert.Equal(t, "hello-1", response.ID)
+
+		data, ok := response.Data.(HelloResponseData)
+		require.True(t, ok)
+		assert.Equal(t, version.APIVersion(), data.APIVersion)
+		assert.Equal(t, []event.EventType{event.EventGetData, event.EventHello}, data.Events)
+		assert.Contains(t, data.Modules, types.ModuleCPU)
+		assert.Equal(t, []string{"json"}, data.Encodings)
+		assert.Equal(t, []string{"data_listener"}, data.NegotiatedFeatures)
+		assert.NotZero(t, data.Limits.MaxCommandOutputSize)
+		assert.NotEmpty(t, data.SessionID)
+
+		session, ok := ws.GetSession("hello-conn")
+		require.True(t, ok)
+		assert.Equal(t, data.SessionID, session.SessionID)
+		require.NotNil(t, session.Client)
+		assert.Equal(t, "Test Client", session.Client.Name)
+		assert.Equal(t, "Test Client", ws.ClientName("hello-conn"))
+	})
+
+	t.Run("Invalid data", func(t *testing.T) {
+		response := router.HandleMessage("hello-conn", event.Message{
+			ID:    "hello-2",
+			Event: event.EventHello,
+			Data:  "not an object",
+		})
+
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeBadRequest, response.Subtype)
+	})
+}
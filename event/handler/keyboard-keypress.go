@@ -34,6 +34,15 @@ func RegisterKeyboardKeypressHandler(router *event.MessageRouter) {
 				Message: "No key provided for keyboard keypress",
 			}
 		}
+		if !keyboard.IsValidKey(data.Key) {
+			slog.Error("Unknown key provided for keyboard keypress", "key", data.Key)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeInvalidKey,
+				Message: "Unknown key, see GET_KEYBOARD_KEYS for the keys that can be pressed",
+			}
+		}
 
 		slog.Debug("Pressing keyboard key", "key", data.Key, "modifiers", data.Modifiers)
 
@@ -0,0 +1,55 @@
+package event_handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/keyboard"
+)
+
+func RegisterKeyboardSequenceHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventKeyboardSequence, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received keyboard sequence event", "message", message)
+
+		data := keyboard.SequenceData{}
+		err := mapstructure.Decode(message.Data, &data)
+		if err != nil {
+			slog.Error("Failed to decode keyboard sequence event data", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to decode keyboard sequence event data",
+			}
+		}
+
+		err = keyboard.SendSequence(data)
+		if errors.Is(err, keyboard.ErrInvalidSequence) {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: err.Error(),
+			}
+		}
+		if err != nil {
+			slog.Error("Failed to send keyboard sequence", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to send keyboard sequence",
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeKeyboardSequenceSent,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    message.Data,
+			Message: "Keyboard sequence sent",
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package event_handler
 
 import (
+	"errors"
 	"log/slog"
 
 	"github.com/mitchellh/mapstructure"
@@ -12,6 +13,21 @@ import (
 
 type MediaControlRequestData struct {
 	Action string `json:"action" mapstructure:"action"`
+	// Player is the name of the player to control, defaulting to the active
+	// player
+	Player string `json:"player,omitempty" mapstructure:"player"`
+	// Position is the position in seconds for SEEK, or the offset to seek by
+	// when Relative is set
+	Position *float64 `json:"position,omitempty" mapstructure:"position"`
+	Relative bool     `json:"relative,omitempty" mapstructure:"relative"`
+	// Volume is the volume from 0 to 100 for SET_VOLUME
+	Volume *float64 `json:"volume,omitempty" mapstructure:"volume"`
+	// Shuffle is the shuffle state for SET_SHUFFLE
+	Shuffle *bool `json:"shuffle,omitempty" mapstructure:"shuffle"`
+	// Repeat is NONE, TRACK or PLAYLIST for SET_REPEAT
+	Repeat string `json:"repeat,omitempty" mapstructure:"repeat"`
+	// Rate is the playback rate for SET_RATE, where 1 is normal speed
+	Rate *float64 `json:"rate,omitempty" mapstructure:"rate"`
 }
 
 func RegisterMediaControlHandler(router *event.MessageRouter, dataStore *data.DataStore) {
@@ -41,7 +57,60 @@ func RegisterMediaControlHandler(router *event.MessageRouter, dataStore *data.Da
 			}
 		}
 
-		err = media.Control(media.MediaAction(data.Action))
+		request := media.ControlRequest{
+			Action:   media.MediaAction(data.Action),
+			Position: data.Position,
+			Relative: data.Relative,
+			Volume:   data.Volume,
+			Shuffle:  data.Shuffle,
+			Repeat:   data.Repeat,
+			Rate:     data.Rate,
+		}
+		if err := request.Validate(); err != nil {
+			slog.Error("Invalid media control request", "error", err)
+			subtype := event.ResponseSubtypeBadRequest
+			if errors.Is(err, media.ErrInvalidAction) {
+				subtype = event.ResponseSubtypeInvalidAction
+			}
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: subtype,
+				Message: err.Error(),
+			}
+		}
+
+		player, ok := resolveMediaPlayer(dataStore, data.Player)
+		if !ok {
+			slog.Error("Unknown player provided for media control", "player", data.Player)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypePlayerNotFound,
+				Message: "Player not found",
+			}
+		}
+
+		request.Player = player
+		err = media.Control(request)
+		if errors.Is(err, media.ErrNotSupported) {
+			slog.Error("Media action not supported", "action", data.Action)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeInvalidAction,
+				Message: err.Error(),
+			}
+		}
+		if errors.Is(err, media.ErrPlayerNotFound) {
+			slog.Error("Player to control is not running", "player", player, "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypePlayerNotFound,
+				Message: "Player not found",
+			}
+		}
 		if err != nil {
 			slog.Error("Failed to control media", "error", err)
 			return event.MessageResponse{
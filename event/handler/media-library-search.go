@@ -0,0 +1,56 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/medialibrary"
+)
+
+func RegisterMediaLibrarySearchHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventMediaLibrarySearch, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received media library search event", "message", message)
+
+		library := medialibrary.GetInstance()
+		if library == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Media library not available",
+			}
+		}
+
+		query := medialibrary.Query{}
+		if message.Data != nil {
+			if err := mapstructure.Decode(message.Data, &query); err != nil {
+				slog.Error("Failed to decode media library search event data", "error", err)
+				return event.MessageResponse{
+					ID:      message.ID,
+					Type:    event.ResponseTypeError,
+					Subtype: event.ResponseSubtypeBadRequest,
+					Message: "Failed to decode media library search event data",
+				}
+			}
+		}
+
+		result, err := library.Search(query)
+		if err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: err.Error(),
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMediaLibraryItems,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    result,
+			Message: "Searched media library",
+		}
+	})
+}
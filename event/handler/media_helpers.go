@@ -0,0 +1,41 @@
+package event_handler
+
+import (
+	"strings"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/mpris"
+)
+
+// resolveMediaPlayer returns the name of the player a media event targets.
+// An empty player resolves to the active player. It reports false when the
+// player is not one the media module knows of.
+func resolveMediaPlayer(dataStore *data.DataStore, player string) (string, bool) {
+	var mediaData types.MediaData
+	if !getModuleData(dataStore, types.ModuleMedia, &mediaData) {
+		// Without media data the player cannot be checked, so pass it through
+		return player, true
+	}
+
+	if player == "" {
+		if mediaData.Player != nil {
+			return *mediaData.Player, true
+		}
+		return "", true
+	}
+
+	for _, p := range mediaData.Players {
+		if strings.EqualFold(p.Name, player) {
+			return p.Name, true
+		}
+	}
+	// Like the MPRIS client, accept names without an instance suffix, such as
+	// firefox for firefox.instance_1_42
+	for _, p := range mediaData.Players {
+		if strings.EqualFold(mpris.BaseName(p.Name), player) {
+			return p.Name, true
+		}
+	}
+	return player, false
+}
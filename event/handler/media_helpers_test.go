@@ -0,0 +1,42 @@
+package event_handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/types"
+)
+
+func TestResolveMediaPlayer(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	dataStore, err := data.NewDataStore()
+	require.NoError(t, err)
+	active := "spotify"
+	require.NoError(t, dataStore.SetModuleData(types.ModuleMedia, types.MediaData{
+		Player: &active,
+		Players: []types.MediaPlayer{
+			{Name: "spotify", IsActive: true},
+			{Name: "firefox.instance_1_42"},
+		},
+	}))
+
+	tests := []struct {
+		player   string
+		expected string
+		found    bool
+	}{
+		{"", "spotify", true},
+		{"Spotify", "spotify", true},
+		{"firefox.instance_1_42", "firefox.instance_1_42", true},
+		{"firefox", "firefox.instance_1_42", true},
+		{"vlc", "vlc", false},
+	}
+	for _, tt := range tests {
+		player, found := resolveMediaPlayer(dataStore, tt.player)
+		assert.Equal(t, tt.expected, player, tt.player)
+		assert.Equal(t, tt.found, found, tt.player)
+	}
+}
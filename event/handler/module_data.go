@@ -0,0 +1,35 @@
+package event_handler
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// getModuleData decodes the data of a module into v, reporting whether the
+// data is available
+func getModuleData(dataStore *data.DataStore, name types.ModuleName, v any) bool {
+	if dataStore == nil {
+		return false
+	}
+
+	module, err := dataStore.GetModule(name)
+	if err != nil || module.Data == nil {
+		slog.Debug("Module data is not available", "module", name, "error", err)
+		return false
+	}
+
+	// The module data may be typed or decoded JSON depending on its source
+	raw, err := json.Marshal(module.Data)
+	if err != nil {
+		slog.Warn("Failed to encode module data", "module", name, "error", err)
+		return false
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		slog.Warn("Failed to decode module data", "module", name, "error", err)
+		return false
+	}
+	return true
+}
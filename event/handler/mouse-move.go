@@ -0,0 +1,40 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/mouse"
+)
+
+func RegisterMouseMoveHandler(router *event.MessageRouter, dataStore *data.DataStore) {
+	router.RegisterSimpleHandler(event.EventMouseMove, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received mouse move event", "message", message)
+
+		data := mouse.MoveData{}
+		err := mapstructure.Decode(message.Data, &data)
+		if err != nil {
+			slog.Error("Failed to decode mouse move event data", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to decode mouse move event data",
+			}
+		}
+
+		if err := mouse.Move(data, getDisplays(dataStore)); err != nil {
+			return mouseErrorResponse(message, err, "Failed to move mouse")
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMouseMoved,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    message.Data,
+			Message: "Mouse moved",
+		}
+	})
+}
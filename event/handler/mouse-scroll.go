@@ -0,0 +1,39 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/utils/handlers/mouse"
+)
+
+func RegisterMouseScrollHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventMouseScroll, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received mouse scroll event", "message", message)
+
+		data := mouse.ScrollData{}
+		err := mapstructure.Decode(message.Data, &data)
+		if err != nil {
+			slog.Error("Failed to decode mouse scroll event data", "error", err)
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Failed to decode mouse scroll event data",
+			}
+		}
+
+		if err := mouse.Scroll(data); err != nil {
+			return mouseErrorResponse(message, err, "Failed to scroll mouse")
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMouseScrolled,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    message.Data,
+			Message: "Mouse scrolled",
+		}
+	})
+}
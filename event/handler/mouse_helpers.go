@@ -0,0 +1,50 @@
+package event_handler
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/data"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/types"
+	"github.com/timmo001/system-bridge/utils/handlers/mouse"
+)
+
+// getDisplays returns the displays from the displays module, or nil if they
+// are not known
+func getDisplays(dataStore *data.DataStore) []types.Display {
+	var displays []types.Display
+	if !getModuleData(dataStore, types.ModuleDisplays, &displays) {
+		return nil
+	}
+	return displays
+}
+
+// mouseErrorResponse returns the response for a failed mouse action, telling
+// invalid requests apart from failures to control the pointer
+func mouseErrorResponse(message event.Message, err error, failure string) event.MessageResponse {
+	switch {
+	case errors.Is(err, mouse.ErrInvalidPosition):
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeError,
+			Subtype: event.ResponseSubtypeInvalidPosition,
+			Message: err.Error(),
+		}
+	case errors.Is(err, mouse.ErrInvalidRequest):
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeError,
+			Subtype: event.ResponseSubtypeBadRequest,
+			Message: err.Error(),
+		}
+	default:
+		slog.Error(failure, "error", err)
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeError,
+			Subtype: event.ResponseSubtypeNone,
+			Message: failure,
+		}
+	}
+}
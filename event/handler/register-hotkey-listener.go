@@ -0,0 +1,50 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+)
+
+func RegisterRegisterHotkeyListenerHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventRegisterHotkeyListener, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received register hotkey listener event", "message", message)
+
+		ws := websocket.GetInstance()
+		if ws == nil {
+			slog.Error("No websocket instance found")
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "No websocket instance found",
+			}
+		}
+
+		if !ws.ConnectionExists(connection) {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Hotkey listeners must be WebSocket connections",
+			}
+		}
+
+		if ws.RegisterHotkeyListener(connection) == websocket.RegisterResponseExists {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeListenerAlreadyRegistered,
+				Message: "Hotkey listener already registered",
+			}
+		}
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeHotkeyListenerRegistered,
+			Subtype: event.ResponseSubtypeNone,
+			Message: "Hotkey listener registered",
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package event_handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/macro"
+)
+
+type RunMacroRequestData struct {
+	MacroID string `json:"macroID" mapstructure:"macroID"`
+}
+
+func RegisterRunMacroHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventRunMacro, func(connection string, message event.Message) event.MessageResponse {
+		slog.Info("Received run macro event", "message", message, "connection", connection)
+
+		var data RunMacroRequestData
+		if err := mapstructure.Decode(message.Data, &data); err != nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeBadRequest,
+				Message: "Invalid request data format: " + err.Error(),
+			}
+		}
+
+		if data.MacroID == "" {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeMissingValue,
+				Message: "No macro ID provided",
+			}
+		}
+
+		runner := macro.GetInstance()
+		if runner == nil {
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "Macro runner not available",
+			}
+		}
+
+		// Macros can run for minutes, so those started over WebSocket run in
+		// the background and send MACRO_COMPLETED when they finish, rather
+		// than blocking the connection's read loop. Other callers, such as
+		// MCP and hotkeys, wait for the result.
+		ws := websocket.GetInstance()
+		var ctx context.Context
+		if ws != nil {
+			ctx, _ = ws.ConnectionContext(connection)
+		}
+		if ctx == nil {
+			result, err := runner.Run(context.Background(), data.MacroID)
+			return macroResponse(message.ID, result, err)
+		}
+
+		// Unknown macros are reported straight away
+		macros, err := runner.List()
+		if err != nil {
+			return macroResponse(message.ID, macro.Result{}, err)
+		}
+		found := false
+		for _, m := range macros {
+			if m.ID == data.MacroID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return macroResponse(message.ID, macro.Result{}, macro.ErrMacroNotFound)
+		}
+
+		go func() {
+			// Closing the connection cancels the macro before its next step
+			result, err := runner.Run(ctx, data.MacroID)
+			if !ws.SendMessageToAddress(connection, macroResponse(message.ID, result, err)) {
+				slog.Warn("Macro finished after its connection closed", "macroID", data.MacroID, "connection", connection)
+			}
+		}()
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeMacroStarted,
+			Subtype: event.ResponseSubtypeNone,
+			Data:    map[string]string{"macroID": data.MacroID},
+			Message: "Macro started",
+		}
+	})
+}
+
+// macroResponse returns the MACRO_COMPLETED response for a macro run, or an
+// error response if it could not run
+func macroResponse(id string, result macro.Result, err error) event.MessageResponse {
+	if err != nil {
+		subtype := event.ResponseSubtypeNone
+		if errors.Is(err, macro.ErrMacroNotFound) {
+			subtype = event.ResponseSubtypeMacroNotFound
+		}
+		return event.MessageResponse{
+			ID:      id,
+			Type:    event.ResponseTypeError,
+			Subtype: subtype,
+			Message: err.Error(),
+		}
+	}
+
+	responseMessage := "Macro completed"
+	if result.Status == macro.StatusError {
+		responseMessage = "Macro completed with errors"
+		if result.Error != "" {
+			responseMessage = result.Error
+		}
+	}
+
+	return event.MessageResponse{
+		ID:      id,
+		Type:    event.ResponseTypeMacroCompleted,
+		Subtype: event.ResponseSubtypeNone,
+		Data:    result,
+		Message: responseMessage,
+	}
+}
@@ -0,0 +1,98 @@
+package event_handler
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/macro"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+func TestRunMacroHandler(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+
+	testSettings, err := settings.Load()
+	require.NoError(t, err)
+	testSettings.Macros = []settings.SettingsMacro{
+		{
+			ID:   "slow",
+			Name: "Slow",
+			Steps: []settings.SettingsMacroStep{
+				{Event: string(event.EventPowerLock)},
+				{Event: string(event.EventMediaControl), DelayMs: 200},
+			},
+		},
+		{
+			ID:    "quick",
+			Name:  "Quick",
+			Steps: []settings.SettingsMacroStep{{Event: string(event.EventMediaControl)}},
+		},
+	}
+	require.NoError(t, testSettings.Save())
+
+	setupTestWebSocket(t)
+
+	router := event.NewMessageRouter()
+	RegisterRunMacroHandler(router)
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	router.RegisterSimpleHandler(event.EventPowerLock, func(connection string, message event.Message) event.MessageResponse {
+		close(locked)
+		<-release
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypePowerLocking}
+	})
+	var mediaControls atomic.Int32
+	router.RegisterSimpleHandler(event.EventMediaControl, func(connection string, message event.Message) event.MessageResponse {
+		mediaControls.Add(1)
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeMediaControlled}
+	})
+
+	macro.SetInstance(macro.NewRunner(router, nil))
+	t.Cleanup(func() { macro.SetInstance(nil) })
+
+	t.Run("WebSocket connections do not wait for the macro", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-1", event.Message{
+			ID:    "macro-1",
+			Event: event.EventRunMacro,
+			Data:  map[string]any{"macroID": "slow"},
+		})
+		assert.Equal(t, event.ResponseTypeMacroStarted, response.Type)
+		assert.Equal(t, map[string]string{"macroID": "slow"}, response.Data)
+
+		// Closing the connection stops the macro before its next step
+		<-locked
+		websocket.GetInstance().RemoveTestConnection("test-conn-1")
+		close(release)
+		assert.Never(t, func() bool { return mediaControls.Load() > 0 }, 500*time.Millisecond, 20*time.Millisecond)
+	})
+
+	t.Run("Unknown macro", func(t *testing.T) {
+		response := router.HandleMessage("test-conn-2", event.Message{
+			ID:    "macro-2",
+			Event: event.EventRunMacro,
+			Data:  map[string]any{"macroID": "missing"},
+		})
+		assert.Equal(t, event.ResponseTypeError, response.Type)
+		assert.Equal(t, event.ResponseSubtypeMacroNotFound, response.Subtype)
+	})
+
+	t.Run("Other callers wait for the result", func(t *testing.T) {
+		response := router.HandleMessage("mcp", event.Message{
+			ID:    "macro-3",
+			Event: event.EventRunMacro,
+			Data:  map[string]any{"macroID": "quick"},
+		})
+		require.Equal(t, event.ResponseTypeMacroCompleted, response.Type)
+		result, ok := response.Data.(macro.Result)
+		require.True(t, ok)
+		assert.Equal(t, macro.StatusOK, result.Status)
+		assert.Equal(t, int32(1), mediaControls.Load())
+	})
+}
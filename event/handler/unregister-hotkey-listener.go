@@ -0,0 +1,34 @@
+package event_handler
+
+import (
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+)
+
+func RegisterUnregisterHotkeyListenerHandler(router *event.MessageRouter) {
+	router.RegisterSimpleHandler(event.EventUnregisterHotkeyListener, func(connection string, message event.Message) event.MessageResponse {
+		slog.Debug("Received unregister hotkey listener event", "message", message)
+
+		ws := websocket.GetInstance()
+		if ws == nil {
+			slog.Error("No websocket instance found")
+			return event.MessageResponse{
+				ID:      message.ID,
+				Type:    event.ResponseTypeError,
+				Subtype: event.ResponseSubtypeNone,
+				Message: "No websocket instance found",
+			}
+		}
+
+		ws.UnregisterHotkeyListener(connection)
+
+		return event.MessageResponse{
+			ID:      message.ID,
+			Type:    event.ResponseTypeHotkeyListenerUnregistered,
+			Subtype: event.ResponseSubtypeNone,
+			Message: "Hotkey listener unregistered",
+		}
+	})
+}
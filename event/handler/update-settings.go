@@ -12,6 +12,9 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/hotkey"
+	"github.com/timmo001/system-bridge/medialibrary"
+	"github.com/timmo001/system-bridge/scheduler"
 	settingspkg "github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/utils"
 	"github.com/timmo001/system-bridge/utils/handlers/settings"
@@ -85,6 +88,8 @@ func RegisterUpdateSettingsHandler(router *event.MessageRouter) {
 			}
 		}
 
+		keepOmittedSections(message.Data, currentSettings, &newSettings)
+
 		err = settings.Update(currentSettings, &newSettings)
 		if err != nil {
 			slog.Error("Failed to update settings", "error", err)
@@ -165,6 +170,17 @@ func RegisterUpdateSettingsHandler(router *event.MessageRouter) {
 			}
 		}
 
+		// Pick up schedule, hotkey and media directory changes straight away
+		if sched := scheduler.GetInstance(); sched != nil {
+			sched.Reload()
+		}
+		if hotkeys := hotkey.GetInstance(); hotkeys != nil {
+			hotkeys.Reload()
+		}
+		if library := medialibrary.GetInstance(); library != nil {
+			library.Reload()
+		}
+
 		if originalSettings.LogLevel != newSettings.LogLevel {
 			slog.Info("LogLevel has changed:", "original", originalSettings.LogLevel, "new", newSettings.LogLevel)
 			logging.SetLogLevel(newSettings.LogLevel.ToSlogLevel())
@@ -179,3 +195,19 @@ func RegisterUpdateSettingsHandler(router *event.MessageRouter) {
 		}
 	})
 }
+
+// keepOmittedSections copies the top-level sections a request leaves out from
+// the current settings, so clients that only know some sections, such as
+// older web clients, do not reset the others. Sections that are sent are
+// replaced as a whole.
+func keepOmittedSections(data any, current, updated *settingspkg.Settings) {
+	sent, _ := data.(map[string]any)
+	currentValue := reflect.ValueOf(current).Elem()
+	updatedValue := reflect.ValueOf(updated).Elem()
+	for i := range currentValue.NumField() {
+		name := currentValue.Type().Field(i).Tag.Get("mapstructure")
+		if _, ok := sent[name]; !ok {
+			updatedValue.Field(i).Set(currentValue.Field(i))
+		}
+	}
+}
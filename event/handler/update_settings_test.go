@@ -0,0 +1,64 @@
+package event_handler
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+func TestUpdateSettingsKeepsOmittedSections(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+
+	cfg, err := settings.Load()
+	require.NoError(t, err)
+	cfg.GRPC = settings.SettingsGRPC{Enabled: true, Port: 9171}
+	cfg.MCP.Tools = map[string]bool{"system_bridge_command_execute": false}
+	cfg.Schedules = []settings.SettingsSchedule{{ID: "night", Cron: "0 22 * * *", Event: string(event.EventPowerLock)}}
+	cfg.Macros = []settings.SettingsMacro{{ID: "lock", Name: "Lock", Steps: []settings.SettingsMacroStep{{Event: string(event.EventPowerLock)}}}}
+	require.NoError(t, cfg.Save())
+
+	router := event.NewMessageRouter()
+	RegisterUpdateSettingsHandler(router)
+
+	// The sections an older web client sends
+	response := router.HandleMessage("test-conn", event.Message{
+		ID:    "settings-1",
+		Event: event.EventUpdateSettings,
+		Data: map[string]any{
+			"autostart": false,
+			"hotkeys":   []any{},
+			"logLevel":  "DEBUG",
+			"commands":  map[string]any{"allowlist": []any{}},
+			"media":     map[string]any{"directories": []any{}},
+		},
+	})
+	require.Equal(t, event.ResponseTypeSettingsUpdated, response.Type, response.Message)
+
+	updated, err := settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.LogLevelDebug, updated.LogLevel)
+	assert.Equal(t, settings.SettingsGRPC{Enabled: true, Port: 9171}, updated.GRPC)
+	assert.Equal(t, map[string]bool{"system_bridge_command_execute": false}, updated.MCP.Tools)
+	assert.Len(t, updated.Schedules, 1)
+	assert.Len(t, updated.Macros, 1)
+
+	// Sections that are sent are replaced as a whole
+	response = router.HandleMessage("test-conn", event.Message{
+		ID:    "settings-2",
+		Event: event.EventUpdateSettings,
+		Data:  map[string]any{"grpc": map[string]any{"port": 9172}, "macros": []any{}},
+	})
+	require.Equal(t, event.ResponseTypeSettingsUpdated, response.Type, response.Message)
+
+	updated, err = settings.Load()
+	require.NoError(t, err)
+	assert.Equal(t, settings.SettingsGRPC{Enabled: false, Port: 9172}, updated.GRPC)
+	assert.Empty(t, updated.Macros)
+	assert.Len(t, updated.Schedules, 1)
+	assert.Equal(t, settings.LogLevelDebug, updated.LogLevel)
+}
@@ -4,32 +4,59 @@ package event
 type ResponseType string
 
 const (
-	ResponseTypeError                    ResponseType = "ERROR"
-	ResponseTypeApplicationExiting       ResponseType = "APPLICATION_EXITING"
-	ResponseTypeDataGet                  ResponseType = "DATA_GET"
-	ResponseTypeDirectories              ResponseType = "DIRECTORIES"
-	ResponseTypeDirectory                ResponseType = "DIRECTORY"
-	ResponseTypeFiles                    ResponseType = "FILES"
-	ResponseTypeFile                     ResponseType = "FILE"
-	ResponseTypeKeyboardKeyPressed       ResponseType = "KEYBOARD_KEY_PRESSED"
-	ResponseTypeKeyboardTextSent         ResponseType = "KEYBOARD_TEXT_SENT"
-	ResponseTypeMediaControlled          ResponseType = "MEDIA_CONTROLLED"
-	ResponseTypeNotificationSent         ResponseType = "NOTIFICATION_SENT"
-	ResponseTypeOpened                   ResponseType = "OPENED"
-	ResponseTypePowerHibernating         ResponseType = "POWER_HIBERNATING"
-	ResponseTypePowerLocking             ResponseType = "POWER_LOCKING"
-	ResponseTypePowerLoggingout          ResponseType = "POWER_LOGGINGOUT"
-	ResponseTypePowerRestarting          ResponseType = "POWER_RESTARTING"
-	ResponseTypePowerShuttingdown        ResponseType = "POWER_SHUTTINGDOWN"
-	ResponseTypePowerSleeping            ResponseType = "POWER_SLEEPING"
-	ResponseTypeDataListenerRegistered   ResponseType = "DATA_LISTENER_REGISTERED"
-	ResponseTypeDataListenerUnregistered ResponseType = "DATA_LISTENER_UNREGISTERED"
-	ResponseTypeDataUpdate               ResponseType = "DATA_UPDATE"
-	ResponseTypeCommandExecuting         ResponseType = "COMMAND_EXECUTING"
-	ResponseTypeCommandCompleted         ResponseType = "COMMAND_COMPLETED"
-	ResponseTypeSettingsResult           ResponseType = "SETTINGS_RESULT"
-	ResponseTypeSettingsUpdated          ResponseType = "SETTINGS_UPDATED"
-	ResponseTypeDirectoryValidated       ResponseType = "DIRECTORY_VALIDATED"
+	ResponseTypeError                      ResponseType = "ERROR"
+	ResponseTypeApplicationExiting         ResponseType = "APPLICATION_EXITING"
+	ResponseTypeDataGet                    ResponseType = "DATA_GET"
+	ResponseTypeDirectories                ResponseType = "DIRECTORIES"
+	ResponseTypeDirectory                  ResponseType = "DIRECTORY"
+	ResponseTypeFiles                      ResponseType = "FILES"
+	ResponseTypeFile                       ResponseType = "FILE"
+	ResponseTypeMediaFileURL               ResponseType = "MEDIA_FILE_URL"
+	ResponseTypeKeyboardKeyPressed         ResponseType = "KEYBOARD_KEY_PRESSED"
+	ResponseTypeKeyboardTextSent           ResponseType = "KEYBOARD_TEXT_SENT"
+	ResponseTypeKeyboardSequenceSent       ResponseType = "KEYBOARD_SEQUENCE_SENT"
+	ResponseTypeKeyboardKeys               ResponseType = "KEYBOARD_KEYS"
+	ResponseTypeMouseMoved                 ResponseType = "MOUSE_MOVED"
+	ResponseTypeMouseClicked               ResponseType = "MOUSE_CLICKED"
+	ResponseTypeMouseScrolled              ResponseType = "MOUSE_SCROLLED"
+	ResponseTypeMouseDragged               ResponseType = "MOUSE_DRAGGED"
+	ResponseTypeMousePosition              ResponseType = "MOUSE_POSITION"
+	ResponseTypeMediaControlled            ResponseType = "MEDIA_CONTROLLED"
+	ResponseTypeMediaLibraryItems          ResponseType = "MEDIA_LIBRARY_ITEMS"
+	ResponseTypeMediaLibraryArtists        ResponseType = "MEDIA_LIBRARY_ARTISTS"
+	ResponseTypeMediaLibraryAlbums         ResponseType = "MEDIA_LIBRARY_ALBUMS"
+	ResponseTypeNotificationSent           ResponseType = "NOTIFICATION_SENT"
+	ResponseTypeOpened                     ResponseType = "OPENED"
+	ResponseTypePowerHibernating           ResponseType = "POWER_HIBERNATING"
+	ResponseTypePowerLocking               ResponseType = "POWER_LOCKING"
+	ResponseTypePowerLoggingout            ResponseType = "POWER_LOGGINGOUT"
+	ResponseTypePowerRestarting            ResponseType = "POWER_RESTARTING"
+	ResponseTypePowerShuttingdown          ResponseType = "POWER_SHUTTINGDOWN"
+	ResponseTypePowerSleeping              ResponseType = "POWER_SLEEPING"
+	ResponseTypeDataListenerRegistered     ResponseType = "DATA_LISTENER_REGISTERED"
+	ResponseTypeDataListenerUnregistered   ResponseType = "DATA_LISTENER_UNREGISTERED"
+	ResponseTypeDataUpdate                 ResponseType = "DATA_UPDATE"
+	ResponseTypeHotkeyListenerRegistered   ResponseType = "HOTKEY_LISTENER_REGISTERED"
+	ResponseTypeHotkeyListenerUnregistered ResponseType = "HOTKEY_LISTENER_UNREGISTERED"
+	ResponseTypeHotkeyPressed              ResponseType = "HOTKEY_PRESSED"
+	ResponseTypeCommandExecuting           ResponseType = "COMMAND_EXECUTING"
+	ResponseTypeCommandCompleted           ResponseType = "COMMAND_COMPLETED"
+	ResponseTypeCommandOutput              ResponseType = "COMMAND_OUTPUT"
+	ResponseTypeCommandCanceled            ResponseType = "COMMAND_CANCELED"
+	ResponseTypeCommandRunning             ResponseType = "COMMAND_RUNNING"
+	ResponseTypeCommandHistory             ResponseType = "COMMAND_HISTORY"
+	ResponseTypeCommandJob                 ResponseType = "COMMAND_JOB"
+	ResponseTypeSchedules                  ResponseType = "SCHEDULES"
+	ResponseTypeScheduleCreated            ResponseType = "SCHEDULE_CREATED"
+	ResponseTypeScheduleDeleted            ResponseType = "SCHEDULE_DELETED"
+	ResponseTypeMacroStarted               ResponseType = "MACRO_STARTED"
+	ResponseTypeMacroCompleted             ResponseType = "MACRO_COMPLETED"
+	ResponseTypeSettingsResult             ResponseType = "SETTINGS_RESULT"
+	ResponseTypeSettingsUpdated            ResponseType = "SETTINGS_UPDATED"
+	ResponseTypeDirectoryValidated         ResponseType = "DIRECTORY_VALIDATED"
+	ResponseTypeHello                      ResponseType = "HELLO"
+	ResponseTypeClients                    ResponseType = "CLIENTS"
+	ResponseTypeClientDisconnected         ResponseType = "CLIENT_DISCONNECTED"
 )
 
 type ResponseSubtype string
@@ -47,6 +74,8 @@ const (
 	ResponseSubtypeListenerNotRegistered     ResponseSubtype = "LISTENER_NOT_REGISTERED"
 	ResponseSubtypeMissingAction             ResponseSubtype = "MISSING_ACTION"
 	ResponseSubtypeMissingBase               ResponseSubtype = "MISSING_BASE"
+	ResponseSubtypeInvalidKey                ResponseSubtype = "INVALID_KEY"
+	ResponseSubtypeInvalidPosition           ResponseSubtype = "INVALID_POSITION"
 	ResponseSubtypeMissingKey                ResponseSubtype = "MISSING_KEY"
 	ResponseSubtypeMissingModules            ResponseSubtype = "MISSING_MODULES"
 	ResponseSubtypeMissingPath               ResponseSubtype = "MISSING_PATH"
@@ -57,5 +86,11 @@ const (
 	ResponseSubtypeMissingToken              ResponseSubtype = "MISSING_TOKEN"
 	ResponseSubtypeMissingValue              ResponseSubtype = "MISSING_VALUE"
 	ResponseSubtypeCommandNotFound           ResponseSubtype = "COMMAND_NOT_FOUND"
+	ResponseSubtypeClientNotFound            ResponseSubtype = "CLIENT_NOT_FOUND"
+	ResponseSubtypeJobNotFound               ResponseSubtype = "JOB_NOT_FOUND"
+	ResponseSubtypeCommandLimitReached       ResponseSubtype = "COMMAND_LIMIT_REACHED"
+	ResponseSubtypeScheduleNotFound          ResponseSubtype = "SCHEDULE_NOT_FOUND"
+	ResponseSubtypePlayerNotFound            ResponseSubtype = "PLAYER_NOT_FOUND"
+	ResponseSubtypeMacroNotFound             ResponseSubtype = "MACRO_NOT_FOUND"
 	ResponseSubtypeUnknownEvent              ResponseSubtype = "UNKNOWN_EVENT"
 )
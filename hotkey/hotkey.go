@@ -0,0 +1,152 @@
+// Package hotkey binds the global hotkeys configured in the settings and runs
+// their actions when they are pressed.
+package hotkey
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// Connection is the connection events sent by hotkeys come from
+const Connection = "hotkey"
+
+// binding is a configured hotkey with its parsed key combination
+type binding struct {
+	hotkey      settings.SettingsHotkey
+	combination Combination
+}
+
+// listenFunc grabs the bindings and calls pressed for each press until the
+// context is canceled
+type listenFunc func(ctx context.Context, bindings []binding, pressed func(binding)) error
+
+// Manager binds the configured hotkeys and runs their actions
+type Manager struct {
+	router       *event.MessageRouter
+	loadSettings func() (*settings.Settings, error)
+	listen       listenFunc
+	reload       chan struct{}
+}
+
+// NewManager creates a hotkey manager that sends actions through the router
+func NewManager(router *event.MessageRouter) *Manager {
+	return &Manager{
+		router:       router,
+		loadSettings: settings.Load,
+		listen:       listen,
+		reload:       make(chan struct{}, 1),
+	}
+}
+
+// Run binds the configured hotkeys until the context is canceled, rebinding
+// them when Reload is called
+func (m *Manager) Run(ctx context.Context) {
+	for {
+		listenCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		if bindings := m.bindings(); len(bindings) > 0 {
+			go func() {
+				defer close(done)
+				slog.Info("Binding hotkeys", "count", len(bindings))
+				if err := m.listen(listenCtx, bindings, m.pressed); err != nil {
+					slog.Warn("Global hotkeys are not available", "error", err)
+				}
+			}()
+		} else {
+			close(done)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-m.reload:
+		}
+		cancel()
+		<-done
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Reload makes the manager rebind the hotkeys from the settings
+func (m *Manager) Reload() {
+	select {
+	case m.reload <- struct{}{}:
+	default:
+	}
+}
+
+// bindings parses the configured hotkeys, skipping any that are invalid or
+// bound more than once
+func (m *Manager) bindings() []binding {
+	cfg, err := m.loadSettings()
+	if err != nil {
+		slog.Error("Failed to load settings for hotkeys", "error", err)
+		return nil
+	}
+
+	bindings := make([]binding, 0, len(cfg.Hotkeys))
+	seen := make(map[string]string)
+	for _, hotkey := range cfg.Hotkeys {
+		if hotkey.Key == "" {
+			continue
+		}
+		combination, err := Parse(hotkey.Key)
+		if err != nil {
+			slog.Warn("Skipping invalid hotkey", "name", hotkey.Name, "error", err)
+			continue
+		}
+		if other, ok := seen[combination.String()]; ok {
+			slog.Warn("Skipping hotkey bound more than once", "name", hotkey.Name, "key", combination.String(), "boundBy", other)
+			continue
+		}
+		seen[combination.String()] = hotkey.Name
+		bindings = append(bindings, binding{hotkey: hotkey, combination: combination})
+	}
+	return bindings
+}
+
+// pressed notifies hotkey listeners of a press and runs the hotkey's action
+func (m *Manager) pressed(b binding) {
+	slog.Info("Hotkey pressed", "name", b.hotkey.Name, "key", b.combination.String())
+
+	bus.GetInstance().Publish(bus.Event{
+		Type: bus.EventHotkeyPressed,
+		Data: bus.HotkeyPressed{Name: b.hotkey.Name, Key: b.combination.String()},
+	})
+
+	message, ok := actionMessage(b.hotkey)
+	if !ok {
+		return
+	}
+	response := m.router.HandleMessage(Connection, message)
+	if response.Type == event.ResponseTypeError {
+		slog.Error("Hotkey action failed", "name", b.hotkey.Name, "event", message.Event, "error", response.Message)
+	}
+}
+
+// actionMessage returns the router message for a hotkey's action, if it has
+// one
+func actionMessage(hotkey settings.SettingsHotkey) (event.Message, bool) {
+	message := event.Message{ID: uuid.NewString()}
+	switch {
+	case hotkey.Event != "":
+		message.Event = event.EventType(hotkey.Event)
+		message.Data = hotkey.Data
+	case hotkey.MacroID != "":
+		message.Event = event.EventRunMacro
+		message.Data = map[string]any{"macroID": hotkey.MacroID}
+	case hotkey.CommandID != "":
+		message.Event = event.EventCommandExecute
+		message.Data = map[string]any{"commandID": hotkey.CommandID}
+	default:
+		return event.Message{}, false
+	}
+	return message, true
+}
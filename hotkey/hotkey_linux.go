@@ -0,0 +1,158 @@
+//go:build linux
+
+package hotkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jezek/xgb"
+	"github.com/jezek/xgb/xproto"
+)
+
+// matchedModifiers are the modifiers compared when matching a press. Lock
+// keys and mouse buttons are ignored.
+const matchedModifiers = xproto.ModMaskShift | xproto.ModMaskControl | xproto.ModMask1 | xproto.ModMask4
+
+// lockModifiers are the caps lock and num lock combinations each hotkey is
+// also grabbed with, so it works whatever locks are on
+var lockModifiers = []uint16{0, xproto.ModMaskLock, xproto.ModMask2, xproto.ModMaskLock | xproto.ModMask2}
+
+// grab is a hotkey grabbed on the root window
+type grab struct {
+	keycode   xproto.Keycode
+	modifiers uint16
+	binding   binding
+}
+
+// listen grabs the hotkeys on the X11 root window with XGrabKey and calls
+// pressed for each press until the context is canceled
+func listen(ctx context.Context, bindings []binding, pressed func(binding)) error {
+	if os.Getenv("DISPLAY") == "" {
+		return errors.New("global hotkeys need an X11 display and DISPLAY is not set")
+	}
+
+	conn, err := xgb.NewConn()
+	if err != nil {
+		return fmt.Errorf("failed to connect to X server: %w", err)
+	}
+	// Closing the connection releases the grabs
+	defer conn.Close()
+
+	setup := xproto.Setup(conn)
+	root := setup.DefaultScreen(conn).Root
+
+	keycodes, err := keycodesByKeysym(conn, setup)
+	if err != nil {
+		return err
+	}
+
+	grabs := make([]grab, 0, len(bindings))
+	for _, b := range bindings {
+		keycode, ok := keycodes[b.combination.Keysym]
+		if !ok {
+			slog.Warn("Skipping hotkey for a key that is not on the keyboard", "name", b.hotkey.Name, "key", b.combination.String())
+			continue
+		}
+
+		modifiers := x11Modifiers(b.combination.Modifiers)
+		if err := grabKey(conn, root, keycode, modifiers); err != nil {
+			slog.Warn("Failed to grab hotkey, another application may be using it", "name", b.hotkey.Name, "key", b.combination.String(), "error", err)
+			continue
+		}
+		grabs = append(grabs, grab{keycode: keycode, modifiers: modifiers, binding: b})
+	}
+	if len(grabs) == 0 {
+		return errors.New("no hotkeys could be grabbed")
+	}
+	slog.Info("Grabbed hotkeys", "count", len(grabs))
+
+	// Closing the connection makes WaitForEvent return
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	for {
+		ev, xerr := conn.WaitForEvent()
+		if ev == nil && xerr == nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.New("connection to X server closed")
+		}
+		if xerr != nil {
+			slog.Debug("X server error while listening for hotkeys", "error", xerr)
+			continue
+		}
+
+		keyPress, ok := ev.(xproto.KeyPressEvent)
+		if !ok {
+			continue
+		}
+		state := keyPress.State & matchedModifiers
+		for _, g := range grabs {
+			if g.keycode == keyPress.Detail && g.modifiers == state {
+				go pressed(g.binding)
+			}
+		}
+	}
+}
+
+// grabKey grabs a key combination with every lock combination
+func grabKey(conn *xgb.Conn, root xproto.Window, keycode xproto.Keycode, modifiers uint16) error {
+	for _, lock := range lockModifiers {
+		err := xproto.GrabKeyChecked(conn, true, root, modifiers|lock, keycode, xproto.GrabModeAsync, xproto.GrabModeAsync).Check()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keycodesByKeysym maps keysyms onto the keycodes that produce them,
+// preferring keys that produce the keysym without shift
+func keycodesByKeysym(conn *xgb.Conn, setup *xproto.SetupInfo) (map[uint32]xproto.Keycode, error) {
+	count := int(setup.MaxKeycode) - int(setup.MinKeycode) + 1
+	mapping, err := xproto.GetKeyboardMapping(conn, setup.MinKeycode, byte(count)).Reply()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keyboard mapping: %w", err)
+	}
+
+	perKeycode := int(mapping.KeysymsPerKeycode)
+	keycodes := make(map[uint32]xproto.Keycode)
+	for column := range perKeycode {
+		for i := range count {
+			index := i*perKeycode + column
+			if index >= len(mapping.Keysyms) {
+				continue
+			}
+			keysym := uint32(mapping.Keysyms[index])
+			if _, ok := keycodes[keysym]; keysym != 0 && !ok {
+				keycodes[keysym] = xproto.Keycode(int(setup.MinKeycode) + i)
+			}
+		}
+	}
+	return keycodes, nil
+}
+
+// x11Modifiers converts modifiers to an X11 modifier mask
+func x11Modifiers(modifiers Modifier) uint16 {
+	var mask uint16
+	if modifiers&ModifierShift != 0 {
+		mask |= xproto.ModMaskShift
+	}
+	if modifiers&ModifierCtrl != 0 {
+		mask |= xproto.ModMaskControl
+	}
+	if modifiers&ModifierAlt != 0 {
+		mask |= xproto.ModMask1
+	}
+	if modifiers&ModifierSuper != 0 {
+		mask |= xproto.ModMask4
+	}
+	return mask
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package hotkey
+
+import (
+	"context"
+	"errors"
+)
+
+// listen is not supported on this platform
+func listen(ctx context.Context, bindings []binding, pressed func(binding)) error {
+	return errors.New("global hotkeys are only supported on Linux with X11")
+}
@@ -0,0 +1,143 @@
+package hotkey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/bus"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// newTestManager returns a manager over the given hotkeys with a router that
+// records the messages it receives
+func newTestManager(t *testing.T, hotkeys []settings.SettingsHotkey) (*Manager, chan event.Message) {
+	t.Helper()
+
+	received := make(chan event.Message, 10)
+	router := event.NewMessageRouter()
+	for _, eventType := range []event.EventType{event.EventPowerLock, event.EventRunMacro, event.EventCommandExecute} {
+		router.RegisterSimpleHandler(eventType, func(connection string, message event.Message) event.MessageResponse {
+			assert.Equal(t, Connection, connection)
+			received <- message
+			return event.MessageResponse{ID: message.ID, Type: event.ResponseTypePowerLocking}
+		})
+	}
+
+	m := NewManager(router)
+	m.loadSettings = func() (*settings.Settings, error) {
+		return &settings.Settings{Hotkeys: hotkeys}, nil
+	}
+	return m, received
+}
+
+func TestBindings(t *testing.T) {
+	m, _ := newTestManager(t, []settings.SettingsHotkey{
+		{Name: "lock", Key: "super+l", Event: string(event.EventPowerLock)},
+		{Name: "unbound", Key: ""},
+		{Name: "invalid", Key: "hyper+x"},
+		{Name: "duplicate", Key: "Super+L"},
+		{Name: "macro", Key: "ctrl+alt+m", MacroID: "leave-desk"},
+	})
+
+	bindings := m.bindings()
+	require.Len(t, bindings, 2)
+	assert.Equal(t, "lock", bindings[0].hotkey.Name)
+	assert.Equal(t, "super+l", bindings[0].combination.String())
+	assert.Equal(t, "macro", bindings[1].hotkey.Name)
+}
+
+func TestActionMessage(t *testing.T) {
+	message, ok := actionMessage(settings.SettingsHotkey{Event: string(event.EventPowerLock), Data: map[string]any{"a": 1}})
+	require.True(t, ok)
+	assert.Equal(t, event.EventPowerLock, message.Event)
+	assert.Equal(t, map[string]any{"a": 1}, message.Data)
+
+	message, ok = actionMessage(settings.SettingsHotkey{MacroID: "leave-desk"})
+	require.True(t, ok)
+	assert.Equal(t, event.EventRunMacro, message.Event)
+	assert.Equal(t, map[string]any{"macroID": "leave-desk"}, message.Data)
+
+	message, ok = actionMessage(settings.SettingsHotkey{CommandID: "backup"})
+	require.True(t, ok)
+	assert.Equal(t, event.EventCommandExecute, message.Event)
+	assert.Equal(t, map[string]any{"commandID": "backup"}, message.Data)
+
+	_, ok = actionMessage(settings.SettingsHotkey{Name: "notify only"})
+	assert.False(t, ok)
+}
+
+func TestPressedRunsActionAndPublishes(t *testing.T) {
+	m, received := newTestManager(t, nil)
+
+	published := make(chan bus.Event, 1)
+	bus.GetInstance().Subscribe(bus.EventHotkeyPressed, t.Name(), func(e bus.Event) {
+		published <- e
+	})
+	t.Cleanup(func() {
+		bus.GetInstance().Unsubscribe(bus.EventHotkeyPressed, t.Name())
+	})
+
+	combination, err := Parse("ctrl+alt+m")
+	require.NoError(t, err)
+	m.pressed(binding{
+		hotkey:      settings.SettingsHotkey{Name: "macro", Key: "Ctrl+Alt+M", MacroID: "leave-desk"},
+		combination: combination,
+	})
+
+	select {
+	case message := <-received:
+		assert.Equal(t, event.EventRunMacro, message.Event)
+	case <-time.After(time.Second):
+		t.Fatal("action was not run")
+	}
+
+	select {
+	case e := <-published:
+		assert.Equal(t, bus.HotkeyPressed{Name: "macro", Key: "ctrl+alt+m"}, e.Data)
+	case <-time.After(time.Second):
+		t.Fatal("press was not published")
+	}
+}
+
+func TestRunRebindsOnReload(t *testing.T) {
+	m, received := newTestManager(t, []settings.SettingsHotkey{
+		{Name: "lock", Key: "super+l", Event: string(event.EventPowerLock)},
+	})
+
+	listens := make(chan []binding, 2)
+	m.listen = func(ctx context.Context, bindings []binding, pressed func(binding)) error {
+		listens <- bindings
+		pressed(bindings[0])
+		<-ctx.Done()
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.Run(ctx)
+	}()
+
+	for range 2 {
+		select {
+		case bindings := <-listens:
+			assert.Len(t, bindings, 1)
+		case <-time.After(time.Second):
+			t.Fatal("hotkeys were not bound")
+		}
+		<-received
+		m.Reload()
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop")
+	}
+}
@@ -0,0 +1,24 @@
+package hotkey
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *Manager
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global hotkey manager instance
+func GetInstance() *Manager {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global hotkey manager instance
+func SetInstance(instance *Manager) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
@@ -0,0 +1,144 @@
+package hotkey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Modifier is a set of modifier keys
+type Modifier uint16
+
+const (
+	ModifierShift Modifier = 1 << iota
+	ModifierCtrl
+	ModifierAlt
+	ModifierSuper
+)
+
+// modifierNames maps the accepted modifier names onto modifiers
+var modifierNames = map[string]Modifier{
+	"shift":   ModifierShift,
+	"ctrl":    ModifierCtrl,
+	"control": ModifierCtrl,
+	"alt":     ModifierAlt,
+	"option":  ModifierAlt,
+	"super":   ModifierSuper,
+	"meta":    ModifierSuper,
+	"win":     ModifierSuper,
+	"cmd":     ModifierSuper,
+	"command": ModifierSuper,
+}
+
+// keysyms maps named keys onto their X11 keysyms. Single printable ASCII
+// characters use their character code, which is also their keysym.
+var keysyms = map[string]uint32{
+	"space":       0x0020,
+	"backspace":   0xff08,
+	"tab":         0xff09,
+	"enter":       0xff0d,
+	"return":      0xff0d,
+	"pause":       0xff13,
+	"scrolllock":  0xff14,
+	"escape":      0xff1b,
+	"esc":         0xff1b,
+	"home":        0xff50,
+	"left":        0xff51,
+	"up":          0xff52,
+	"right":       0xff53,
+	"down":        0xff54,
+	"pageup":      0xff55,
+	"pagedown":    0xff56,
+	"end":         0xff57,
+	"print":       0xff61,
+	"printscreen": 0xff61,
+	"insert":      0xff63,
+	"delete":      0xffff,
+	"volumedown":  0x1008ff11,
+	"volumemute":  0x1008ff12,
+	"mute":        0x1008ff12,
+	"volumeup":    0x1008ff13,
+	"play":        0x1008ff14,
+	"playpause":   0x1008ff14,
+	"stop":        0x1008ff15,
+	"previous":    0x1008ff16,
+	"next":        0x1008ff17,
+}
+
+// functionKeyBase is the keysym of F1. F1 to F35 are consecutive.
+const functionKeyBase = 0xffbe
+
+// Combination is a parsed key combination, such as ctrl+alt+l
+type Combination struct {
+	Modifiers Modifier
+	// Key is the normalized name of the key
+	Key string
+	// Keysym is the X11 keysym of the key
+	Keysym uint32
+}
+
+// String returns the combination in its normalized form
+func (c Combination) String() string {
+	parts := make([]string, 0, 5)
+	for _, modifier := range []struct {
+		modifier Modifier
+		name     string
+	}{
+		{ModifierCtrl, "ctrl"},
+		{ModifierAlt, "alt"},
+		{ModifierShift, "shift"},
+		{ModifierSuper, "super"},
+	} {
+		if c.Modifiers&modifier.modifier != 0 {
+			parts = append(parts, modifier.name)
+		}
+	}
+	return strings.Join(append(parts, c.Key), "+")
+}
+
+// Parse parses a key combination of modifiers and a key joined by +, such as
+// ctrl+shift+t, super+f5 or playpause. Names are case insensitive.
+func Parse(combination string) (Combination, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(combination)), "+")
+	// A combination ending in + uses the plus key
+	if len(parts) > 1 && parts[len(parts)-1] == "" && parts[len(parts)-2] == "" {
+		parts = append(parts[:len(parts)-2], "+")
+	}
+
+	var result Combination
+	for _, part := range parts[:len(parts)-1] {
+		modifier, ok := modifierNames[strings.TrimSpace(part)]
+		if !ok {
+			return Combination{}, fmt.Errorf("unknown modifier %q in %q", part, combination)
+		}
+		result.Modifiers |= modifier
+	}
+
+	key := strings.TrimSpace(parts[len(parts)-1])
+	if key == "" {
+		return Combination{}, fmt.Errorf("%q has no key", combination)
+	}
+	keysym, ok := keysymFor(key)
+	if !ok {
+		return Combination{}, fmt.Errorf("unknown key %q in %q", key, combination)
+	}
+	result.Key = key
+	result.Keysym = keysym
+	return result, nil
+}
+
+// keysymFor returns the keysym of a lowercase key name
+func keysymFor(key string) (uint32, bool) {
+	if keysym, ok := keysyms[key]; ok {
+		return keysym, true
+	}
+	if len(key) == 1 && key[0] >= 0x21 && key[0] <= 0x7e {
+		return uint32(key[0]), true
+	}
+	if number, ok := strings.CutPrefix(key, "f"); ok {
+		if n, err := strconv.Atoi(number); err == nil && n >= 1 && n <= 35 {
+			return functionKeyBase + uint32(n-1), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,48 @@
+package hotkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input     string
+		modifiers Modifier
+		key       string
+		keysym    uint32
+		str       string
+	}{
+		{"ctrl+t", ModifierCtrl, "t", 't', "ctrl+t"},
+		{"Ctrl+Shift+T", ModifierCtrl | ModifierShift, "t", 't', "ctrl+shift+t"},
+		{"super+alt+l", ModifierSuper | ModifierAlt, "l", 'l', "alt+super+l"},
+		{"control + 1", ModifierCtrl, "1", '1', "ctrl+1"},
+		{"f5", 0, "f5", 0xffc2, "f5"},
+		{"cmd+F12", ModifierSuper, "f12", 0xffc9, "super+f12"},
+		{"PlayPause", 0, "playpause", 0x1008ff14, "playpause"},
+		{"ctrl+alt+delete", ModifierCtrl | ModifierAlt, "delete", 0xffff, "ctrl+alt+delete"},
+		{"ctrl++", ModifierCtrl, "+", '+', "ctrl++"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			combination, err := Parse(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.modifiers, combination.Modifiers)
+			assert.Equal(t, tt.key, combination.Key)
+			assert.Equal(t, tt.keysym, combination.Keysym)
+			assert.Equal(t, tt.str, combination.String())
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, input := range []string{"", "ctrl+", "hyper+t", "ctrl+nokey", "f36"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input)
+			assert.Error(t, err)
+		})
+	}
+}
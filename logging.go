@@ -256,7 +256,7 @@ func isManagedLogFile(name string) bool {
 
 // shouldLogToStdout returns true when the application should emit logs to stdout.
 // We only log to stdout for the `backend` command to avoid polluting CLI output
-// (e.g. JSON) for `client` commands.
+// (e.g. JSON) for `client` commands, or the JSON-RPC stream of `mcp stdio`.
 func shouldLogToStdout() bool {
 	// Expect subcommand in os.Args[1]
 	if len(os.Args) < 2 {
@@ -266,7 +266,7 @@ func shouldLogToStdout() bool {
 	// Prefer explicit checks for known top-level commands and their aliases
 	for _, arg := range os.Args[1:] {
 		switch arg {
-		case "client", "c", "cli":
+		case "client", "c", "cli", "mcp":
 			return false
 		case "backend", "b":
 			return true
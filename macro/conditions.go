@@ -0,0 +1,139 @@
+package macro
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// conditionsMet checks step conditions against the current module data,
+// returning why the first unmet condition failed
+func (r *Runner) conditionsMet(conditions []settings.SettingsMacroCondition) (bool, string) {
+	for _, condition := range conditions {
+		value, found := r.moduleValue(condition.Module, condition.Path)
+		if !evaluate(condition, value, found) {
+			return false, fmt.Sprintf("condition not met: %s.%s %s %v", condition.Module, condition.Path, condition.Operator, condition.Value)
+		}
+	}
+	return true, ""
+}
+
+// moduleValue returns the value at a path in a module's data
+func (r *Runner) moduleValue(module, path string) (any, bool) {
+	if r.modules == nil {
+		return nil, false
+	}
+	m, err := r.modules.GetModule(types.ModuleName(module))
+	if err != nil || m.Data == nil {
+		return nil, false
+	}
+	data, err := normalize(m.Data)
+	if err != nil {
+		return nil, false
+	}
+	return lookup(data, path)
+}
+
+// evaluate compares a module data value with a condition. A missing value
+// fails every operator, including ne.
+func evaluate(condition settings.SettingsMacroCondition, value any, found bool) bool {
+	if condition.Operator == settings.MacroConditionExists {
+		return found && value != nil
+	}
+	if !found {
+		return false
+	}
+
+	expected, err := normalize(condition.Value)
+	if err != nil {
+		return false
+	}
+
+	switch condition.Operator {
+	case settings.MacroConditionEquals:
+		return reflect.DeepEqual(value, expected)
+	case settings.MacroConditionNotEquals:
+		return !reflect.DeepEqual(value, expected)
+	case settings.MacroConditionGreater, settings.MacroConditionGreaterOrEqual,
+		settings.MacroConditionLess, settings.MacroConditionLessOrEqual:
+		actualNumber, ok := value.(float64)
+		if !ok {
+			return false
+		}
+		expectedNumber, ok := expected.(float64)
+		if !ok {
+			return false
+		}
+		switch condition.Operator {
+		case settings.MacroConditionGreater:
+			return actualNumber > expectedNumber
+		case settings.MacroConditionGreaterOrEqual:
+			return actualNumber >= expectedNumber
+		case settings.MacroConditionLess:
+			return actualNumber < expectedNumber
+		default:
+			return actualNumber <= expectedNumber
+		}
+	case settings.MacroConditionContains:
+		switch actual := value.(type) {
+		case string:
+			substring, ok := expected.(string)
+			return ok && strings.Contains(actual, substring)
+		case []any:
+			for _, item := range actual {
+				if reflect.DeepEqual(item, expected) {
+					return true
+				}
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// normalize converts a value to its JSON form, so module data structs and
+// condition values from the settings compare the same way
+func normalize(value any) (any, error) {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized any
+	if err := json.Unmarshal(content, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// lookup follows a dot separated path of object keys and array indexes
+func lookup(data any, path string) (any, bool) {
+	if path == "" {
+		return data, true
+	}
+	current := data
+	for _, part := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[part]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
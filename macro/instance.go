@@ -0,0 +1,24 @@
+package macro
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *Runner
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global macro runner instance
+func GetInstance() *Runner {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global macro runner instance
+func SetInstance(instance *Runner) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
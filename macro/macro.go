@@ -0,0 +1,180 @@
+// Package macro runs the macros configured in the settings, sending each
+// step's router event in order.
+package macro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// Connection is the connection events sent by macros come from
+const Connection = "macro"
+
+// Step and macro statuses
+const (
+	StatusOK      = "ok"
+	StatusError   = "error"
+	StatusSkipped = "skipped"
+	StatusNotRun  = "not_run"
+)
+
+// ErrMacroNotFound is returned when a macro ID does not match a macro
+var ErrMacroNotFound = errors.New("macro not found")
+
+// ModuleSource provides the module data that step conditions are checked
+// against
+type ModuleSource interface {
+	GetModule(name types.ModuleName) (types.Module, error)
+}
+
+// StepResult is the outcome of a macro step
+type StepResult struct {
+	Index   int    `json:"index" mapstructure:"index"`
+	Event   string `json:"event" mapstructure:"event"`
+	Status  string `json:"status" mapstructure:"status"`
+	Message string `json:"message,omitempty" mapstructure:"message"`
+}
+
+// Result is the outcome of a macro run. Its status is error if any step
+// failed, even when the macro continued past it.
+type Result struct {
+	MacroID    string       `json:"macroID" mapstructure:"macroID"`
+	Name       string       `json:"name" mapstructure:"name"`
+	Status     string       `json:"status" mapstructure:"status"`
+	Error      string       `json:"error,omitempty" mapstructure:"error"`
+	Steps      []StepResult `json:"steps" mapstructure:"steps"`
+	DurationMs int64        `json:"durationMs" mapstructure:"durationMs"`
+}
+
+// Runner runs macros through the router
+type Runner struct {
+	router       *event.MessageRouter
+	modules      ModuleSource
+	loadSettings func() (*settings.Settings, error)
+	sleep        func(ctx context.Context, d time.Duration) error
+}
+
+// NewRunner creates a runner that sends events through the router and
+// checks conditions against the module data
+func NewRunner(router *event.MessageRouter, modules ModuleSource) *Runner {
+	return &Runner{
+		router:       router,
+		modules:      modules,
+		loadSettings: settings.Load,
+		sleep:        sleep,
+	}
+}
+
+// List returns the configured macros
+func (r *Runner) List() ([]settings.SettingsMacro, error) {
+	cfg, err := r.loadSettings()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Macros, nil
+}
+
+// Run runs a macro and waits for it to finish. Canceling the context stops
+// the macro before its next step.
+func (r *Runner) Run(ctx context.Context, id string) (Result, error) {
+	cfg, err := r.loadSettings()
+	if err != nil {
+		return Result{}, err
+	}
+
+	var macro *settings.SettingsMacro
+	for i := range cfg.Macros {
+		if cfg.Macros[i].ID == id {
+			macro = &cfg.Macros[i]
+			break
+		}
+	}
+	if macro == nil {
+		return Result{}, fmt.Errorf("%w: %s", ErrMacroNotFound, id)
+	}
+
+	slog.Info("Running macro", "id", macro.ID, "name", macro.Name, "steps", len(macro.Steps))
+	start := time.Now()
+
+	result := Result{
+		MacroID: macro.ID,
+		Name:    macro.Name,
+		Status:  StatusOK,
+		Steps:   make([]StepResult, len(macro.Steps)),
+	}
+	for i, step := range macro.Steps {
+		result.Steps[i] = StepResult{Index: i, Event: step.Event, Status: StatusNotRun}
+	}
+
+	for i, step := range macro.Steps {
+		if step.DelayMs > 0 {
+			if err := r.sleep(ctx, time.Duration(step.DelayMs)*time.Millisecond); err != nil {
+				result.Status = StatusError
+				result.Error = "macro canceled"
+				break
+			}
+		}
+
+		if met, reason := r.conditionsMet(step.Conditions); !met {
+			slog.Info("Skipping macro step", "id", macro.ID, "step", i, "reason", reason)
+			result.Steps[i].Status = StatusSkipped
+			result.Steps[i].Message = reason
+			continue
+		}
+
+		response := r.router.HandleMessage(Connection, event.Message{
+			ID:    uuid.NewString(),
+			Event: event.EventType(step.Event),
+			Data:  step.Data,
+		})
+		result.Steps[i].Message = response.Message
+		if response.Type != event.ResponseTypeError {
+			result.Steps[i].Status = StatusOK
+			continue
+		}
+
+		slog.Error("Macro step failed", "id", macro.ID, "step", i, "event", step.Event, "error", response.Message)
+		result.Steps[i].Status = StatusError
+		result.Status = StatusError
+		if errorPolicy(*macro, step) == settings.MacroErrorPolicyStop {
+			result.Error = fmt.Sprintf("step %d (%s) failed: %s", i, step.Event, response.Message)
+			break
+		}
+	}
+
+	result.DurationMs = time.Since(start).Milliseconds()
+	slog.Info("Macro finished", "id", macro.ID, "status", result.Status, "durationMs", result.DurationMs)
+	return result, nil
+}
+
+// errorPolicy returns the error policy of a step, falling back to the
+// macro's and then to stopping
+func errorPolicy(macro settings.SettingsMacro, step settings.SettingsMacroStep) settings.MacroErrorPolicy {
+	if step.OnError != "" {
+		return step.OnError
+	}
+	if macro.OnError != "" {
+		return macro.OnError
+	}
+	return settings.MacroErrorPolicyStop
+}
+
+// sleep waits for the duration or until the context is canceled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
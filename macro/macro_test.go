@@ -0,0 +1,214 @@
+package macro
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// fakeModules serves fixed module data
+type fakeModules map[types.ModuleName]any
+
+func (f fakeModules) GetModule(name types.ModuleName) (types.Module, error) {
+	data, ok := f[name]
+	if !ok {
+		return types.Module{}, errors.New("module not found")
+	}
+	return types.Module{Name: name, Data: data}, nil
+}
+
+type batteryData struct {
+	IsCharging bool     `json:"isCharging"`
+	Percentage float64  `json:"percentage"`
+	Sources    []string `json:"sources"`
+}
+
+// newTestRunner returns a runner over the given macros, recording the events
+// it sends and the delays it waits for. NOTIFICATION fails.
+func newTestRunner(t *testing.T, macros []settings.SettingsMacro) (*Runner, *[]event.EventType, *[]time.Duration) {
+	t.Helper()
+
+	sent := make([]event.EventType, 0)
+	router := event.NewMessageRouter()
+	for _, eventType := range []event.EventType{event.EventMediaControl, event.EventPowerLock} {
+		router.RegisterSimpleHandler(eventType, func(connection string, message event.Message) event.MessageResponse {
+			assert.Equal(t, Connection, connection)
+			sent = append(sent, message.Event)
+			return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeMediaControlled}
+		})
+	}
+	router.RegisterSimpleHandler(event.EventNotification, func(connection string, message event.Message) event.MessageResponse {
+		sent = append(sent, message.Event)
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeError, Message: "notifications unavailable"}
+	})
+
+	delays := make([]time.Duration, 0)
+	r := NewRunner(router, fakeModules{
+		types.ModuleBattery: batteryData{IsCharging: false, Percentage: 42, Sources: []string{"internal"}},
+	})
+	r.loadSettings = func() (*settings.Settings, error) {
+		return &settings.Settings{Macros: macros}, nil
+	}
+	r.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	return r, &sent, &delays
+}
+
+func TestRunMacro(t *testing.T) {
+	r, sent, delays := newTestRunner(t, []settings.SettingsMacro{{
+		ID:   "leave-desk",
+		Name: "Leave desk",
+		Steps: []settings.SettingsMacroStep{
+			{Event: string(event.EventMediaControl), Data: map[string]any{"action": "PAUSE"}},
+			{Event: string(event.EventPowerLock), DelayMs: 1500},
+		},
+	}})
+
+	result, err := r.Run(context.Background(), "leave-desk")
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, result.Status)
+	assert.Equal(t, "Leave desk", result.Name)
+	assert.Equal(t, []event.EventType{event.EventMediaControl, event.EventPowerLock}, *sent)
+	assert.Equal(t, []time.Duration{1500 * time.Millisecond}, *delays)
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, StatusOK, result.Steps[0].Status)
+	assert.Equal(t, StatusOK, result.Steps[1].Status)
+}
+
+func TestRunMacroNotFound(t *testing.T) {
+	r, _, _ := newTestRunner(t, nil)
+
+	_, err := r.Run(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrMacroNotFound)
+}
+
+func TestRunMacroConditions(t *testing.T) {
+	r, sent, _ := newTestRunner(t, []settings.SettingsMacro{{
+		ID: "conditional",
+		Steps: []settings.SettingsMacroStep{
+			{
+				Event:      string(event.EventMediaControl),
+				Conditions: []settings.SettingsMacroCondition{{Module: "battery", Path: "isCharging", Operator: settings.MacroConditionEquals, Value: true}},
+			},
+			{
+				Event: string(event.EventPowerLock),
+				Conditions: []settings.SettingsMacroCondition{
+					{Module: "battery", Path: "percentage", Operator: settings.MacroConditionLess, Value: 50},
+					{Module: "battery", Path: "sources", Operator: settings.MacroConditionContains, Value: "internal"},
+				},
+			},
+		},
+	}})
+
+	result, err := r.Run(context.Background(), "conditional")
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, result.Status)
+	assert.Equal(t, StatusSkipped, result.Steps[0].Status)
+	assert.Contains(t, result.Steps[0].Message, "battery.isCharging")
+	assert.Equal(t, StatusOK, result.Steps[1].Status)
+	assert.Equal(t, []event.EventType{event.EventPowerLock}, *sent)
+}
+
+func TestRunMacroErrorPolicy(t *testing.T) {
+	steps := []settings.SettingsMacroStep{
+		{Event: string(event.EventNotification)},
+		{Event: string(event.EventPowerLock)},
+	}
+
+	t.Run("Stops by default", func(t *testing.T) {
+		r, sent, _ := newTestRunner(t, []settings.SettingsMacro{{ID: "stop", Steps: steps}})
+
+		result, err := r.Run(context.Background(), "stop")
+		require.NoError(t, err)
+		assert.Equal(t, StatusError, result.Status)
+		assert.Contains(t, result.Error, "notifications unavailable")
+		assert.Equal(t, StatusError, result.Steps[0].Status)
+		assert.Equal(t, StatusNotRun, result.Steps[1].Status)
+		assert.Len(t, *sent, 1)
+	})
+
+	t.Run("Continues", func(t *testing.T) {
+		r, sent, _ := newTestRunner(t, []settings.SettingsMacro{{ID: "continue", Steps: steps, OnError: settings.MacroErrorPolicyContinue}})
+
+		result, err := r.Run(context.Background(), "continue")
+		require.NoError(t, err)
+		assert.Equal(t, StatusError, result.Status, "a failed step still fails the macro")
+		assert.Empty(t, result.Error)
+		assert.Equal(t, StatusOK, result.Steps[1].Status)
+		assert.Len(t, *sent, 2)
+	})
+
+	t.Run("Step overrides the macro", func(t *testing.T) {
+		overridden := []settings.SettingsMacroStep{
+			{Event: string(event.EventNotification), OnError: settings.MacroErrorPolicyContinue},
+			{Event: string(event.EventPowerLock)},
+		}
+		r, sent, _ := newTestRunner(t, []settings.SettingsMacro{{ID: "override", Steps: overridden}})
+
+		_, err := r.Run(context.Background(), "override")
+		require.NoError(t, err)
+		assert.Len(t, *sent, 2)
+	})
+}
+
+func TestRunMacroCanceled(t *testing.T) {
+	r, sent, _ := newTestRunner(t, []settings.SettingsMacro{{
+		ID: "delayed",
+		Steps: []settings.SettingsMacroStep{
+			{Event: string(event.EventPowerLock), DelayMs: 1000},
+		},
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := r.Run(ctx, "delayed")
+	require.NoError(t, err)
+	assert.Equal(t, StatusError, result.Status)
+	assert.Equal(t, StatusNotRun, result.Steps[0].Status)
+	assert.Empty(t, *sent)
+}
+
+func TestEvaluate(t *testing.T) {
+	data, err := normalize(map[string]any{
+		"state":    "PLAYING",
+		"volume":   0.5,
+		"displays": []map[string]any{{"name": "DP-1"}},
+		"missing":  nil,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		condition settings.SettingsMacroCondition
+		expected  bool
+	}{
+		{"Equals string", settings.SettingsMacroCondition{Path: "state", Operator: settings.MacroConditionEquals, Value: "PLAYING"}, true},
+		{"Not equals string", settings.SettingsMacroCondition{Path: "state", Operator: settings.MacroConditionNotEquals, Value: "PAUSED"}, true},
+		{"Greater", settings.SettingsMacroCondition{Path: "volume", Operator: settings.MacroConditionGreater, Value: 0.25}, true},
+		{"Less or equal", settings.SettingsMacroCondition{Path: "volume", Operator: settings.MacroConditionLessOrEqual, Value: 0.25}, false},
+		{"Compare string as number", settings.SettingsMacroCondition{Path: "state", Operator: settings.MacroConditionGreater, Value: 1}, false},
+		{"Array index", settings.SettingsMacroCondition{Path: "displays.0.name", Operator: settings.MacroConditionEquals, Value: "DP-1"}, true},
+		{"Array index out of range", settings.SettingsMacroCondition{Path: "displays.1.name", Operator: settings.MacroConditionExists}, false},
+		{"Contains substring", settings.SettingsMacroCondition{Path: "state", Operator: settings.MacroConditionContains, Value: "PLAY"}, true},
+		{"Exists", settings.SettingsMacroCondition{Path: "state", Operator: settings.MacroConditionExists}, true},
+		{"Null does not exist", settings.SettingsMacroCondition{Path: "missing", Operator: settings.MacroConditionExists}, false},
+		{"Missing fails not equals", settings.SettingsMacroCondition{Path: "other", Operator: settings.MacroConditionNotEquals, Value: "x"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, found := lookup(data, tt.condition.Path)
+			assert.Equal(t, tt.expected, evaluate(tt.condition, value, found))
+		})
+	}
+}
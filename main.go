@@ -15,12 +15,19 @@ import (
 	"github.com/pkg/browser"
 
 	"github.com/timmo001/system-bridge/backend"
+	"github.com/timmo001/system-bridge/backend/clients"
+	api_http "github.com/timmo001/system-bridge/backend/http"
+	"github.com/timmo001/system-bridge/backend/mcp"
 	"github.com/timmo001/system-bridge/data"
 	"github.com/timmo001/system-bridge/discovery"
+	"github.com/timmo001/system-bridge/event"
+	event_handler "github.com/timmo001/system-bridge/event/handler"
+	"github.com/timmo001/system-bridge/macro"
 	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/tray"
 	"github.com/timmo001/system-bridge/types"
 	"github.com/timmo001/system-bridge/utils"
+	"github.com/timmo001/system-bridge/utils/handlers/command"
 	"github.com/timmo001/system-bridge/utils/handlers/filesystem"
 	"github.com/timmo001/system-bridge/utils/handlers/notification"
 	"github.com/timmo001/system-bridge/version"
@@ -65,6 +72,9 @@ func main() {
 		Name:    "System Bridge",
 		Usage:   "A bridge for your systems",
 		Version: version.Version,
+		// Parameter values can contain commas, so slice flags are only split
+		// by repeating them
+		DisableSliceFlagSeparator: true,
 		Commands: []*cli.Command{
 			{
 				Name:    "backend",
@@ -134,6 +144,14 @@ func main() {
 						OpenLogsDir: func() {
 							openLogsDirectory()
 						},
+						ListClients: listTrayClients,
+						DisconnectClient: func(sessionID string) {
+							clients.Disconnect(sessionID)
+						},
+						ListMacros: listTrayMacros,
+						RunMacro: func(id string) {
+							runTrayMacro(ctx, id)
+						},
 						Quit: func() {
 							slog.Info("Quitting...")
 							// Cancel context to trigger graceful shutdown
@@ -230,6 +248,54 @@ func main() {
 							return nil
 						},
 					},
+					{
+						Name:    "command",
+						Aliases: []string{"cmd"},
+						Usage:   "Run allowlisted commands on the running backend",
+						Commands: []*cli.Command{
+							{
+								Name:      "run",
+								Usage:     "Run a command and print its output",
+								ArgsUsage: "<id>",
+								Flags: []cli.Flag{
+									&cli.StringSliceFlag{
+										Name:    "param",
+										Aliases: []string{"p"},
+										Usage:   "A parameter value as name=value. Can be repeated.",
+									},
+									&cli.BoolFlag{
+										Name:  "async",
+										Usage: "Start the command in the background and print its job ID",
+									},
+									&cli.IntFlag{
+										Name:  "timeout",
+										Usage: "Stop waiting for the command after this many seconds",
+									},
+									&cli.BoolFlag{
+										Name:  "json",
+										Usage: "Print the result as JSON",
+									},
+								},
+								Action: func(cmdCtx context.Context, cmd *cli.Command) error {
+									commandID := cmd.Args().First()
+									if commandID == "" {
+										return fmt.Errorf("a command ID must be provided")
+									}
+
+									params, err := parseCommandParams(cmd.StringSlice("param"))
+									if err != nil {
+										return err
+									}
+
+									return runClientCommand(cmdCtx, commandID, api_http.CommandExecuteRequest{
+										Params:         params,
+										Async:          cmd.Bool("async"),
+										TimeoutSeconds: cmd.Int("timeout"),
+									}, cmd.Bool("json"))
+								},
+							},
+						},
+					},
 					{
 						Name:    "discovery",
 						Aliases: []string{"disc"},
@@ -410,6 +476,46 @@ func main() {
 					},
 				},
 			},
+			{
+				Name:  "mcp",
+				Usage: "Model Context Protocol server",
+				Commands: []*cli.Command{
+					{
+						Name:  "stdio",
+						Usage: "Serve MCP over stdin/stdout, for clients that launch MCP servers as a subprocess",
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "in-process",
+								Usage: "Serve from this process instead of proxying to a running backend",
+								Value: false,
+							},
+						},
+						Action: func(cmdCtx context.Context, cmd *cli.Command) error {
+							token, err := utils.LoadToken()
+							if err != nil {
+								return fmt.Errorf("error loading token: %w", err)
+							}
+
+							if !cmd.Bool("in-process") {
+								endpoint := fmt.Sprintf("ws://127.0.0.1:%d/api/mcp", utils.GetPort())
+								return mcp.ProxyStdio(cmdCtx, endpoint, token, os.Stdin, os.Stdout)
+							}
+
+							dataStore, err := data.NewDataStore()
+							if err != nil {
+								return fmt.Errorf("failed to create data store: %w", err)
+							}
+							go data.RunUpdateTaskProcessor(dataStore)
+
+							command.SetServerContext(cmdCtx)
+							router := event.NewMessageRouter()
+							event_handler.RegisterHandlers(router, dataStore)
+
+							return mcp.NewMCPServer(token, router, dataStore).ServeStdio(cmdCtx, os.Stdin, os.Stdout)
+						},
+					},
+				},
+			},
 			{
 				Name:  "version",
 				Usage: "Show the version of the application",
@@ -444,6 +550,83 @@ func openWebClient(token string) {
 	}
 }
 
+// listTrayClients lists connected clients for the tray menu
+func listTrayClients() []tray.Client {
+	sessions := clients.List()
+	items := make([]tray.Client, 0, len(sessions))
+	for _, session := range sessions {
+		name := "Unknown client"
+		if session.Client != nil && session.Client.Name != "" {
+			name = session.Client.Name
+		}
+		items = append(items, tray.Client{
+			SessionID: session.SessionID,
+			Title:     fmt.Sprintf("%s (%s, %s)", name, session.Transport, session.Address),
+			Tooltip: fmt.Sprintf(
+				"Connected since %s - %d messages in, %d messages out",
+				session.ConnectedAt.Format(time.Kitchen),
+				session.MessagesIn,
+				session.MessagesOut,
+			),
+		})
+	}
+	return items
+}
+
+// listTrayMacros lists the macros shown in the tray menu
+func listTrayMacros() []tray.Macro {
+	cfg, err := settings.Load()
+	if err != nil {
+		slog.Error("Failed to load settings for tray macros", "error", err)
+		return nil
+	}
+
+	items := make([]tray.Macro, 0)
+	for _, m := range cfg.Macros {
+		if !m.ShowInTray {
+			continue
+		}
+		title := m.Name
+		if title == "" {
+			title = m.ID
+		}
+		items = append(items, tray.Macro{
+			ID:      m.ID,
+			Title:   title,
+			Tooltip: fmt.Sprintf("Run %d steps", len(m.Steps)),
+		})
+	}
+	return items
+}
+
+// runTrayMacro runs a macro from the tray menu, notifying if it fails
+func runTrayMacro(ctx context.Context, id string) {
+	runner := macro.GetInstance()
+	if runner == nil {
+		slog.Warn("Macro runner not available")
+		return
+	}
+
+	result, err := runner.Run(ctx, id)
+	if err == nil && result.Status == macro.StatusOK {
+		return
+	}
+
+	message := result.Error
+	if err != nil {
+		message = err.Error()
+	} else if message == "" {
+		message = "One or more steps failed"
+	}
+	if err := notification.Send(notification.NotificationData{
+		Title:   "Macro failed",
+		Message: message,
+		Icon:    "system-bridge",
+	}); err != nil {
+		slog.Error("Failed to send notification", "err", err)
+	}
+}
+
 func openLogsDirectory() {
 	logsDir, err := utils.GetLogsPath()
 	if err != nil {
@@ -0,0 +1,604 @@
+package medialibrary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"math/bits"
+	"time"
+)
+
+// errInvalidHeader is returned when a file's headers do not match its format
+var errInvalidHeader = errors.New("invalid media header")
+
+// readDuration reads the length of an audio or video file from its headers,
+// returning 0 for formats it cannot read the length of
+func readDuration(r io.ReadSeeker, ext string, size int64) (time.Duration, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	switch ext {
+	case ".mp3":
+		return mp3Duration(r, size)
+	case ".flac":
+		return flacDuration(r)
+	case ".wav":
+		return wavDuration(r)
+	case ".ogg":
+		return oggDuration(r, size)
+	case ".m4a", ".mp4", ".mov":
+		return mp4Duration(r, size)
+	case ".mkv", ".webm":
+		return matroskaDuration(r)
+	case ".avi":
+		return aviDuration(r)
+	case ".wmv":
+		return asfDuration(r)
+	}
+	return 0, nil
+}
+
+// seconds converts a number of seconds to a duration
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// skipID3v2 moves past an ID3v2 tag at the start of a file, returning where
+// the audio starts
+func skipID3v2(r io.ReadSeeker) (int64, error) {
+	header := make([]byte, 10)
+	if _, err := io.ReadFull(r, header); err != nil || string(header[:3]) != "ID3" {
+		_, err := r.Seek(0, io.SeekStart)
+		return 0, err
+	}
+	// The size is syncsafe, using seven bits of each byte
+	size := int64(header[6])<<21 | int64(header[7])<<14 | int64(header[8])<<7 | int64(header[9])
+	size += 10
+	if header[5]&0x10 != 0 {
+		size += 10 // Footer
+	}
+	return r.Seek(size, io.SeekStart)
+}
+
+// mp3Header is a decoded MPEG audio frame header
+type mp3Header struct {
+	bitrate         int // Bits per second
+	sampleRate      int
+	samplesPerFrame int
+	frameLength     int
+	// sideInfo is the length of the side information after the header, where
+	// Xing headers are found
+	sideInfo int
+}
+
+var (
+	mp3Bitrates = map[[2]int][]int{
+		{1, 1}: {0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+		{1, 2}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{1, 3}: {0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{2, 1}: {0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+		{2, 2}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{2, 3}: {0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+	}
+	mp3SampleRates = map[int][]int{
+		1:  {44100, 48000, 32000},
+		2:  {22050, 24000, 16000},
+		25: {11025, 12000, 8000},
+	}
+)
+
+// parseMP3Header decodes a frame header, reporting false for anything that
+// is not a valid header
+func parseMP3Header(b []byte) (mp3Header, bool) {
+	if len(b) < 4 || b[0] != 0xff || b[1]&0xe0 != 0xe0 {
+		return mp3Header{}, false
+	}
+
+	var version int
+	switch (b[1] >> 3) & 0x03 {
+	case 0:
+		version = 25
+	case 2:
+		version = 2
+	case 3:
+		version = 1
+	default:
+		return mp3Header{}, false
+	}
+	layer := 4 - int((b[1]>>1)&0x03)
+	bitrateIndex := int(b[2] >> 4)
+	sampleRateIndex := int((b[2] >> 2) & 0x03)
+	if layer == 4 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		return mp3Header{}, false
+	}
+
+	tableVersion := min(version, 2)
+	h := mp3Header{
+		bitrate:    mp3Bitrates[[2]int{tableVersion, layer}][bitrateIndex] * 1000,
+		sampleRate: mp3SampleRates[version][sampleRateIndex],
+	}
+	padding := int((b[2] >> 1) & 0x01)
+	mono := (b[3] >> 6) == 3
+
+	switch {
+	case layer == 1:
+		h.samplesPerFrame = 384
+		h.frameLength = (12*h.bitrate/h.sampleRate + padding) * 4
+	case layer == 3 && version != 1:
+		h.samplesPerFrame = 576
+		h.frameLength = 72*h.bitrate/h.sampleRate + padding
+	default:
+		h.samplesPerFrame = 1152
+		h.frameLength = 144*h.bitrate/h.sampleRate + padding
+	}
+
+	switch {
+	case version == 1 && mono:
+		h.sideInfo = 17
+	case version == 1:
+		h.sideInfo = 32
+	case mono:
+		h.sideInfo = 9
+	default:
+		h.sideInfo = 17
+	}
+	return h, true
+}
+
+// mp3Duration reads the length of an MP3 from its Xing or VBRI header, or
+// estimates it from the bitrate of the first frame for constant bitrate files
+func mp3Duration(r io.ReadSeeker, size int64) (time.Duration, error) {
+	start, err := skipID3v2(r)
+	if err != nil {
+		return 0, err
+	}
+	buf := make([]byte, 64*1024)
+	n, err := io.ReadFull(r, buf)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return 0, err
+	}
+	buf = buf[:n]
+
+	for i := 0; i+4 <= len(buf); i++ {
+		h, ok := parseMP3Header(buf[i:])
+		if !ok {
+			continue
+		}
+		// Check the next frame follows, as headers can appear by chance
+		if next := i + h.frameLength; next+4 <= len(buf) {
+			if _, ok := parseMP3Header(buf[next:]); !ok {
+				continue
+			}
+		}
+
+		frame := buf[i:]
+		if xing := 4 + h.sideInfo; len(frame) >= xing+12 {
+			if tag := string(frame[xing : xing+4]); tag == "Xing" || tag == "Info" {
+				if flags := binary.BigEndian.Uint32(frame[xing+4:]); flags&0x01 != 0 {
+					frames := binary.BigEndian.Uint32(frame[xing+8:])
+					return seconds(float64(frames) * float64(h.samplesPerFrame) / float64(h.sampleRate)), nil
+				}
+			}
+		}
+		if vbri := 4 + 32; len(frame) >= vbri+18 && string(frame[vbri:vbri+4]) == "VBRI" {
+			frames := binary.BigEndian.Uint32(frame[vbri+14:])
+			return seconds(float64(frames) * float64(h.samplesPerFrame) / float64(h.sampleRate)), nil
+		}
+
+		audio := size - start - int64(i)
+		if hasID3v1(r, size) {
+			audio -= 128
+		}
+		return seconds(float64(audio) * 8 / float64(h.bitrate)), nil
+	}
+	return 0, nil
+}
+
+// hasID3v1 reports whether a file ends with an ID3v1 tag
+func hasID3v1(r io.ReadSeeker, size int64) bool {
+	if size < 128 {
+		return false
+	}
+	if _, err := r.Seek(size-128, io.SeekStart); err != nil {
+		return false
+	}
+	tag := make([]byte, 3)
+	if _, err := io.ReadFull(r, tag); err != nil {
+		return false
+	}
+	return string(tag) == "TAG"
+}
+
+// flacDuration reads the length of a FLAC file from its STREAMINFO block
+func flacDuration(r io.ReadSeeker) (time.Duration, error) {
+	if _, err := skipID3v2(r); err != nil {
+		return 0, err
+	}
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(r, magic); err != nil {
+		return 0, err
+	}
+	if string(magic) != "fLaC" {
+		return 0, errInvalidHeader
+	}
+
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	// STREAMINFO is always the first block
+	if header[0]&0x7f != 0 {
+		return 0, errInvalidHeader
+	}
+	info := make([]byte, 18)
+	if _, err := io.ReadFull(r, info); err != nil {
+		return 0, err
+	}
+	sampleRate := int64(info[10])<<12 | int64(info[11])<<4 | int64(info[12])>>4
+	samples := int64(info[13]&0x0f)<<32 | int64(binary.BigEndian.Uint32(info[14:]))
+	if sampleRate == 0 {
+		return 0, errInvalidHeader
+	}
+	return seconds(float64(samples) / float64(sampleRate)), nil
+}
+
+// wavDuration reads the length of a WAV file from the byte rate in its fmt
+// chunk and the size of its data chunk
+func wavDuration(r io.ReadSeeker) (time.Duration, error) {
+	header := make([]byte, 12)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	if string(header[:4]) != "RIFF" || string(header[8:]) != "WAVE" {
+		return 0, errInvalidHeader
+	}
+
+	var byteRate uint32
+	chunk := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return 0, err
+		}
+		size := binary.LittleEndian.Uint32(chunk[4:])
+		switch string(chunk[:4]) {
+		case "fmt ":
+			format := make([]byte, 16)
+			if size < 16 {
+				return 0, errInvalidHeader
+			}
+			if _, err := io.ReadFull(r, format); err != nil {
+				return 0, err
+			}
+			byteRate = binary.LittleEndian.Uint32(format[8:])
+			size -= 16
+		case "data":
+			if byteRate == 0 {
+				return 0, errInvalidHeader
+			}
+			return seconds(float64(size) / float64(byteRate)), nil
+		}
+		// Chunks are padded to an even length
+		if _, err := r.Seek(int64(size)+int64(size&1), io.SeekCurrent); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// oggDuration reads the length of an Ogg Vorbis or Opus file from the
+// granule position of its last page
+func oggDuration(r io.ReadSeeker, size int64) (time.Duration, error) {
+	header := make([]byte, 27)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	if string(header[:4]) != "OggS" {
+		return 0, errInvalidHeader
+	}
+	segments := make([]byte, header[26])
+	if _, err := io.ReadFull(r, segments); err != nil {
+		return 0, err
+	}
+	packet := make([]byte, 19)
+	if _, err := io.ReadFull(r, packet); err != nil {
+		return 0, err
+	}
+
+	var sampleRate, preSkip int64
+	switch {
+	case packet[0] == 0x01 && string(packet[1:7]) == "vorbis":
+		sampleRate = int64(binary.LittleEndian.Uint32(packet[12:]))
+	case string(packet[:8]) == "OpusHead":
+		// Opus granule positions always count 48kHz samples
+		sampleRate = 48000
+		preSkip = int64(binary.LittleEndian.Uint16(packet[10:]))
+	default:
+		return 0, nil
+	}
+	if sampleRate == 0 {
+		return 0, errInvalidHeader
+	}
+
+	tail := min(size, 64*1024)
+	if _, err := r.Seek(size-tail, io.SeekStart); err != nil {
+		return 0, err
+	}
+	buf := make([]byte, tail)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, err
+	}
+	last := bytes.LastIndex(buf, []byte("OggS"))
+	if last < 0 || last+14 > len(buf) {
+		return 0, errInvalidHeader
+	}
+	granule := int64(binary.LittleEndian.Uint64(buf[last+6:]))
+	return seconds(float64(max(granule-preSkip, 0)) / float64(sampleRate)), nil
+}
+
+// mp4Duration reads the length of an MP4 or QuickTime file from its movie
+// header
+func mp4Duration(r io.ReadSeeker, size int64) (time.Duration, error) {
+	moovStart, moovEnd, err := findAtom(r, 0, size, "moov")
+	if err != nil {
+		return 0, err
+	}
+	mvhdStart, mvhdEnd, err := findAtom(r, moovStart, moovEnd, "mvhd")
+	if err != nil {
+		return 0, err
+	}
+	// Version 0 headers need 20 bytes for the duration, and version 1 32
+	if mvhdEnd-mvhdStart < 20 {
+		return 0, errInvalidHeader
+	}
+	if _, err := r.Seek(mvhdStart, io.SeekStart); err != nil {
+		return 0, err
+	}
+	mvhd := make([]byte, min(mvhdEnd-mvhdStart, 32))
+	if _, err := io.ReadFull(r, mvhd); err != nil {
+		return 0, err
+	}
+
+	var timescale, duration uint64
+	switch {
+	case mvhd[0] == 0:
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[12:]))
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:]))
+	case mvhd[0] == 1 && len(mvhd) >= 32:
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[20:]))
+		duration = binary.BigEndian.Uint64(mvhd[24:])
+	default:
+		return 0, errInvalidHeader
+	}
+	if timescale == 0 {
+		return 0, errInvalidHeader
+	}
+	return seconds(float64(duration) / float64(timescale)), nil
+}
+
+// findAtom finds an atom between two offsets, returning where its content
+// starts and ends
+func findAtom(r io.ReadSeeker, start, end int64, name string) (int64, int64, error) {
+	header := make([]byte, 16)
+	for offset := start; offset+8 <= end; {
+		if _, err := r.Seek(offset, io.SeekStart); err != nil {
+			return 0, 0, err
+		}
+		if _, err := io.ReadFull(r, header[:8]); err != nil {
+			return 0, 0, err
+		}
+		size := int64(binary.BigEndian.Uint32(header))
+		headerSize := int64(8)
+		switch size {
+		case 0:
+			// The atom runs to the end of the file
+			size = end - offset
+		case 1:
+			if _, err := io.ReadFull(r, header[8:]); err != nil {
+				return 0, 0, err
+			}
+			size = int64(binary.BigEndian.Uint64(header[8:]))
+			headerSize = 16
+		}
+		// Sizes past the end, or large enough to overflow, are truncated
+		// files, so the atom is cut off at the end
+		if size < 0 || size > end-offset {
+			size = end - offset
+		}
+		if size < headerSize {
+			return 0, 0, errInvalidHeader
+		}
+		if string(header[4:8]) == name {
+			return offset + headerSize, offset + size, nil
+		}
+		offset += size
+	}
+	return 0, 0, errInvalidHeader
+}
+
+const (
+	ebmlID            = 0x1a45dfa3
+	ebmlSegmentID     = 0x18538067
+	ebmlInfoID        = 0x1549a966
+	ebmlClusterID     = 0x1f43b675
+	ebmlTimestampID   = 0x2ad7b1
+	ebmlDurationID    = 0x4489
+	ebmlMaxInfoLength = 64 * 1024
+)
+
+// matroskaDuration reads the length of a Matroska or WebM file from its
+// segment information
+func matroskaDuration(r io.ReadSeeker) (time.Duration, error) {
+	id, size, _, err := readEBMLElement(r)
+	if err != nil {
+		return 0, err
+	}
+	if id != ebmlID {
+		return 0, errInvalidHeader
+	}
+	if _, err := r.Seek(size, io.SeekCurrent); err != nil {
+		return 0, err
+	}
+	if id, _, _, err = readEBMLElement(r); err != nil {
+		return 0, err
+	}
+	if id != ebmlSegmentID {
+		return 0, errInvalidHeader
+	}
+
+	// Info comes before the clusters, usually after the seek head
+	for range 32 {
+		id, size, unknown, err := readEBMLElement(r)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case id == ebmlInfoID && !unknown && size <= ebmlMaxInfoLength:
+			info := make([]byte, size)
+			if _, err := io.ReadFull(r, info); err != nil {
+				return 0, err
+			}
+			return matroskaInfoDuration(bytes.NewReader(info))
+		case id == ebmlClusterID || unknown:
+			return 0, nil
+		}
+		if _, err := r.Seek(size, io.SeekCurrent); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
+
+// matroskaInfoDuration reads the duration from segment information, which is
+// in units of its timestamp scale
+func matroskaInfoDuration(r *bytes.Reader) (time.Duration, error) {
+	scale := uint64(1_000_000)
+	duration := -1.0
+	for r.Len() > 0 {
+		id, size, _, err := readEBMLElement(r)
+		if err != nil {
+			return 0, err
+		}
+		if size > int64(r.Len()) {
+			return 0, errInvalidHeader
+		}
+		value := make([]byte, size)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return 0, err
+		}
+		switch {
+		case id == ebmlTimestampID && size <= 8:
+			scale = 0
+			for _, b := range value {
+				scale = scale<<8 | uint64(b)
+			}
+		case id == ebmlDurationID && size == 4:
+			duration = float64(math.Float32frombits(binary.BigEndian.Uint32(value)))
+		case id == ebmlDurationID && size == 8:
+			duration = math.Float64frombits(binary.BigEndian.Uint64(value))
+		}
+	}
+	if duration < 0 {
+		return 0, nil
+	}
+	return time.Duration(duration * float64(scale)), nil
+}
+
+// readEBMLElement reads an element's ID and size, reporting when the size is
+// unknown, as it can be for segments and clusters that are being streamed
+func readEBMLElement(r io.Reader) (uint32, int64, bool, error) {
+	b := make([]byte, 1)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return 0, 0, false, err
+	}
+	length := bits.LeadingZeros8(b[0]) + 1
+	if length > 4 {
+		return 0, 0, false, errInvalidHeader
+	}
+	id := uint32(b[0])
+	for range length - 1 {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return 0, 0, false, err
+		}
+		id = id<<8 | uint32(b[0])
+	}
+
+	if _, err := io.ReadFull(r, b); err != nil {
+		return 0, 0, false, err
+	}
+	length = bits.LeadingZeros8(b[0]) + 1
+	if length > 8 {
+		return 0, 0, false, errInvalidHeader
+	}
+	size := uint64(b[0]) & (0xff >> length)
+	unknown := size == 0xff>>length
+	for range length - 1 {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return 0, 0, false, err
+		}
+		size = size<<8 | uint64(b[0])
+		unknown = unknown && b[0] == 0xff
+	}
+	if size > math.MaxInt64 {
+		return 0, 0, false, errInvalidHeader
+	}
+	return id, int64(size), unknown, nil
+}
+
+// aviDuration reads the length of an AVI file from its main header
+func aviDuration(r io.ReadSeeker) (time.Duration, error) {
+	header := make([]byte, 32+20)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	if string(header[:4]) != "RIFF" || string(header[8:12]) != "AVI " ||
+		string(header[12:16]) != "LIST" || string(header[20:24]) != "hdrl" || string(header[24:28]) != "avih" {
+		return 0, errInvalidHeader
+	}
+	microSecondsPerFrame := binary.LittleEndian.Uint32(header[32:])
+	frames := binary.LittleEndian.Uint32(header[32+16:])
+	return time.Duration(microSecondsPerFrame) * time.Duration(frames) * time.Microsecond, nil
+}
+
+var (
+	asfHeaderID         = []byte{0x30, 0x26, 0xb2, 0x75, 0x8e, 0x66, 0xcf, 0x11, 0xa6, 0xd9, 0x00, 0xaa, 0x00, 0x62, 0xce, 0x6c}
+	asfFilePropertiesID = []byte{0xa1, 0xdc, 0xab, 0x8c, 0x47, 0xa9, 0xcf, 0x11, 0x8e, 0xe4, 0x00, 0xc0, 0x0c, 0x20, 0x53, 0x65}
+)
+
+// asfDuration reads the length of a WMV file from its file properties, less
+// the time buffered before playback
+func asfDuration(r io.ReadSeeker) (time.Duration, error) {
+	header := make([]byte, 30)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(header[:16], asfHeaderID) {
+		return 0, errInvalidHeader
+	}
+	objects := binary.LittleEndian.Uint32(header[24:])
+
+	object := make([]byte, 24)
+	for range min(objects, 64) {
+		if _, err := io.ReadFull(r, object); err != nil {
+			return 0, err
+		}
+		size := int64(binary.LittleEndian.Uint64(object[16:]))
+		if size < 24 {
+			return 0, errInvalidHeader
+		}
+		if bytes.Equal(object[:16], asfFilePropertiesID) {
+			properties := make([]byte, 64)
+			if _, err := io.ReadFull(r, properties); err != nil {
+				return 0, err
+			}
+			// Play duration is in 100ns units and preroll in milliseconds
+			play := time.Duration(binary.LittleEndian.Uint64(properties[40:])) * 100
+			preroll := time.Duration(binary.LittleEndian.Uint64(properties[56:])) * time.Millisecond
+			return max(play-preroll, 0), nil
+		}
+		if _, err := r.Seek(size-24, io.SeekCurrent); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,212 @@
+package medialibrary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mp3Frames returns constant bitrate MPEG-1 layer III frames at 128kbps and
+// 44.1kHz, the first with a Xing header when xingFrames is set
+func mp3Frames(count int, xingFrames uint32) []byte {
+	const frameLength = 417
+	var buf bytes.Buffer
+	for i := range count {
+		frame := make([]byte, frameLength)
+		copy(frame, []byte{0xff, 0xfb, 0x90, 0x00})
+		if i == 0 && xingFrames > 0 {
+			copy(frame[36:], "Xing")
+			binary.BigEndian.PutUint32(frame[40:], 0x01)
+			binary.BigEndian.PutUint32(frame[44:], xingFrames)
+		}
+		buf.Write(frame)
+	}
+	return buf.Bytes()
+}
+
+// wavFile returns a silent 16-bit stereo WAV at 44.1kHz
+func wavFile(length time.Duration) []byte {
+	const byteRate = 44100 * 2 * 2
+	data := make([]byte, int(length.Seconds()*byteRate))
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(36+len(data)))
+	buf.WriteString("WAVEfmt ")
+	for _, field := range []any{uint32(16), uint16(1), uint16(2), uint32(44100), uint32(byteRate), uint16(4), uint16(16)} {
+		_ = binary.Write(&buf, binary.LittleEndian, field)
+	}
+	buf.WriteString("data")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func flacFile() []byte {
+	const sampleRate, samples = 44100, 441000
+	info := make([]byte, 34)
+	info[10] = byte(sampleRate >> 12)
+	info[11] = byte(sampleRate >> 4 & 0xff)
+	info[12] = byte(sampleRate&0x0f)<<4 | 1<<1 // Two channels
+	info[13] = 15 << 4                         // 16 bits per sample
+	binary.BigEndian.PutUint32(info[14:], samples)
+	return append([]byte{'f', 'L', 'a', 'C', 0x80, 0, 0, 34}, info...)
+}
+
+func mp4File() []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0, 0, 0, 16})
+	buf.WriteString("ftypisom")
+	buf.Write([]byte{0, 0, 2, 0})
+	buf.Write([]byte{0, 0, 0, 8})
+	buf.WriteString("free")
+
+	mvhd := make([]byte, 100)
+	binary.BigEndian.PutUint32(mvhd[12:], 1000)
+	binary.BigEndian.PutUint32(mvhd[16:], 90500)
+	_ = binary.Write(&buf, binary.BigEndian, uint32(8+8+len(mvhd)))
+	buf.WriteString("moov")
+	_ = binary.Write(&buf, binary.BigEndian, uint32(8+len(mvhd)))
+	buf.WriteString("mvhd")
+	buf.Write(mvhd)
+	return buf.Bytes()
+}
+
+func oggPage(granule uint64, packet []byte) []byte {
+	page := []byte("OggS\x00\x02")
+	page = binary.LittleEndian.AppendUint64(page, granule)
+	page = append(page, make([]byte, 12)...) // Serial, sequence and checksum
+	page = append(page, 1, byte(len(packet)))
+	return append(page, packet...)
+}
+
+func oggVorbisFile() []byte {
+	ident := make([]byte, 30)
+	ident[0] = 0x01
+	copy(ident[1:], "vorbis")
+	ident[11] = 2
+	binary.LittleEndian.PutUint32(ident[12:], 44100)
+	file := oggPage(0, ident)
+	file = append(file, oggPage(220500, make([]byte, 200))...)
+	return append(file, oggPage(441000, make([]byte, 200))...)
+}
+
+func oggOpusFile() []byte {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8], head[9] = 1, 2
+	binary.LittleEndian.PutUint16(head[10:], 312)
+	return append(oggPage(0, head), oggPage(312+48000*3, make([]byte, 200))...)
+}
+
+func matroskaFile() []byte {
+	var info []byte
+	info = append(info, 0x2a, 0xd7, 0xb1, 0x83, 0x0f, 0x42, 0x40) // 1ms timestamps
+	info = append(info, 0x44, 0x89, 0x88)
+	info = binary.BigEndian.AppendUint64(info, math.Float64bits(12345))
+
+	var file []byte
+	file = append(file, 0x1a, 0x45, 0xdf, 0xa3, 0x80)
+	// A segment of unknown size, as written while streaming
+	file = append(file, 0x18, 0x53, 0x80, 0x67, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+	file = append(file, 0x11, 0x4d, 0x9b, 0x74, 0x82, 0x00, 0x00)
+	file = append(file, 0x15, 0x49, 0xa9, 0x66, 0x80|byte(len(info)))
+	return append(file, info...)
+}
+
+func aviFile() []byte {
+	avih := make([]byte, 56)
+	binary.LittleEndian.PutUint32(avih[0:], 40000) // 25 frames per second
+	binary.LittleEndian.PutUint32(avih[16:], 250)
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF\x00\x00\x00\x00AVI LIST\x00\x00\x00\x00hdrlavih")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(avih)))
+	buf.Write(avih)
+	return buf.Bytes()
+}
+
+func asfFile() []byte {
+	properties := make([]byte, 80)
+	binary.LittleEndian.PutUint64(properties[40:], 15*10_000_000)
+	binary.LittleEndian.PutUint64(properties[56:], 3000)
+
+	var buf bytes.Buffer
+	buf.Write(asfHeaderID)
+	_ = binary.Write(&buf, binary.LittleEndian, uint64(30+24+len(properties)))
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(1))
+	buf.Write([]byte{1, 2})
+	buf.Write(asfFilePropertiesID)
+	_ = binary.Write(&buf, binary.LittleEndian, uint64(24+len(properties)))
+	buf.Write(properties)
+	return buf.Bytes()
+}
+
+func TestReadDuration(t *testing.T) {
+	tests := []struct {
+		name     string
+		ext      string
+		data     []byte
+		expected time.Duration
+	}{
+		{"mp3 constant bitrate", ".mp3", mp3Frames(100, 0), 2606 * time.Millisecond},
+		{"mp3 with id3 tag", ".mp3", append(id3Tag(map[string]string{"TIT2": "Song"}), mp3Frames(100, 0)...), 2606 * time.Millisecond},
+		{"mp3 xing", ".mp3", mp3Frames(10, 1000), 26122 * time.Millisecond},
+		{"wav", ".wav", wavFile(2 * time.Second), 2 * time.Second},
+		{"flac", ".flac", flacFile(), 10 * time.Second},
+		{"mp4", ".m4a", mp4File(), 90500 * time.Millisecond},
+		{"ogg vorbis", ".ogg", oggVorbisFile(), 10 * time.Second},
+		{"ogg opus", ".ogg", oggOpusFile(), 3 * time.Second},
+		{"matroska", ".mkv", matroskaFile(), 12345 * time.Millisecond},
+		{"avi", ".avi", aviFile(), 10 * time.Second},
+		{"wmv", ".wmv", asfFile(), 12 * time.Second},
+		{"aac is not read", ".aac", []byte{0xff, 0xf1, 0x50, 0x80}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			duration, err := readDuration(bytes.NewReader(tt.data), tt.ext, int64(len(tt.data)))
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, duration, float64(time.Millisecond))
+		})
+	}
+}
+
+func TestReadDurationInvalid(t *testing.T) {
+	for _, ext := range []string{".flac", ".wav", ".ogg", ".m4a", ".mkv", ".avi", ".wmv"} {
+		t.Run(ext, func(t *testing.T) {
+			data := bytes.Repeat([]byte("not media "), 10)
+			_, err := readDuration(bytes.NewReader(data), ext, int64(len(data)))
+			assert.Error(t, err)
+		})
+	}
+
+	// Truncated and malformed atoms are errors rather than panics
+	atom := func(name string, size uint32, content ...byte) []byte {
+		return append(binary.BigEndian.AppendUint32(nil, size), append([]byte(name), content...)...)
+	}
+	extended := binary.BigEndian.AppendUint64(nil, math.MaxUint64)
+	for name, data := range map[string][]byte{
+		"empty mvhd":             atom("moov", 16, atom("mvhd", 8)...),
+		"short mvhd":             atom("moov", 20, atom("mvhd", 12, 0, 0, 0, 0)...),
+		"mvhd past moov":         atom("moov", 16, atom("mvhd", 100)...),
+		"extended size overflow": atom("moov", 1, extended...),
+		"extended size past end": append(atom("free", 8), atom("moov", 1)...),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := readDuration(bytes.NewReader(data), ".mp4", int64(len(data)))
+			assert.Error(t, err)
+		})
+	}
+
+	// Files with no frames have no known length
+	data := bytes.Repeat([]byte{0}, 1000)
+	duration, err := readDuration(bytes.NewReader(data), ".mp3", int64(len(data)))
+	require.NoError(t, err)
+	assert.Zero(t, duration)
+}
@@ -0,0 +1,205 @@
+package medialibrary
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// updateDelay is how long changes must settle before files are indexed, as
+// copying a file into a directory writes it many times
+const updateDelay = 2 * time.Second
+
+// index indexes the directories, then keeps the index up to date as files
+// change until the context is canceled
+func (l *Library) index(ctx context.Context, directories []directory) {
+	// The watcher is set up before scanning, so changes during the scan are
+	// picked up afterwards
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		slog.Warn("Failed to watch media directories, the media library will only update on restart", "error", err)
+	} else {
+		defer func() { _ = watcher.Close() }()
+	}
+
+	started := time.Now()
+	l.removeOtherDirectories(directories)
+	for _, dir := range directories {
+		l.update(ctx, watcher, dir, dir.path)
+	}
+	if ctx.Err() != nil {
+		return
+	}
+	l.persist()
+
+	l.mu.RLock()
+	count := len(l.items)
+	l.mu.RUnlock()
+	slog.Info("Indexed media library", "directories", len(directories), "items", count, "took", time.Since(started).Round(time.Millisecond))
+
+	if watcher != nil {
+		l.watch(ctx, watcher, directories)
+	}
+}
+
+// watch updates the index for changed paths until the context is canceled
+func (l *Library) watch(ctx context.Context, watcher *fsnotify.Watcher, directories []directory) {
+	pending := make(map[string]struct{})
+	timer := time.NewTimer(updateDelay)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Op == fsnotify.Chmod {
+				continue
+			}
+			pending[event.Name] = struct{}{}
+			timer.Reset(updateDelay)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn("Error watching media directories", "error", err)
+		case <-timer.C:
+			for path := range pending {
+				for _, dir := range directories {
+					if _, ok := dir.contains(path); ok {
+						l.update(ctx, watcher, dir, path)
+					}
+				}
+			}
+			clear(pending)
+			l.persist()
+		}
+	}
+}
+
+// update indexes the files under a path in a directory, which may be the
+// directory itself, a subdirectory or a single file. Files that have not
+// changed since they were last indexed are kept, and files that are gone are
+// removed.
+func (l *Library) update(ctx context.Context, watcher *fsnotify.Watcher, dir directory, root string) {
+	prefix, ok := dir.contains(root)
+	if !ok {
+		return
+	}
+
+	l.mu.RLock()
+	existing := make(map[string]Item)
+	for id, item := range l.items {
+		if item.Base == dir.name && underPrefix(item.Path, prefix) {
+			existing[id] = item
+		}
+	}
+	l.mu.RUnlock()
+
+	found := make(map[string]Item)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path != root {
+				slog.Debug("Skipping unreadable media path", "path", path, "error", err)
+			}
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if path != dir.path && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			if watcher != nil {
+				if err := watcher.Add(path); err != nil {
+					slog.Warn("Failed to watch media directory", "path", path, "error", err)
+				}
+			}
+			return nil
+		}
+
+		itemType, ok := itemTypes[strings.ToLower(filepath.Ext(path))]
+		if !ok || !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		rel, _ := dir.contains(path)
+		id := itemID(dir.name, rel)
+		if item, ok := existing[id]; ok && item.Size == info.Size() && item.ModTime.Equal(info.ModTime()) {
+			found[id] = item
+			return nil
+		}
+
+		item, err := readItem(path, itemType, info)
+		if err != nil {
+			slog.Debug("Failed to read media metadata", "path", path, "error", err)
+		}
+		item.ID = id
+		item.Base = dir.name
+		item.Path = rel
+		found[id] = item
+		return nil
+	})
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for id := range existing {
+		if _, ok := found[id]; !ok {
+			delete(l.items, id)
+		}
+	}
+	for id, item := range found {
+		l.items[id] = item
+	}
+}
+
+// underPrefix reports whether a slash separated path is, or is under, a
+// prefix, where "." is the whole directory
+func underPrefix(path, prefix string) bool {
+	return prefix == "." || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// removeOtherDirectories removes items from directories that are no longer
+// in the settings
+func (l *Library) removeOtherDirectories(directories []directory) {
+	names := make(map[string]struct{}, len(directories))
+	for _, dir := range directories {
+		names[dir.name] = struct{}{}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for id, item := range l.items {
+		if _, ok := names[item.Base]; !ok {
+			delete(l.items, id)
+		}
+	}
+}
+
+// persist saves the index, logging failures as the index is rebuilt from the
+// directories anyway
+func (l *Library) persist() {
+	if err := l.save(); err != nil {
+		slog.Warn("Failed to save media library index", "path", l.indexPath, "error", err)
+	}
+}
@@ -0,0 +1,24 @@
+package medialibrary
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *Library
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global media library instance
+func GetInstance() *Library {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global media library instance
+func SetInstance(instance *Library) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
@@ -0,0 +1,234 @@
+// Package medialibrary indexes the media directories from the settings by
+// their tags and properties, so clients can search and browse the music,
+// videos and pictures on this machine rather than listing directories.
+package medialibrary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// ItemType is the kind of media a file holds
+type ItemType string
+
+const (
+	ItemTypeAudio ItemType = "audio"
+	ItemTypeVideo ItemType = "video"
+	ItemTypeImage ItemType = "image"
+)
+
+// itemTypes are the file extensions that are indexed, which are those the
+// media file endpoint serves
+var itemTypes = map[string]ItemType{
+	".mp3": ItemTypeAudio, ".wav": ItemTypeAudio, ".flac": ItemTypeAudio,
+	".aac": ItemTypeAudio, ".ogg": ItemTypeAudio, ".m4a": ItemTypeAudio,
+	".mp4": ItemTypeVideo, ".avi": ItemTypeVideo, ".mkv": ItemTypeVideo,
+	".mov": ItemTypeVideo, ".wmv": ItemTypeVideo, ".webm": ItemTypeVideo,
+	".jpg": ItemTypeImage, ".jpeg": ItemTypeImage, ".png": ItemTypeImage,
+	".gif": ItemTypeImage, ".bmp": ItemTypeImage, ".webp": ItemTypeImage,
+}
+
+// indexVersion is bumped when the metadata read from files changes, so
+// existing indexes are read again
+const indexVersion = 1
+
+// Item is an indexed media file
+type Item struct {
+	ID string `json:"id"`
+	// Base is the name of the media directory the file is in, which with Path
+	// can be passed to GET_MEDIA_FILE_URL
+	Base string `json:"base"`
+	// Path is the slash separated path of the file in its media directory
+	Path    string    `json:"path"`
+	Type    ItemType  `json:"type"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+	// Title is the tagged title, or the file name without its extension
+	Title       string `json:"title"`
+	Artist      string `json:"artist,omitempty"`
+	AlbumArtist string `json:"albumArtist,omitempty"`
+	Album       string `json:"album,omitempty"`
+	Genre       string `json:"genre,omitempty"`
+	Year        int    `json:"year,omitempty"`
+	Track       int    `json:"track,omitempty"`
+	Disc        int    `json:"disc,omitempty"`
+	// Duration is the length of audio and video in seconds, when known
+	Duration float64 `json:"duration,omitempty"`
+	// Width and Height are the dimensions of images in pixels
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+	// TakenAt is when a photo was taken, from its EXIF data
+	TakenAt *time.Time `json:"takenAt,omitempty"`
+}
+
+// itemID returns the ID of the file at a path in a media directory
+func itemID(base, path string) string {
+	sum := sha256.Sum256([]byte(base + "\x00" + path))
+	return hex.EncodeToString(sum[:8])
+}
+
+// directory is a media directory from the settings
+type directory struct {
+	name string
+	path string
+}
+
+// contains returns the slash separated path of a file in the directory
+func (d directory) contains(path string) (string, bool) {
+	rel, err := filepath.Rel(d.path, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// Library keeps an index of the media directories up to date
+type Library struct {
+	indexPath    string
+	loadSettings func() (*settings.Settings, error)
+	reload       chan struct{}
+
+	mu    sync.RWMutex
+	items map[string]Item
+}
+
+// NewLibrary creates a library that keeps its index in a file
+func NewLibrary(indexPath string) *Library {
+	return &Library{
+		indexPath:    indexPath,
+		loadSettings: settings.Load,
+		reload:       make(chan struct{}, 1),
+		items:        make(map[string]Item),
+	}
+}
+
+// Run indexes the media directories and watches them for changes until the
+// context is canceled, starting again when Reload is called
+func (l *Library) Run(ctx context.Context) {
+	if err := l.load(); err != nil {
+		slog.Warn("Failed to load media library index, rebuilding it", "error", err)
+	}
+
+	for {
+		indexCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			l.index(indexCtx, l.directories())
+		}()
+
+		select {
+		case <-ctx.Done():
+		case <-l.reload:
+		}
+		cancel()
+		<-done
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// Reload makes the library index the media directories from the settings
+// again
+func (l *Library) Reload() {
+	select {
+	case l.reload <- struct{}{}:
+	default:
+	}
+}
+
+// directories returns the media directories from the settings
+func (l *Library) directories() []directory {
+	cfg, err := l.loadSettings()
+	if err != nil {
+		slog.Error("Failed to load settings for media library", "error", err)
+		return nil
+	}
+
+	directories := make([]directory, 0, len(cfg.Media.Directories))
+	for _, dir := range cfg.Media.Directories {
+		if dir.Name == "" || dir.Path == "" {
+			continue
+		}
+		directories = append(directories, directory{name: dir.Name, path: filepath.Clean(dir.Path)})
+	}
+	return directories
+}
+
+// index is the on-disk form of the library
+type index struct {
+	Version int    `json:"version"`
+	Items   []Item `json:"items"`
+}
+
+// load reads the index from disk, if there is one for this version
+func (l *Library) load() error {
+	data, err := os.ReadFile(l.indexPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var idx index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return err
+	}
+	if idx.Version != indexVersion {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, item := range idx.Items {
+		l.items[item.ID] = item
+	}
+	return nil
+}
+
+// save writes the index to disk through a temporary file, so it is never
+// left partly written
+func (l *Library) save() error {
+	l.mu.RLock()
+	idx := index{Version: indexVersion, Items: make([]Item, 0, len(l.items))}
+	for _, item := range l.items {
+		idx.Items = append(idx.Items, item)
+	}
+	l.mu.RUnlock()
+
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(l.indexPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create media library directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(l.indexPath), ".media-library-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), l.indexPath)
+}
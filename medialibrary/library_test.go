@@ -0,0 +1,248 @@
+package medialibrary
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// id3Tag returns an ID3v2.3 tag with text frames, such as TIT2 for the title
+func id3Tag(frames map[string]string) []byte {
+	var body bytes.Buffer
+	for id, text := range frames {
+		body.WriteString(id)
+		_ = binary.Write(&body, binary.BigEndian, uint32(len(text)+1))
+		body.Write([]byte{0, 0, 0}) // Flags, then ISO-8859-1 text
+		body.WriteString(text)
+	}
+
+	size := body.Len()
+	header := []byte{'I', 'D', '3', 3, 0, 0,
+		byte(size >> 21 & 0x7f), byte(size >> 14 & 0x7f), byte(size >> 7 & 0x7f), byte(size & 0x7f)}
+	return append(header, body.Bytes()...)
+}
+
+// song returns a tagged MP3 of about 2.6 seconds
+func song(title, artist, album, track string) []byte {
+	return append(id3Tag(map[string]string{
+		"TIT2": title, "TPE1": artist, "TALB": album, "TRCK": track, "TYER": "2001",
+	}), mp3Frames(100, 0)...)
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, data, 0o644))
+}
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+// newTestLibrary creates a library of directories, named by their keys
+func newTestLibrary(t *testing.T, directories map[string]string) *Library {
+	t.Helper()
+	l := NewLibrary(filepath.Join(t.TempDir(), "media-library.json"))
+	l.loadSettings = func() (*settings.Settings, error) {
+		cfg := &settings.Settings{}
+		for name, path := range directories {
+			cfg.Media.Directories = append(cfg.Media.Directories, settings.SettingsMediaDirectory{Name: name, Path: path})
+		}
+		return cfg, nil
+	}
+	return l
+}
+
+// scan indexes a library's directories without watching them
+func scan(l *Library) {
+	for _, dir := range l.directories() {
+		l.update(context.Background(), nil, dir, dir.path)
+	}
+}
+
+func TestLibrary(t *testing.T) {
+	music := t.TempDir()
+	writeFile(t, filepath.Join(music, "Band", "Record", "01.mp3"), song("Opening", "Band", "Record", "1"))
+	writeFile(t, filepath.Join(music, "Band", "Record", "02.mp3"), song("Closing", "Band", "Record", "2/2"))
+	writeFile(t, filepath.Join(music, "Band", "Live", "01.mp3"), song("Encore", "Band", "Live", "1"))
+	writeFile(t, filepath.Join(music, "Other", "single.mp3"), song("Single", "Other", "", ""))
+	writeFile(t, filepath.Join(music, "untagged.wav"), wavFile(time.Second))
+	writeFile(t, filepath.Join(music, "cover.png"), pngImage(t, 30, 20))
+	writeFile(t, filepath.Join(music, "notes.txt"), []byte("not media"))
+	writeFile(t, filepath.Join(music, ".cache", "hidden.mp3"), song("Hidden", "Band", "Record", "3"))
+
+	l := newTestLibrary(t, map[string]string{"music": music})
+	scan(l)
+
+	result, err := l.Search(Query{})
+	require.NoError(t, err)
+	assert.Equal(t, 6, result.Total)
+
+	result, err = l.Search(Query{Artist: "band", Album: "record"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 2)
+	opening := result.Items[0]
+	assert.Equal(t, "music", opening.Base)
+	assert.Equal(t, "Band/Record/01.mp3", opening.Path)
+	assert.Equal(t, ItemTypeAudio, opening.Type)
+	assert.Equal(t, "Opening", opening.Title)
+	assert.Equal(t, "Band", opening.Artist)
+	assert.Equal(t, "Record", opening.Album)
+	assert.Equal(t, 2001, opening.Year)
+	assert.Equal(t, 1, opening.Track)
+	assert.InDelta(t, 2.6, opening.Duration, 0.01)
+	assert.Equal(t, "Closing", result.Items[1].Title)
+
+	result, err = l.Search(Query{Query: "band clos"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "Closing", result.Items[0].Title)
+
+	result, err = l.Search(Query{Type: ItemTypeImage})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "cover", result.Items[0].Title)
+	assert.Equal(t, 30, result.Items[0].Width)
+	assert.Equal(t, 20, result.Items[0].Height)
+
+	result, err = l.Search(Query{Query: "untagged"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.InDelta(t, 1, result.Items[0].Duration, 0.01)
+
+	result, err = l.Search(Query{Type: ItemTypeAudio, Limit: 2, Offset: 3})
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Total)
+	assert.Len(t, result.Items, 2)
+
+	_, err = l.Search(Query{Type: "podcast"})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+	_, err = l.Search(Query{Offset: -1})
+	assert.ErrorIs(t, err, ErrInvalidQuery)
+
+	assert.Equal(t, []Artist{
+		{Name: "Band", Albums: 2, Tracks: 3},
+		{Name: "Other", Albums: 0, Tracks: 1},
+	}, l.Artists())
+
+	albums := l.Albums("BAND")
+	require.Len(t, albums, 2)
+	assert.Equal(t, "Live", albums[0].Name)
+	assert.Equal(t, "Record", albums[1].Name)
+	assert.Equal(t, "Band", albums[1].Artist)
+	assert.Equal(t, 2001, albums[1].Year)
+	assert.Equal(t, 2, albums[1].Tracks)
+	assert.InDelta(t, 5.2, albums[1].Duration, 0.02)
+	assert.Len(t, l.Albums(""), 2)
+	assert.Empty(t, l.Albums("Nobody"))
+}
+
+func TestLibraryIndexFile(t *testing.T) {
+	music := t.TempDir()
+	writeFile(t, filepath.Join(music, "song.mp3"), song("Song", "Band", "Record", "1"))
+
+	l := newTestLibrary(t, map[string]string{"music": music})
+	scan(l)
+	require.NoError(t, l.save())
+
+	loaded := NewLibrary(l.indexPath)
+	require.NoError(t, loaded.load())
+	expected, err := json.Marshal(l.items)
+	require.NoError(t, err)
+	actual, err := json.Marshal(loaded.items)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+
+	// Unchanged files keep their indexed metadata rather than being read again
+	for id, item := range loaded.items {
+		item.Title = "Indexed"
+		loaded.items[id] = item
+	}
+	loaded.loadSettings = l.loadSettings
+	scan(loaded)
+	result, err := loaded.Search(Query{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "Indexed", result.Items[0].Title)
+
+	// Items from directories no longer in the settings are removed
+	loaded.loadSettings = func() (*settings.Settings, error) {
+		return &settings.Settings{}, nil
+	}
+	loaded.removeOtherDirectories(loaded.directories())
+	result, err = loaded.Search(Query{})
+	require.NoError(t, err)
+	assert.Zero(t, result.Total)
+}
+
+func TestLibraryWatch(t *testing.T) {
+	music := t.TempDir()
+	writeFile(t, filepath.Join(music, "first.mp3"), song("First", "Band", "Record", "1"))
+
+	l := newTestLibrary(t, map[string]string{"music": music})
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		l.Run(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	titles := func() []string {
+		result, err := l.Search(Query{})
+		require.NoError(t, err)
+		titles := make([]string, 0, len(result.Items))
+		for _, item := range result.Items {
+			titles = append(titles, item.Title)
+		}
+		return titles
+	}
+	waitFor := func(expected []string) {
+		t.Helper()
+		assert.Eventually(t, func() bool {
+			return assert.ObjectsAreEqual(expected, titles())
+		}, 10*time.Second, 50*time.Millisecond, "expected %v", expected)
+	}
+
+	waitFor([]string{"First"})
+
+	// New files in new subdirectories are indexed
+	writeFile(t, filepath.Join(music, "Live", "second.mp3"), song("Second", "Band", "Record", "2"))
+	waitFor([]string{"First", "Second"})
+
+	require.NoError(t, os.RemoveAll(filepath.Join(music, "Live")))
+	waitFor([]string{"First"})
+
+	_, err := os.Stat(l.indexPath)
+	assert.NoError(t, err)
+}
+
+func TestReadItemMalformed(t *testing.T) {
+	// An mvhd atom with no content, as left by an interrupted download
+	path := filepath.Join(t.TempDir(), "truncated.m4a")
+	writeFile(t, path, []byte{0, 0, 0, 16, 'm', 'o', 'o', 'v', 0, 0, 0, 8, 'm', 'v', 'h', 'd'})
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	item, err := readItem(path, ItemTypeAudio, info)
+	assert.Error(t, err)
+	assert.Equal(t, "truncated", item.Title)
+	assert.Equal(t, int64(16), item.Size)
+}
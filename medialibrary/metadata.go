@@ -0,0 +1,100 @@
+package medialibrary
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"  // Register GIF for image dimensions
+	_ "image/jpeg" // Register JPEG for image dimensions
+	_ "image/png"  // Register PNG for image dimensions
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dhowden/tag"
+	"github.com/rwcarlsen/goexif/exif"
+	_ "golang.org/x/image/bmp"  // Register BMP for image dimensions
+	_ "golang.org/x/image/webp" // Register WebP for image dimensions
+)
+
+// readItem reads the metadata of a media file. The item always has the
+// file's type, size and a title, even when reading its metadata fails.
+func readItem(path string, itemType ItemType, info fs.FileInfo) (item Item, err error) {
+	// The parsers read files from anywhere in the media directories, so a
+	// malformed file must not take down the server
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic reading media metadata: %v", r)
+		}
+	}()
+	item = Item{
+		Type:    itemType,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}
+	defer func() {
+		if item.Title == "" {
+			item.Title = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+	}()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return item, err
+	}
+	defer func() { _ = f.Close() }()
+
+	if itemType == ItemTypeImage {
+		return item, readImage(f, &item)
+	}
+
+	// Tags are optional, so files without them are not an error
+	if metadata, err := tag.ReadFrom(f); err == nil {
+		item.Title = strings.TrimSpace(metadata.Title())
+		item.Artist = strings.TrimSpace(metadata.Artist())
+		item.AlbumArtist = strings.TrimSpace(metadata.AlbumArtist())
+		item.Album = strings.TrimSpace(metadata.Album())
+		item.Genre = strings.TrimSpace(metadata.Genre())
+		item.Year = metadata.Year()
+		item.Track, _ = metadata.Track()
+		item.Disc, _ = metadata.Disc()
+	}
+
+	duration, err := readDuration(f, strings.ToLower(filepath.Ext(path)), info.Size())
+	if err != nil {
+		return item, err
+	}
+	item.Duration = duration.Seconds()
+	return item, nil
+}
+
+// readImage reads the dimensions of an image, and when it was taken from its
+// EXIF data if it has any
+func readImage(f io.ReadSeeker, item *Item) error {
+	config, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return err
+	}
+	item.Width, item.Height = config.Width, config.Height
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	x, err := exif.Decode(f)
+	if err != nil {
+		// Most images other than photos have no EXIF data
+		return nil
+	}
+	if takenAt, err := x.DateTime(); err == nil {
+		item.TakenAt = &takenAt
+	}
+	// Orientations 5 to 8 are rotated a quarter turn, so are displayed with
+	// their width and height swapped
+	if tag, err := x.Get(exif.Orientation); err == nil {
+		if orientation, err := tag.Int(0); err == nil && orientation >= 5 && orientation <= 8 {
+			item.Width, item.Height = item.Height, item.Width
+		}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package medialibrary
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefaultLimit is how many items a search returns when it sets no limit
+	DefaultLimit = 100
+	// MaxLimit is the most items a search returns
+	MaxLimit = 1000
+)
+
+// ErrInvalidQuery is returned for a search with an unknown type or a
+// negative limit or offset
+var ErrInvalidQuery = errors.New("invalid media library query")
+
+// Query searches the library. Every word of the query text must appear in
+// an item's title, artist, album artist, album, genre or path, and the other
+// fields narrow the results further.
+type Query struct {
+	Query string   `json:"query" mapstructure:"query"`
+	Type  ItemType `json:"type" mapstructure:"type"`
+	// Base limits the results to a media directory
+	Base string `json:"base" mapstructure:"base"`
+	// Artist matches the artist or album artist, ignoring case
+	Artist string `json:"artist" mapstructure:"artist"`
+	// Album matches the album, ignoring case
+	Album  string `json:"album" mapstructure:"album"`
+	Limit  int    `json:"limit" mapstructure:"limit"`
+	Offset int    `json:"offset" mapstructure:"offset"`
+}
+
+// Validate checks the query, applying the default limit and capping it at
+// the maximum
+func (q *Query) Validate() error {
+	switch q.Type {
+	case "", ItemTypeAudio, ItemTypeVideo, ItemTypeImage:
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidQuery, q.Type)
+	}
+	if q.Limit < 0 || q.Offset < 0 {
+		return fmt.Errorf("%w: limit and offset cannot be negative", ErrInvalidQuery)
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultLimit
+	}
+	q.Limit = min(q.Limit, MaxLimit)
+	return nil
+}
+
+// SearchResult is a page of items matching a query
+type SearchResult struct {
+	Items []Item `json:"items"`
+	// Total is how many items match, across every page
+	Total int `json:"total"`
+}
+
+// Search returns the items matching a query, ordered by artist, album, disc,
+// track and path
+func (l *Library) Search(query Query) (SearchResult, error) {
+	if err := query.Validate(); err != nil {
+		return SearchResult{}, err
+	}
+	words := strings.Fields(strings.ToLower(query.Query))
+
+	l.mu.RLock()
+	matches := make([]Item, 0)
+	for _, item := range l.items {
+		if item.matches(query, words) {
+			matches = append(matches, item)
+		}
+	}
+	l.mu.RUnlock()
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].less(matches[j])
+	})
+
+	start := min(query.Offset, len(matches))
+	end := min(start+query.Limit, len(matches))
+	return SearchResult{Items: matches[start:end], Total: len(matches)}, nil
+}
+
+// matches reports whether an item matches a query and its lower case words
+func (item Item) matches(query Query, words []string) bool {
+	if query.Type != "" && item.Type != query.Type {
+		return false
+	}
+	if query.Base != "" && item.Base != query.Base {
+		return false
+	}
+	if query.Artist != "" && !strings.EqualFold(item.Artist, query.Artist) && !strings.EqualFold(item.AlbumArtist, query.Artist) {
+		return false
+	}
+	if query.Album != "" && !strings.EqualFold(item.Album, query.Album) {
+		return false
+	}
+	if len(words) == 0 {
+		return true
+	}
+
+	text := strings.ToLower(strings.Join([]string{
+		item.Title, item.Artist, item.AlbumArtist, item.Album, item.Genre, item.Path,
+	}, "\x00"))
+	for _, word := range words {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupArtist is the artist an item's album is listed under
+func (item Item) groupArtist() string {
+	if item.AlbumArtist != "" {
+		return item.AlbumArtist
+	}
+	return item.Artist
+}
+
+// less orders items by artist, album, disc, track and path
+func (item Item) less(other Item) bool {
+	if a, b := strings.ToLower(item.groupArtist()), strings.ToLower(other.groupArtist()); a != b {
+		return a < b
+	}
+	if a, b := strings.ToLower(item.Album), strings.ToLower(other.Album); a != b {
+		return a < b
+	}
+	if item.Disc != other.Disc {
+		return item.Disc < other.Disc
+	}
+	if item.Track != other.Track {
+		return item.Track < other.Track
+	}
+	if item.Base != other.Base {
+		return item.Base < other.Base
+	}
+	return item.Path < other.Path
+}
+
+// Artist is an artist with tracks in the library
+type Artist struct {
+	Name   string `json:"name"`
+	Albums int    `json:"albums"`
+	Tracks int    `json:"tracks"`
+}
+
+// Artists returns the artists of the audio in the library by name, listing
+// tracks under their album artist when they have one
+func (l *Library) Artists() []Artist {
+	type group struct {
+		artist Artist
+		albums map[string]struct{}
+	}
+	groups := make(map[string]*group)
+
+	l.mu.RLock()
+	for _, item := range l.items {
+		name := item.groupArtist()
+		if item.Type != ItemTypeAudio || name == "" {
+			continue
+		}
+		key := strings.ToLower(name)
+		g, ok := groups[key]
+		if !ok {
+			g = &group{artist: Artist{Name: name}, albums: make(map[string]struct{})}
+			groups[key] = g
+		}
+		g.artist.Tracks++
+		if item.Album != "" {
+			g.albums[strings.ToLower(item.Album)] = struct{}{}
+		}
+	}
+	l.mu.RUnlock()
+
+	artists := make([]Artist, 0, len(groups))
+	for _, g := range groups {
+		g.artist.Albums = len(g.albums)
+		artists = append(artists, g.artist)
+	}
+	sort.Slice(artists, func(i, j int) bool {
+		return strings.ToLower(artists[i].Name) < strings.ToLower(artists[j].Name)
+	})
+	return artists
+}
+
+// Album is an album with tracks in the library
+type Album struct {
+	Name   string `json:"name"`
+	Artist string `json:"artist"`
+	Year   int    `json:"year,omitempty"`
+	Tracks int    `json:"tracks"`
+	// Duration is the total length of the album's tracks in seconds
+	Duration float64 `json:"duration"`
+}
+
+// Albums returns the albums of the audio in the library by artist and name,
+// or only those listed under an artist, ignoring case
+func (l *Library) Albums(artist string) []Album {
+	groups := make(map[[2]string]*Album)
+
+	l.mu.RLock()
+	for _, item := range l.items {
+		name := item.groupArtist()
+		if item.Type != ItemTypeAudio || item.Album == "" {
+			continue
+		}
+		if artist != "" && !strings.EqualFold(name, artist) {
+			continue
+		}
+		key := [2]string{strings.ToLower(name), strings.ToLower(item.Album)}
+		album, ok := groups[key]
+		if !ok {
+			album = &Album{Name: item.Album, Artist: name}
+			groups[key] = album
+		}
+		album.Tracks++
+		album.Duration += item.Duration
+		album.Year = max(album.Year, item.Year)
+	}
+	l.mu.RUnlock()
+
+	albums := make([]Album, 0, len(groups))
+	for _, album := range groups {
+		albums = append(albums, *album)
+	}
+	sort.Slice(albums, func(i, j int) bool {
+		if a, b := strings.ToLower(albums[i].Artist), strings.ToLower(albums[j].Artist); a != b {
+			return a < b
+		}
+		return strings.ToLower(albums[i].Name) < strings.ToLower(albums[j].Name)
+	})
+	return albums
+}
@@ -0,0 +1,1935 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: systembridge/v1/service.proto
+
+package systembridgev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRequest) ProtoMessage() {}
+
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+// ActionResponse is returned by handlers that perform an action without data
+type ActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionResponse) Reset() {
+	*x = ActionResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionResponse) ProtoMessage() {}
+
+func (x *ActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionResponse.ProtoReflect.Descriptor instead.
+func (*ActionResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ModuleData is a single data module with its typed data
+type ModuleData struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Module  string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Updated string                 `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*ModuleData_Battery
+	//	*ModuleData_Cpu
+	//	*ModuleData_Disks
+	//	*ModuleData_Displays
+	//	*ModuleData_Gpus
+	//	*ModuleData_Media
+	//	*ModuleData_Memory
+	//	*ModuleData_Networks
+	//	*ModuleData_Processes
+	//	*ModuleData_Sensors
+	//	*ModuleData_System
+	Data          isModuleData_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleData) Reset() {
+	*x = ModuleData{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleData) ProtoMessage() {}
+
+func (x *ModuleData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleData.ProtoReflect.Descriptor instead.
+func (*ModuleData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ModuleData) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *ModuleData) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *ModuleData) GetData() isModuleData_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ModuleData) GetBattery() *BatteryData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Battery); ok {
+			return x.Battery
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetCpu() *CPUData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Cpu); ok {
+			return x.Cpu
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetDisks() *DisksData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Disks); ok {
+			return x.Disks
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetDisplays() *DisplaysData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Displays); ok {
+			return x.Displays
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetGpus() *GPUsData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Gpus); ok {
+			return x.Gpus
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetMedia() *MediaData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Media); ok {
+			return x.Media
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetMemory() *MemoryData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Memory); ok {
+			return x.Memory
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetNetworks() *NetworksData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Networks); ok {
+			return x.Networks
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetProcesses() *ProcessesData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Processes); ok {
+			return x.Processes
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetSensors() *SensorsData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_Sensors); ok {
+			return x.Sensors
+		}
+	}
+	return nil
+}
+
+func (x *ModuleData) GetSystem() *SystemData {
+	if x != nil {
+		if x, ok := x.Data.(*ModuleData_System); ok {
+			return x.System
+		}
+	}
+	return nil
+}
+
+type isModuleData_Data interface {
+	isModuleData_Data()
+}
+
+type ModuleData_Battery struct {
+	Battery *BatteryData `protobuf:"bytes,10,opt,name=battery,proto3,oneof"`
+}
+
+type ModuleData_Cpu struct {
+	Cpu *CPUData `protobuf:"bytes,11,opt,name=cpu,proto3,oneof"`
+}
+
+type ModuleData_Disks struct {
+	Disks *DisksData `protobuf:"bytes,12,opt,name=disks,proto3,oneof"`
+}
+
+type ModuleData_Displays struct {
+	Displays *DisplaysData `protobuf:"bytes,13,opt,name=displays,proto3,oneof"`
+}
+
+type ModuleData_Gpus struct {
+	Gpus *GPUsData `protobuf:"bytes,14,opt,name=gpus,proto3,oneof"`
+}
+
+type ModuleData_Media struct {
+	Media *MediaData `protobuf:"bytes,15,opt,name=media,proto3,oneof"`
+}
+
+type ModuleData_Memory struct {
+	Memory *MemoryData `protobuf:"bytes,16,opt,name=memory,proto3,oneof"`
+}
+
+type ModuleData_Networks struct {
+	Networks *NetworksData `protobuf:"bytes,17,opt,name=networks,proto3,oneof"`
+}
+
+type ModuleData_Processes struct {
+	Processes *ProcessesData `protobuf:"bytes,18,opt,name=processes,proto3,oneof"`
+}
+
+type ModuleData_Sensors struct {
+	Sensors *SensorsData `protobuf:"bytes,19,opt,name=sensors,proto3,oneof"`
+}
+
+type ModuleData_System struct {
+	System *SystemData `protobuf:"bytes,20,opt,name=system,proto3,oneof"`
+}
+
+func (*ModuleData_Battery) isModuleData_Data() {}
+
+func (*ModuleData_Cpu) isModuleData_Data() {}
+
+func (*ModuleData_Disks) isModuleData_Data() {}
+
+func (*ModuleData_Displays) isModuleData_Data() {}
+
+func (*ModuleData_Gpus) isModuleData_Data() {}
+
+func (*ModuleData_Media) isModuleData_Data() {}
+
+func (*ModuleData_Memory) isModuleData_Data() {}
+
+func (*ModuleData_Networks) isModuleData_Data() {}
+
+func (*ModuleData_Processes) isModuleData_Data() {}
+
+func (*ModuleData_Sensors) isModuleData_Data() {}
+
+func (*ModuleData_System) isModuleData_Data() {}
+
+type GetDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modules       []string               `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataRequest) Reset() {
+	*x = GetDataRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataRequest) ProtoMessage() {}
+
+func (x *GetDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataRequest.ProtoReflect.Descriptor instead.
+func (*GetDataRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDataRequest) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type GetDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modules       []*ModuleData          `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataResponse) Reset() {
+	*x = GetDataResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataResponse) ProtoMessage() {}
+
+func (x *GetDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataResponse.ProtoReflect.Descriptor instead.
+func (*GetDataResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDataResponse) GetModules() []*ModuleData {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type SubscribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Modules to subscribe to, defaults to all registered modules
+	Modules       []string `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SubscribeRequest) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type SettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *structpb.Struct       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettingsResponse) Reset() {
+	*x = SettingsResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsResponse) ProtoMessage() {}
+
+func (x *SettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsResponse.ProtoReflect.Descriptor instead.
+func (*SettingsResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SettingsResponse) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *structpb.Struct       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettingsRequest) Reset() {
+	*x = UpdateSettingsRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateSettingsRequest) GetSettings() *structpb.Struct {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Directory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Directory) Reset() {
+	*x = Directory{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Directory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Directory) ProtoMessage() {}
+
+func (x *Directory) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Directory.ProtoReflect.Descriptor instead.
+func (*Directory) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Directory) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Directory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Directory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Directory) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetDirectoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Directories   []*Directory           `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirectoriesResponse) Reset() {
+	*x = GetDirectoriesResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirectoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirectoriesResponse) ProtoMessage() {}
+
+func (x *GetDirectoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirectoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetDirectoriesResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDirectoriesResponse) GetDirectories() []*Directory {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+type GetDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirectoryRequest) Reset() {
+	*x = GetDirectoryRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirectoryRequest) ProtoMessage() {}
+
+func (x *GetDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetDirectoryRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+type GetFilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesRequest) Reset() {
+	*x = GetFilesRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesRequest) ProtoMessage() {}
+
+func (x *GetFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetFilesRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFilesRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *GetFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	IsDirectory   bool                   `protobuf:"varint,4,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	ModTime       string                 `protobuf:"bytes,5,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+	Permissions   string                 `protobuf:"bytes,6,opt,name=permissions,proto3" json:"permissions,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Extension     string                 `protobuf:"bytes,8,opt,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileEntry) Reset() {
+	*x = FileEntry{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileEntry) ProtoMessage() {}
+
+func (x *FileEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileEntry.ProtoReflect.Descriptor instead.
+func (*FileEntry) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FileEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileEntry) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *FileEntry) GetModTime() string {
+	if x != nil {
+		return x.ModTime
+	}
+	return ""
+}
+
+func (x *FileEntry) GetPermissions() string {
+	if x != nil {
+		return x.Permissions
+	}
+	return ""
+}
+
+func (x *FileEntry) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *FileEntry) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+type GetFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Files         []*FileEntry           `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFilesResponse) Reset() {
+	*x = GetFilesResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilesResponse) ProtoMessage() {}
+
+func (x *GetFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetFilesResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetFilesResponse) GetFiles() []*FileEntry {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FileInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Modified      int64                  `protobuf:"varint,4,opt,name=modified,proto3" json:"modified,omitempty"`
+	Extension     string                 `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
+	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileInfo) GetModified() int64 {
+	if x != nil {
+		return x.Modified
+	}
+	return 0
+}
+
+func (x *FileInfo) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *FileInfo) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type ValidateDirectoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateDirectoryRequest) Reset() {
+	*x = ValidateDirectoryRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDirectoryRequest) ProtoMessage() {}
+
+func (x *ValidateDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ValidateDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ValidateDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ValidateDirectoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateDirectoryResponse) Reset() {
+	*x = ValidateDirectoryResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateDirectoryResponse) ProtoMessage() {}
+
+func (x *ValidateDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ValidateDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidateDirectoryResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type OpenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenRequest) Reset() {
+	*x = OpenRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenRequest) ProtoMessage() {}
+
+func (x *OpenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenRequest.ProtoReflect.Descriptor instead.
+func (*OpenRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OpenRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OpenRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type KeyboardKeypressRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Key       string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Modifiers []string               `protobuf:"bytes,2,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	// Delay in milliseconds
+	Delay         int32 `protobuf:"varint,3,opt,name=delay,proto3" json:"delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyboardKeypressRequest) Reset() {
+	*x = KeyboardKeypressRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyboardKeypressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyboardKeypressRequest) ProtoMessage() {}
+
+func (x *KeyboardKeypressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyboardKeypressRequest.ProtoReflect.Descriptor instead.
+func (*KeyboardKeypressRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *KeyboardKeypressRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyboardKeypressRequest) GetModifiers() []string {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *KeyboardKeypressRequest) GetDelay() int32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type KeyboardTextRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Text  string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Delay in milliseconds
+	Delay         int32 `protobuf:"varint,2,opt,name=delay,proto3" json:"delay,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyboardTextRequest) Reset() {
+	*x = KeyboardTextRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyboardTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyboardTextRequest) ProtoMessage() {}
+
+func (x *KeyboardTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyboardTextRequest.ProtoReflect.Descriptor instead.
+func (*KeyboardTextRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KeyboardTextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *KeyboardTextRequest) GetDelay() int32 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+type MediaControlRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Action string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Player to control, defaulting to the active player
+	Player string `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"`
+	// Position in seconds for SEEK, or the offset to seek by when relative
+	Position *float64 `protobuf:"fixed64,3,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Relative bool     `protobuf:"varint,4,opt,name=relative,proto3" json:"relative,omitempty"`
+	// Volume from 0 to 100 for SET_VOLUME
+	Volume *float64 `protobuf:"fixed64,5,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	// Shuffle state for SET_SHUFFLE
+	Shuffle *bool `protobuf:"varint,6,opt,name=shuffle,proto3,oneof" json:"shuffle,omitempty"`
+	// NONE, TRACK or PLAYLIST for SET_REPEAT
+	Repeat string `protobuf:"bytes,7,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	// Playback rate for SET_RATE, where 1 is normal speed
+	Rate          *float64 `protobuf:"fixed64,8,opt,name=rate,proto3,oneof" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaControlRequest) Reset() {
+	*x = MediaControlRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaControlRequest) ProtoMessage() {}
+
+func (x *MediaControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaControlRequest.ProtoReflect.Descriptor instead.
+func (*MediaControlRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MediaControlRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MediaControlRequest) GetPlayer() string {
+	if x != nil {
+		return x.Player
+	}
+	return ""
+}
+
+func (x *MediaControlRequest) GetPosition() float64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *MediaControlRequest) GetRelative() bool {
+	if x != nil {
+		return x.Relative
+	}
+	return false
+}
+
+func (x *MediaControlRequest) GetVolume() float64 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+func (x *MediaControlRequest) GetShuffle() bool {
+	if x != nil && x.Shuffle != nil {
+		return *x.Shuffle
+	}
+	return false
+}
+
+func (x *MediaControlRequest) GetRepeat() string {
+	if x != nil {
+		return x.Repeat
+	}
+	return ""
+}
+
+func (x *MediaControlRequest) GetRate() float64 {
+	if x != nil && x.Rate != nil {
+		return *x.Rate
+	}
+	return 0
+}
+
+type NotificationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Title   string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Icon    string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Duration in milliseconds
+	Duration      int32  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	ActionUrl     string `protobuf:"bytes,5,opt,name=action_url,json=actionUrl,proto3" json:"action_url,omitempty"`
+	ActionPath    string `protobuf:"bytes,6,opt,name=action_path,json=actionPath,proto3" json:"action_path,omitempty"`
+	Sound         string `protobuf:"bytes,7,opt,name=sound,proto3" json:"sound,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationRequest) Reset() {
+	*x = NotificationRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRequest) ProtoMessage() {}
+
+func (x *NotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRequest.ProtoReflect.Descriptor instead.
+func (*NotificationRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NotificationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NotificationRequest) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *NotificationRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *NotificationRequest) GetActionUrl() string {
+	if x != nil {
+		return x.ActionUrl
+	}
+	return ""
+}
+
+func (x *NotificationRequest) GetActionPath() string {
+	if x != nil {
+		return x.ActionPath
+	}
+	return ""
+}
+
+func (x *NotificationRequest) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+type CommandExecuteRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CommandId string                 `protobuf:"bytes,1,opt,name=command_id,json=commandID,proto3" json:"command_id,omitempty"`
+	// Values for the command's declared parameters, keyed by parameter name
+	Params        map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandExecuteRequest) Reset() {
+	*x = CommandExecuteRequest{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandExecuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandExecuteRequest) ProtoMessage() {}
+
+func (x *CommandExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandExecuteRequest.ProtoReflect.Descriptor instead.
+func (*CommandExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CommandExecuteRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandExecuteRequest) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type CommandExecuteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommandId     string                 `protobuf:"bytes,1,opt,name=command_id,json=commandID,proto3" json:"command_id,omitempty"`
+	ExitCode      int32                  `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Stdout        string                 `protobuf:"bytes,3,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr        string                 `protobuf:"bytes,4,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommandExecuteResponse) Reset() {
+	*x = CommandExecuteResponse{}
+	mi := &file_systembridge_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommandExecuteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandExecuteResponse) ProtoMessage() {}
+
+func (x *CommandExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandExecuteResponse.ProtoReflect.Descriptor instead.
+func (*CommandExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CommandExecuteResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandExecuteResponse) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *CommandExecuteResponse) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *CommandExecuteResponse) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *CommandExecuteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_systembridge_v1_service_proto protoreflect.FileDescriptor
+
+const file_systembridge_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dsystembridge/v1/service.proto\x12\x0fsystembridge.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1bsystembridge/v1/types.proto\"\x0e\n" +
+	"\fEmptyRequest\"*\n" +
+	"\x0eActionResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xa9\x05\n" +
+	"\n" +
+	"ModuleData\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\tR\aupdated\x128\n" +
+	"\abattery\x18\n" +
+	" \x01(\v2\x1c.systembridge.v1.BatteryDataH\x00R\abattery\x12,\n" +
+	"\x03cpu\x18\v \x01(\v2\x18.systembridge.v1.CPUDataH\x00R\x03cpu\x122\n" +
+	"\x05disks\x18\f \x01(\v2\x1a.systembridge.v1.DisksDataH\x00R\x05disks\x12;\n" +
+	"\bdisplays\x18\r \x01(\v2\x1d.systembridge.v1.DisplaysDataH\x00R\bdisplays\x12/\n" +
+	"\x04gpus\x18\x0e \x01(\v2\x19.systembridge.v1.GPUsDataH\x00R\x04gpus\x122\n" +
+	"\x05media\x18\x0f \x01(\v2\x1a.systembridge.v1.MediaDataH\x00R\x05media\x125\n" +
+	"\x06memory\x18\x10 \x01(\v2\x1b.systembridge.v1.MemoryDataH\x00R\x06memory\x12;\n" +
+	"\bnetworks\x18\x11 \x01(\v2\x1d.systembridge.v1.NetworksDataH\x00R\bnetworks\x12>\n" +
+	"\tprocesses\x18\x12 \x01(\v2\x1e.systembridge.v1.ProcessesDataH\x00R\tprocesses\x128\n" +
+	"\asensors\x18\x13 \x01(\v2\x1c.systembridge.v1.SensorsDataH\x00R\asensors\x125\n" +
+	"\x06system\x18\x14 \x01(\v2\x1b.systembridge.v1.SystemDataH\x00R\x06systemB\x06\n" +
+	"\x04data\"*\n" +
+	"\x0eGetDataRequest\x12\x18\n" +
+	"\amodules\x18\x01 \x03(\tR\amodules\"H\n" +
+	"\x0fGetDataResponse\x125\n" +
+	"\amodules\x18\x01 \x03(\v2\x1b.systembridge.v1.ModuleDataR\amodules\",\n" +
+	"\x10SubscribeRequest\x12\x18\n" +
+	"\amodules\x18\x01 \x03(\tR\amodules\"G\n" +
+	"\x10SettingsResponse\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bsettings\"L\n" +
+	"\x15UpdateSettingsRequest\x123\n" +
+	"\bsettings\x18\x01 \x01(\v2\x17.google.protobuf.StructR\bsettings\"g\n" +
+	"\tDirectory\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"V\n" +
+	"\x16GetDirectoriesResponse\x12<\n" +
+	"\vdirectories\x18\x01 \x03(\v2\x1a.systembridge.v1.DirectoryR\vdirectories\")\n" +
+	"\x13GetDirectoryRequest\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\"9\n" +
+	"\x0fGetFilesRequest\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\xe8\x01\n" +
+	"\tFileEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12!\n" +
+	"\fis_directory\x18\x04 \x01(\bR\visDirectory\x12\x19\n" +
+	"\bmod_time\x18\x05 \x01(\tR\amodTime\x12 \n" +
+	"\vpermissions\x18\x06 \x01(\tR\vpermissions\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x1c\n" +
+	"\textension\x18\b \x01(\tR\textension\"D\n" +
+	"\x10GetFilesResponse\x120\n" +
+	"\x05files\x18\x01 \x03(\v2\x1a.systembridge.v1.FileEntryR\x05files\"$\n" +
+	"\x0eGetFileRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\x9d\x01\n" +
+	"\bFileInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bmodified\x18\x04 \x01(\x03R\bmodified\x12\x1c\n" +
+	"\textension\x18\x05 \x01(\tR\textension\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\".\n" +
+	"\x18ValidateDirectoryRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"1\n" +
+	"\x19ValidateDirectoryResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\"3\n" +
+	"\vOpenRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"_\n" +
+	"\x17KeyboardKeypressRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\tmodifiers\x18\x02 \x03(\tR\tmodifiers\x12\x14\n" +
+	"\x05delay\x18\x03 \x01(\x05R\x05delay\"?\n" +
+	"\x13KeyboardTextRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05delay\x18\x02 \x01(\x05R\x05delay\"\x9c\x02\n" +
+	"\x13MediaControlRequest\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x16\n" +
+	"\x06player\x18\x02 \x01(\tR\x06player\x12\x1f\n" +
+	"\bposition\x18\x03 \x01(\x01H\x00R\bposition\x88\x01\x01\x12\x1a\n" +
+	"\brelative\x18\x04 \x01(\bR\brelative\x12\x1b\n" +
+	"\x06volume\x18\x05 \x01(\x01H\x01R\x06volume\x88\x01\x01\x12\x1d\n" +
+	"\ashuffle\x18\x06 \x01(\bH\x02R\ashuffle\x88\x01\x01\x12\x16\n" +
+	"\x06repeat\x18\a \x01(\tR\x06repeat\x12\x17\n" +
+	"\x04rate\x18\b \x01(\x01H\x03R\x04rate\x88\x01\x01B\v\n" +
+	"\t_positionB\t\n" +
+	"\a_volumeB\n" +
+	"\n" +
+	"\b_shuffleB\a\n" +
+	"\x05_rate\"\xcb\x01\n" +
+	"\x13NotificationRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04icon\x18\x03 \x01(\tR\x04icon\x12\x1a\n" +
+	"\bduration\x18\x04 \x01(\x05R\bduration\x12\x1d\n" +
+	"\n" +
+	"action_url\x18\x05 \x01(\tR\tactionUrl\x12\x1f\n" +
+	"\vaction_path\x18\x06 \x01(\tR\n" +
+	"actionPath\x12\x14\n" +
+	"\x05sound\x18\a \x01(\tR\x05sound\"\xbd\x01\n" +
+	"\x15CommandExecuteRequest\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x01 \x01(\tR\tcommandID\x12J\n" +
+	"\x06params\x18\x02 \x03(\v22.systembridge.v1.CommandExecuteRequest.ParamsEntryR\x06params\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9a\x01\n" +
+	"\x16CommandExecuteResponse\x12\x1d\n" +
+	"\n" +
+	"command_id\x18\x01 \x01(\tR\tcommandID\x12\x1b\n" +
+	"\texit_code\x18\x02 \x01(\x05R\bexitCode\x12\x16\n" +
+	"\x06stdout\x18\x03 \x01(\tR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\x04 \x01(\tR\x06stderr\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error2\xc7\x0e\n" +
+	"\fSystemBridge\x12L\n" +
+	"\aGetData\x12\x1f.systembridge.v1.GetDataRequest\x1a .systembridge.v1.GetDataResponse\x12M\n" +
+	"\tSubscribe\x12!.systembridge.v1.SubscribeRequest\x1a\x1b.systembridge.v1.ModuleData0\x01\x12Q\n" +
+	"\x0fExitApplication\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12O\n" +
+	"\vGetSettings\x12\x1d.systembridge.v1.EmptyRequest\x1a!.systembridge.v1.SettingsResponse\x12[\n" +
+	"\x0eUpdateSettings\x12&.systembridge.v1.UpdateSettingsRequest\x1a!.systembridge.v1.SettingsResponse\x12X\n" +
+	"\x0eGetDirectories\x12\x1d.systembridge.v1.EmptyRequest\x1a'.systembridge.v1.GetDirectoriesResponse\x12P\n" +
+	"\fGetDirectory\x12$.systembridge.v1.GetDirectoryRequest\x1a\x1a.systembridge.v1.Directory\x12O\n" +
+	"\bGetFiles\x12 .systembridge.v1.GetFilesRequest\x1a!.systembridge.v1.GetFilesResponse\x12E\n" +
+	"\aGetFile\x12\x1f.systembridge.v1.GetFileRequest\x1a\x19.systembridge.v1.FileInfo\x12j\n" +
+	"\x11ValidateDirectory\x12).systembridge.v1.ValidateDirectoryRequest\x1a*.systembridge.v1.ValidateDirectoryResponse\x12E\n" +
+	"\x04Open\x12\x1c.systembridge.v1.OpenRequest\x1a\x1f.systembridge.v1.ActionResponse\x12]\n" +
+	"\x10KeyboardKeypress\x12(.systembridge.v1.KeyboardKeypressRequest\x1a\x1f.systembridge.v1.ActionResponse\x12U\n" +
+	"\fKeyboardText\x12$.systembridge.v1.KeyboardTextRequest\x1a\x1f.systembridge.v1.ActionResponse\x12U\n" +
+	"\fMediaControl\x12$.systembridge.v1.MediaControlRequest\x1a\x1f.systembridge.v1.ActionResponse\x12U\n" +
+	"\fNotification\x12$.systembridge.v1.NotificationRequest\x1a\x1f.systembridge.v1.ActionResponse\x12P\n" +
+	"\x0ePowerHibernate\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12K\n" +
+	"\tPowerLock\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12M\n" +
+	"\vPowerLogout\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12N\n" +
+	"\fPowerRestart\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12O\n" +
+	"\rPowerShutdown\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12L\n" +
+	"\n" +
+	"PowerSleep\x12\x1d.systembridge.v1.EmptyRequest\x1a\x1f.systembridge.v1.ActionResponse\x12a\n" +
+	"\x0eCommandExecute\x12&.systembridge.v1.CommandExecuteRequest\x1a'.systembridge.v1.CommandExecuteResponseBHZFgithub.com/timmo001/system-bridge/proto/systembridge/v1;systembridgev1b\x06proto3"
+
+var (
+	file_systembridge_v1_service_proto_rawDescOnce sync.Once
+	file_systembridge_v1_service_proto_rawDescData []byte
+)
+
+func file_systembridge_v1_service_proto_rawDescGZIP() []byte {
+	file_systembridge_v1_service_proto_rawDescOnce.Do(func() {
+		file_systembridge_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_systembridge_v1_service_proto_rawDesc), len(file_systembridge_v1_service_proto_rawDesc)))
+	})
+	return file_systembridge_v1_service_proto_rawDescData
+}
+
+var file_systembridge_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_systembridge_v1_service_proto_goTypes = []any{
+	(*EmptyRequest)(nil),              // 0: systembridge.v1.EmptyRequest
+	(*ActionResponse)(nil),            // 1: systembridge.v1.ActionResponse
+	(*ModuleData)(nil),                // 2: systembridge.v1.ModuleData
+	(*GetDataRequest)(nil),            // 3: systembridge.v1.GetDataRequest
+	(*GetDataResponse)(nil),           // 4: systembridge.v1.GetDataResponse
+	(*SubscribeRequest)(nil),          // 5: systembridge.v1.SubscribeRequest
+	(*SettingsResponse)(nil),          // 6: systembridge.v1.SettingsResponse
+	(*UpdateSettingsRequest)(nil),     // 7: systembridge.v1.UpdateSettingsRequest
+	(*Directory)(nil),                 // 8: systembridge.v1.Directory
+	(*GetDirectoriesResponse)(nil),    // 9: systembridge.v1.GetDirectoriesResponse
+	(*GetDirectoryRequest)(nil),       // 10: systembridge.v1.GetDirectoryRequest
+	(*GetFilesRequest)(nil),           // 11: systembridge.v1.GetFilesRequest
+	(*FileEntry)(nil),                 // 12: systembridge.v1.FileEntry
+	(*GetFilesResponse)(nil),          // 13: systembridge.v1.GetFilesResponse
+	(*GetFileRequest)(nil),            // 14: systembridge.v1.GetFileRequest
+	(*FileInfo)(nil),                  // 15: systembridge.v1.FileInfo
+	(*ValidateDirectoryRequest)(nil),  // 16: systembridge.v1.ValidateDirectoryRequest
+	(*ValidateDirectoryResponse)(nil), // 17: systembridge.v1.ValidateDirectoryResponse
+	(*OpenRequest)(nil),               // 18: systembridge.v1.OpenRequest
+	(*KeyboardKeypressRequest)(nil),   // 19: systembridge.v1.KeyboardKeypressRequest
+	(*KeyboardTextRequest)(nil),       // 20: systembridge.v1.KeyboardTextRequest
+	(*MediaControlRequest)(nil),       // 21: systembridge.v1.MediaControlRequest
+	(*NotificationRequest)(nil),       // 22: systembridge.v1.NotificationRequest
+	(*CommandExecuteRequest)(nil),     // 23: systembridge.v1.CommandExecuteRequest
+	(*CommandExecuteResponse)(nil),    // 24: systembridge.v1.CommandExecuteResponse
+	nil,                               // 25: systembridge.v1.CommandExecuteRequest.ParamsEntry
+	(*BatteryData)(nil),               // 26: systembridge.v1.BatteryData
+	(*CPUData)(nil),                   // 27: systembridge.v1.CPUData
+	(*DisksData)(nil),                 // 28: systembridge.v1.DisksData
+	(*DisplaysData)(nil),              // 29: systembridge.v1.DisplaysData
+	(*GPUsData)(nil),                  // 30: systembridge.v1.GPUsData
+	(*MediaData)(nil),                 // 31: systembridge.v1.MediaData
+	(*MemoryData)(nil),                // 32: systembridge.v1.MemoryData
+	(*NetworksData)(nil),              // 33: systembridge.v1.NetworksData
+	(*ProcessesData)(nil),             // 34: systembridge.v1.ProcessesData
+	(*SensorsData)(nil),               // 35: systembridge.v1.SensorsData
+	(*SystemData)(nil),                // 36: systembridge.v1.SystemData
+	(*structpb.Struct)(nil),           // 37: google.protobuf.Struct
+}
+var file_systembridge_v1_service_proto_depIdxs = []int32{
+	26, // 0: systembridge.v1.ModuleData.battery:type_name -> systembridge.v1.BatteryData
+	27, // 1: systembridge.v1.ModuleData.cpu:type_name -> systembridge.v1.CPUData
+	28, // 2: systembridge.v1.ModuleData.disks:type_name -> systembridge.v1.DisksData
+	29, // 3: systembridge.v1.ModuleData.displays:type_name -> systembridge.v1.DisplaysData
+	30, // 4: systembridge.v1.ModuleData.gpus:type_name -> systembridge.v1.GPUsData
+	31, // 5: systembridge.v1.ModuleData.media:type_name -> systembridge.v1.MediaData
+	32, // 6: systembridge.v1.ModuleData.memory:type_name -> systembridge.v1.MemoryData
+	33, // 7: systembridge.v1.ModuleData.networks:type_name -> systembridge.v1.NetworksData
+	34, // 8: systembridge.v1.ModuleData.processes:type_name -> systembridge.v1.ProcessesData
+	35, // 9: systembridge.v1.ModuleData.sensors:type_name -> systembridge.v1.SensorsData
+	36, // 10: systembridge.v1.ModuleData.system:type_name -> systembridge.v1.SystemData
+	2,  // 11: systembridge.v1.GetDataResponse.modules:type_name -> systembridge.v1.ModuleData
+	37, // 12: systembridge.v1.SettingsResponse.settings:type_name -> google.protobuf.Struct
+	37, // 13: systembridge.v1.UpdateSettingsRequest.settings:type_name -> google.protobuf.Struct
+	8,  // 14: systembridge.v1.GetDirectoriesResponse.directories:type_name -> systembridge.v1.Directory
+	12, // 15: systembridge.v1.GetFilesResponse.files:type_name -> systembridge.v1.FileEntry
+	25, // 16: systembridge.v1.CommandExecuteRequest.params:type_name -> systembridge.v1.CommandExecuteRequest.ParamsEntry
+	3,  // 17: systembridge.v1.SystemBridge.GetData:input_type -> systembridge.v1.GetDataRequest
+	5,  // 18: systembridge.v1.SystemBridge.Subscribe:input_type -> systembridge.v1.SubscribeRequest
+	0,  // 19: systembridge.v1.SystemBridge.ExitApplication:input_type -> systembridge.v1.EmptyRequest
+	0,  // 20: systembridge.v1.SystemBridge.GetSettings:input_type -> systembridge.v1.EmptyRequest
+	7,  // 21: systembridge.v1.SystemBridge.UpdateSettings:input_type -> systembridge.v1.UpdateSettingsRequest
+	0,  // 22: systembridge.v1.SystemBridge.GetDirectories:input_type -> systembridge.v1.EmptyRequest
+	10, // 23: systembridge.v1.SystemBridge.GetDirectory:input_type -> systembridge.v1.GetDirectoryRequest
+	11, // 24: systembridge.v1.SystemBridge.GetFiles:input_type -> systembridge.v1.GetFilesRequest
+	14, // 25: systembridge.v1.SystemBridge.GetFile:input_type -> systembridge.v1.GetFileRequest
+	16, // 26: systembridge.v1.SystemBridge.ValidateDirectory:input_type -> systembridge.v1.ValidateDirectoryRequest
+	18, // 27: systembridge.v1.SystemBridge.Open:input_type -> systembridge.v1.OpenRequest
+	19, // 28: systembridge.v1.SystemBridge.KeyboardKeypress:input_type -> systembridge.v1.KeyboardKeypressRequest
+	20, // 29: systembridge.v1.SystemBridge.KeyboardText:input_type -> systembridge.v1.KeyboardTextRequest
+	21, // 30: systembridge.v1.SystemBridge.MediaControl:input_type -> systembridge.v1.MediaControlRequest
+	22, // 31: systembridge.v1.SystemBridge.Notification:input_type -> systembridge.v1.NotificationRequest
+	0,  // 32: systembridge.v1.SystemBridge.PowerHibernate:input_type -> systembridge.v1.EmptyRequest
+	0,  // 33: systembridge.v1.SystemBridge.PowerLock:input_type -> systembridge.v1.EmptyRequest
+	0,  // 34: systembridge.v1.SystemBridge.PowerLogout:input_type -> systembridge.v1.EmptyRequest
+	0,  // 35: systembridge.v1.SystemBridge.PowerRestart:input_type -> systembridge.v1.EmptyRequest
+	0,  // 36: systembridge.v1.SystemBridge.PowerShutdown:input_type -> systembridge.v1.EmptyRequest
+	0,  // 37: systembridge.v1.SystemBridge.PowerSleep:input_type -> systembridge.v1.EmptyRequest
+	23, // 38: systembridge.v1.SystemBridge.CommandExecute:input_type -> systembridge.v1.CommandExecuteRequest
+	4,  // 39: systembridge.v1.SystemBridge.GetData:output_type -> systembridge.v1.GetDataResponse
+	2,  // 40: systembridge.v1.SystemBridge.Subscribe:output_type -> systembridge.v1.ModuleData
+	1,  // 41: systembridge.v1.SystemBridge.ExitApplication:output_type -> systembridge.v1.ActionResponse
+	6,  // 42: systembridge.v1.SystemBridge.GetSettings:output_type -> systembridge.v1.SettingsResponse
+	6,  // 43: systembridge.v1.SystemBridge.UpdateSettings:output_type -> systembridge.v1.SettingsResponse
+	9,  // 44: systembridge.v1.SystemBridge.GetDirectories:output_type -> systembridge.v1.GetDirectoriesResponse
+	8,  // 45: systembridge.v1.SystemBridge.GetDirectory:output_type -> systembridge.v1.Directory
+	13, // 46: systembridge.v1.SystemBridge.GetFiles:output_type -> systembridge.v1.GetFilesResponse
+	15, // 47: systembridge.v1.SystemBridge.GetFile:output_type -> systembridge.v1.FileInfo
+	17, // 48: systembridge.v1.SystemBridge.ValidateDirectory:output_type -> systembridge.v1.ValidateDirectoryResponse
+	1,  // 49: systembridge.v1.SystemBridge.Open:output_type -> systembridge.v1.ActionResponse
+	1,  // 50: systembridge.v1.SystemBridge.KeyboardKeypress:output_type -> systembridge.v1.ActionResponse
+	1,  // 51: systembridge.v1.SystemBridge.KeyboardText:output_type -> systembridge.v1.ActionResponse
+	1,  // 52: systembridge.v1.SystemBridge.MediaControl:output_type -> systembridge.v1.ActionResponse
+	1,  // 53: systembridge.v1.SystemBridge.Notification:output_type -> systembridge.v1.ActionResponse
+	1,  // 54: systembridge.v1.SystemBridge.PowerHibernate:output_type -> systembridge.v1.ActionResponse
+	1,  // 55: systembridge.v1.SystemBridge.PowerLock:output_type -> systembridge.v1.ActionResponse
+	1,  // 56: systembridge.v1.SystemBridge.PowerLogout:output_type -> systembridge.v1.ActionResponse
+	1,  // 57: systembridge.v1.SystemBridge.PowerRestart:output_type -> systembridge.v1.ActionResponse
+	1,  // 58: systembridge.v1.SystemBridge.PowerShutdown:output_type -> systembridge.v1.ActionResponse
+	1,  // 59: systembridge.v1.SystemBridge.PowerSleep:output_type -> systembridge.v1.ActionResponse
+	24, // 60: systembridge.v1.SystemBridge.CommandExecute:output_type -> systembridge.v1.CommandExecuteResponse
+	39, // [39:61] is the sub-list for method output_type
+	17, // [17:39] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_systembridge_v1_service_proto_init() }
+func file_systembridge_v1_service_proto_init() {
+	if File_systembridge_v1_service_proto != nil {
+		return
+	}
+	file_systembridge_v1_types_proto_init()
+	file_systembridge_v1_service_proto_msgTypes[2].OneofWrappers = []any{
+		(*ModuleData_Battery)(nil),
+		(*ModuleData_Cpu)(nil),
+		(*ModuleData_Disks)(nil),
+		(*ModuleData_Displays)(nil),
+		(*ModuleData_Gpus)(nil),
+		(*ModuleData_Media)(nil),
+		(*ModuleData_Memory)(nil),
+		(*ModuleData_Networks)(nil),
+		(*ModuleData_Processes)(nil),
+		(*ModuleData_Sensors)(nil),
+		(*ModuleData_System)(nil),
+	}
+	file_systembridge_v1_service_proto_msgTypes[21].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_systembridge_v1_service_proto_rawDesc), len(file_systembridge_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_systembridge_v1_service_proto_goTypes,
+		DependencyIndexes: file_systembridge_v1_service_proto_depIdxs,
+		MessageInfos:      file_systembridge_v1_service_proto_msgTypes,
+	}.Build()
+	File_systembridge_v1_service_proto = out.File
+	file_systembridge_v1_service_proto_goTypes = nil
+	file_systembridge_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,951 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: systembridge/v1/service.proto
+
+package systembridgev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SystemBridge_GetData_FullMethodName           = "/systembridge.v1.SystemBridge/GetData"
+	SystemBridge_Subscribe_FullMethodName         = "/systembridge.v1.SystemBridge/Subscribe"
+	SystemBridge_ExitApplication_FullMethodName   = "/systembridge.v1.SystemBridge/ExitApplication"
+	SystemBridge_GetSettings_FullMethodName       = "/systembridge.v1.SystemBridge/GetSettings"
+	SystemBridge_UpdateSettings_FullMethodName    = "/systembridge.v1.SystemBridge/UpdateSettings"
+	SystemBridge_GetDirectories_FullMethodName    = "/systembridge.v1.SystemBridge/GetDirectories"
+	SystemBridge_GetDirectory_FullMethodName      = "/systembridge.v1.SystemBridge/GetDirectory"
+	SystemBridge_GetFiles_FullMethodName          = "/systembridge.v1.SystemBridge/GetFiles"
+	SystemBridge_GetFile_FullMethodName           = "/systembridge.v1.SystemBridge/GetFile"
+	SystemBridge_ValidateDirectory_FullMethodName = "/systembridge.v1.SystemBridge/ValidateDirectory"
+	SystemBridge_Open_FullMethodName              = "/systembridge.v1.SystemBridge/Open"
+	SystemBridge_KeyboardKeypress_FullMethodName  = "/systembridge.v1.SystemBridge/KeyboardKeypress"
+	SystemBridge_KeyboardText_FullMethodName      = "/systembridge.v1.SystemBridge/KeyboardText"
+	SystemBridge_MediaControl_FullMethodName      = "/systembridge.v1.SystemBridge/MediaControl"
+	SystemBridge_Notification_FullMethodName      = "/systembridge.v1.SystemBridge/Notification"
+	SystemBridge_PowerHibernate_FullMethodName    = "/systembridge.v1.SystemBridge/PowerHibernate"
+	SystemBridge_PowerLock_FullMethodName         = "/systembridge.v1.SystemBridge/PowerLock"
+	SystemBridge_PowerLogout_FullMethodName       = "/systembridge.v1.SystemBridge/PowerLogout"
+	SystemBridge_PowerRestart_FullMethodName      = "/systembridge.v1.SystemBridge/PowerRestart"
+	SystemBridge_PowerShutdown_FullMethodName     = "/systembridge.v1.SystemBridge/PowerShutdown"
+	SystemBridge_PowerSleep_FullMethodName        = "/systembridge.v1.SystemBridge/PowerSleep"
+	SystemBridge_CommandExecute_FullMethodName    = "/systembridge.v1.SystemBridge/CommandExecute"
+)
+
+// SystemBridgeClient is the client API for SystemBridge service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SystemBridge exposes the event handlers and module data over gRPC.
+//
+// Every call must carry the API token in the "token", "x-api-token" or
+// "authorization" (Bearer) metadata key.
+type SystemBridgeClient interface {
+	// Data
+	GetData(ctx context.Context, in *GetDataRequest, opts ...grpc.CallOption) (*GetDataResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ModuleData], error)
+	// Application
+	ExitApplication(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Settings
+	GetSettings(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
+	// Filesystem
+	GetDirectories(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDirectoriesResponse, error)
+	GetDirectory(ctx context.Context, in *GetDirectoryRequest, opts ...grpc.CallOption) (*Directory, error)
+	GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error)
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileInfo, error)
+	ValidateDirectory(ctx context.Context, in *ValidateDirectoryRequest, opts ...grpc.CallOption) (*ValidateDirectoryResponse, error)
+	Open(ctx context.Context, in *OpenRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Input
+	KeyboardKeypress(ctx context.Context, in *KeyboardKeypressRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	KeyboardText(ctx context.Context, in *KeyboardTextRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Media
+	MediaControl(ctx context.Context, in *MediaControlRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Notifications
+	Notification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Power
+	PowerHibernate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PowerLock(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PowerLogout(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PowerRestart(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PowerShutdown(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	PowerSleep(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error)
+	// Commands
+	CommandExecute(ctx context.Context, in *CommandExecuteRequest, opts ...grpc.CallOption) (*CommandExecuteResponse, error)
+}
+
+type systemBridgeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSystemBridgeClient(cc grpc.ClientConnInterface) SystemBridgeClient {
+	return &systemBridgeClient{cc}
+}
+
+func (c *systemBridgeClient) GetData(ctx context.Context, in *GetDataRequest, opts ...grpc.CallOption) (*GetDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_GetData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ModuleData], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SystemBridge_ServiceDesc.Streams[0], SystemBridge_Subscribe_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeRequest, ModuleData]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SystemBridge_SubscribeClient = grpc.ServerStreamingClient[ModuleData]
+
+func (c *systemBridgeClient) ExitApplication(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_ExitApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) GetSettings(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) GetDirectories(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDirectoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDirectoriesResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_GetDirectories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) GetDirectory(ctx context.Context, in *GetDirectoryRequest, opts ...grpc.CallOption) (*Directory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Directory)
+	err := c.cc.Invoke(ctx, SystemBridge_GetDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFilesResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_GetFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*FileInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileInfo)
+	err := c.cc.Invoke(ctx, SystemBridge_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) ValidateDirectory(ctx context.Context, in *ValidateDirectoryRequest, opts ...grpc.CallOption) (*ValidateDirectoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateDirectoryResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_ValidateDirectory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) Open(ctx context.Context, in *OpenRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_Open_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) KeyboardKeypress(ctx context.Context, in *KeyboardKeypressRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_KeyboardKeypress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) KeyboardText(ctx context.Context, in *KeyboardTextRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_KeyboardText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) MediaControl(ctx context.Context, in *MediaControlRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_MediaControl_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) Notification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_Notification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerHibernate(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerHibernate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerLock(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerLogout(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerLogout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerRestart(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerRestart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerShutdown(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerShutdown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) PowerSleep(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActionResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_PowerSleep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemBridgeClient) CommandExecute(ctx context.Context, in *CommandExecuteRequest, opts ...grpc.CallOption) (*CommandExecuteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommandExecuteResponse)
+	err := c.cc.Invoke(ctx, SystemBridge_CommandExecute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SystemBridgeServer is the server API for SystemBridge service.
+// All implementations must embed UnimplementedSystemBridgeServer
+// for forward compatibility.
+//
+// SystemBridge exposes the event handlers and module data over gRPC.
+//
+// Every call must carry the API token in the "token", "x-api-token" or
+// "authorization" (Bearer) metadata key.
+type SystemBridgeServer interface {
+	// Data
+	GetData(context.Context, *GetDataRequest) (*GetDataResponse, error)
+	Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[ModuleData]) error
+	// Application
+	ExitApplication(context.Context, *EmptyRequest) (*ActionResponse, error)
+	// Settings
+	GetSettings(context.Context, *EmptyRequest) (*SettingsResponse, error)
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*SettingsResponse, error)
+	// Filesystem
+	GetDirectories(context.Context, *EmptyRequest) (*GetDirectoriesResponse, error)
+	GetDirectory(context.Context, *GetDirectoryRequest) (*Directory, error)
+	GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*FileInfo, error)
+	ValidateDirectory(context.Context, *ValidateDirectoryRequest) (*ValidateDirectoryResponse, error)
+	Open(context.Context, *OpenRequest) (*ActionResponse, error)
+	// Input
+	KeyboardKeypress(context.Context, *KeyboardKeypressRequest) (*ActionResponse, error)
+	KeyboardText(context.Context, *KeyboardTextRequest) (*ActionResponse, error)
+	// Media
+	MediaControl(context.Context, *MediaControlRequest) (*ActionResponse, error)
+	// Notifications
+	Notification(context.Context, *NotificationRequest) (*ActionResponse, error)
+	// Power
+	PowerHibernate(context.Context, *EmptyRequest) (*ActionResponse, error)
+	PowerLock(context.Context, *EmptyRequest) (*ActionResponse, error)
+	PowerLogout(context.Context, *EmptyRequest) (*ActionResponse, error)
+	PowerRestart(context.Context, *EmptyRequest) (*ActionResponse, error)
+	PowerShutdown(context.Context, *EmptyRequest) (*ActionResponse, error)
+	PowerSleep(context.Context, *EmptyRequest) (*ActionResponse, error)
+	// Commands
+	CommandExecute(context.Context, *CommandExecuteRequest) (*CommandExecuteResponse, error)
+	mustEmbedUnimplementedSystemBridgeServer()
+}
+
+// UnimplementedSystemBridgeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSystemBridgeServer struct{}
+
+func (UnimplementedSystemBridgeServer) GetData(context.Context, *GetDataRequest) (*GetDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetData not implemented")
+}
+func (UnimplementedSystemBridgeServer) Subscribe(*SubscribeRequest, grpc.ServerStreamingServer[ModuleData]) error {
+	return status.Error(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedSystemBridgeServer) ExitApplication(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExitApplication not implemented")
+}
+func (UnimplementedSystemBridgeServer) GetSettings(context.Context, *EmptyRequest) (*SettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedSystemBridgeServer) UpdateSettings(context.Context, *UpdateSettingsRequest) (*SettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedSystemBridgeServer) GetDirectories(context.Context, *EmptyRequest) (*GetDirectoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDirectories not implemented")
+}
+func (UnimplementedSystemBridgeServer) GetDirectory(context.Context, *GetDirectoryRequest) (*Directory, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDirectory not implemented")
+}
+func (UnimplementedSystemBridgeServer) GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFiles not implemented")
+}
+func (UnimplementedSystemBridgeServer) GetFile(context.Context, *GetFileRequest) (*FileInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedSystemBridgeServer) ValidateDirectory(context.Context, *ValidateDirectoryRequest) (*ValidateDirectoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ValidateDirectory not implemented")
+}
+func (UnimplementedSystemBridgeServer) Open(context.Context, *OpenRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Open not implemented")
+}
+func (UnimplementedSystemBridgeServer) KeyboardKeypress(context.Context, *KeyboardKeypressRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KeyboardKeypress not implemented")
+}
+func (UnimplementedSystemBridgeServer) KeyboardText(context.Context, *KeyboardTextRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KeyboardText not implemented")
+}
+func (UnimplementedSystemBridgeServer) MediaControl(context.Context, *MediaControlRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MediaControl not implemented")
+}
+func (UnimplementedSystemBridgeServer) Notification(context.Context, *NotificationRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Notification not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerHibernate(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerHibernate not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerLock(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerLock not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerLogout(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerLogout not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerRestart(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerRestart not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerShutdown(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerShutdown not implemented")
+}
+func (UnimplementedSystemBridgeServer) PowerSleep(context.Context, *EmptyRequest) (*ActionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PowerSleep not implemented")
+}
+func (UnimplementedSystemBridgeServer) CommandExecute(context.Context, *CommandExecuteRequest) (*CommandExecuteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommandExecute not implemented")
+}
+func (UnimplementedSystemBridgeServer) mustEmbedUnimplementedSystemBridgeServer() {}
+func (UnimplementedSystemBridgeServer) testEmbeddedByValue()                      {}
+
+// UnsafeSystemBridgeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SystemBridgeServer will
+// result in compilation errors.
+type UnsafeSystemBridgeServer interface {
+	mustEmbedUnimplementedSystemBridgeServer()
+}
+
+func RegisterSystemBridgeServer(s grpc.ServiceRegistrar, srv SystemBridgeServer) {
+	// If the following call panics, it indicates UnimplementedSystemBridgeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SystemBridge_ServiceDesc, srv)
+}
+
+func _SystemBridge_GetData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetData(ctx, req.(*GetDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemBridgeServer).Subscribe(m, &grpc.GenericServerStream[SubscribeRequest, ModuleData]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SystemBridge_SubscribeServer = grpc.ServerStreamingServer[ModuleData]
+
+func _SystemBridge_ExitApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).ExitApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_ExitApplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).ExitApplication(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetSettings(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_GetDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetDirectories(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_GetDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetDirectory(ctx, req.(*GetDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_GetFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetFiles(ctx, req.(*GetFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_ValidateDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).ValidateDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_ValidateDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).ValidateDirectory(ctx, req.(*ValidateDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_Open_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).Open(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_Open_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).Open(ctx, req.(*OpenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_KeyboardKeypress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyboardKeypressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).KeyboardKeypress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_KeyboardKeypress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).KeyboardKeypress(ctx, req.(*KeyboardKeypressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_KeyboardText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyboardTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).KeyboardText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_KeyboardText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).KeyboardText(ctx, req.(*KeyboardTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_MediaControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MediaControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).MediaControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_MediaControl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).MediaControl(ctx, req.(*MediaControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_Notification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).Notification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_Notification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).Notification(ctx, req.(*NotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerHibernate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerHibernate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerHibernate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerHibernate(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerLock(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerLogout(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerRestart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerRestart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerRestart(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerShutdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerShutdown(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_PowerSleep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).PowerSleep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_PowerSleep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).PowerSleep(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemBridge_CommandExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemBridgeServer).CommandExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemBridge_CommandExecute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemBridgeServer).CommandExecute(ctx, req.(*CommandExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SystemBridge_ServiceDesc is the grpc.ServiceDesc for SystemBridge service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SystemBridge_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "systembridge.v1.SystemBridge",
+	HandlerType: (*SystemBridgeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetData",
+			Handler:    _SystemBridge_GetData_Handler,
+		},
+		{
+			MethodName: "ExitApplication",
+			Handler:    _SystemBridge_ExitApplication_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _SystemBridge_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _SystemBridge_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "GetDirectories",
+			Handler:    _SystemBridge_GetDirectories_Handler,
+		},
+		{
+			MethodName: "GetDirectory",
+			Handler:    _SystemBridge_GetDirectory_Handler,
+		},
+		{
+			MethodName: "GetFiles",
+			Handler:    _SystemBridge_GetFiles_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _SystemBridge_GetFile_Handler,
+		},
+		{
+			MethodName: "ValidateDirectory",
+			Handler:    _SystemBridge_ValidateDirectory_Handler,
+		},
+		{
+			MethodName: "Open",
+			Handler:    _SystemBridge_Open_Handler,
+		},
+		{
+			MethodName: "KeyboardKeypress",
+			Handler:    _SystemBridge_KeyboardKeypress_Handler,
+		},
+		{
+			MethodName: "KeyboardText",
+			Handler:    _SystemBridge_KeyboardText_Handler,
+		},
+		{
+			MethodName: "MediaControl",
+			Handler:    _SystemBridge_MediaControl_Handler,
+		},
+		{
+			MethodName: "Notification",
+			Handler:    _SystemBridge_Notification_Handler,
+		},
+		{
+			MethodName: "PowerHibernate",
+			Handler:    _SystemBridge_PowerHibernate_Handler,
+		},
+		{
+			MethodName: "PowerLock",
+			Handler:    _SystemBridge_PowerLock_Handler,
+		},
+		{
+			MethodName: "PowerLogout",
+			Handler:    _SystemBridge_PowerLogout_Handler,
+		},
+		{
+			MethodName: "PowerRestart",
+			Handler:    _SystemBridge_PowerRestart_Handler,
+		},
+		{
+			MethodName: "PowerShutdown",
+			Handler:    _SystemBridge_PowerShutdown_Handler,
+		},
+		{
+			MethodName: "PowerSleep",
+			Handler:    _SystemBridge_PowerSleep_Handler,
+		},
+		{
+			MethodName: "CommandExecute",
+			Handler:    _SystemBridge_CommandExecute_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _SystemBridge_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "systembridge/v1/service.proto",
+}
@@ -0,0 +1,4491 @@
+// Code generated by tools/generate-schemas. DO NOT EDIT.
+// Generated from backend types in types/ directory
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: systembridge/v1/types.proto
+
+package systembridgev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Battery Module
+type BatteryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsCharging    *bool                  `protobuf:"varint,1,opt,name=is_charging,json=isCharging,proto3,oneof" json:"is_charging,omitempty"`
+	Percentage    *float64               `protobuf:"fixed64,2,opt,name=percentage,proto3,oneof" json:"percentage,omitempty"`
+	TimeRemaining *float64               `protobuf:"fixed64,3,opt,name=time_remaining,json=timeRemaining,proto3,oneof" json:"time_remaining,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatteryData) Reset() {
+	*x = BatteryData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatteryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryData) ProtoMessage() {}
+
+func (x *BatteryData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryData.ProtoReflect.Descriptor instead.
+func (*BatteryData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatteryData) GetIsCharging() bool {
+	if x != nil && x.IsCharging != nil {
+		return *x.IsCharging
+	}
+	return false
+}
+
+func (x *BatteryData) GetPercentage() float64 {
+	if x != nil && x.Percentage != nil {
+		return *x.Percentage
+	}
+	return 0
+}
+
+func (x *BatteryData) GetTimeRemaining() float64 {
+	if x != nil && x.TimeRemaining != nil {
+		return *x.TimeRemaining
+	}
+	return 0
+}
+
+// CPU Module
+type CPUData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         *int64                 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Frequency     *CPUFrequency          `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	LoadAverage   *float64               `protobuf:"fixed64,3,opt,name=load_average,json=loadAverage,proto3,oneof" json:"load_average,omitempty"`
+	PerCpu        []*PerCPU              `protobuf:"bytes,4,rep,name=per_cpu,json=perCpu,proto3" json:"per_cpu,omitempty"`
+	Power         *float64               `protobuf:"fixed64,5,opt,name=power,proto3,oneof" json:"power,omitempty"`
+	Stats         *CPUStats              `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	Temperature   *float64               `protobuf:"fixed64,7,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	Times         *CPUTimes              `protobuf:"bytes,8,opt,name=times,proto3" json:"times,omitempty"`
+	TimesPercent  *CPUTimes              `protobuf:"bytes,9,opt,name=times_percent,json=timesPercent,proto3" json:"times_percent,omitempty"`
+	Usage         *float64               `protobuf:"fixed64,10,opt,name=usage,proto3,oneof" json:"usage,omitempty"`
+	Voltage       *float64               `protobuf:"fixed64,11,opt,name=voltage,proto3,oneof" json:"voltage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUData) Reset() {
+	*x = CPUData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUData) ProtoMessage() {}
+
+func (x *CPUData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUData.ProtoReflect.Descriptor instead.
+func (*CPUData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CPUData) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *CPUData) GetFrequency() *CPUFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return nil
+}
+
+func (x *CPUData) GetLoadAverage() float64 {
+	if x != nil && x.LoadAverage != nil {
+		return *x.LoadAverage
+	}
+	return 0
+}
+
+func (x *CPUData) GetPerCpu() []*PerCPU {
+	if x != nil {
+		return x.PerCpu
+	}
+	return nil
+}
+
+func (x *CPUData) GetPower() float64 {
+	if x != nil && x.Power != nil {
+		return *x.Power
+	}
+	return 0
+}
+
+func (x *CPUData) GetStats() *CPUStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *CPUData) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *CPUData) GetTimes() *CPUTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *CPUData) GetTimesPercent() *CPUTimes {
+	if x != nil {
+		return x.TimesPercent
+	}
+	return nil
+}
+
+func (x *CPUData) GetUsage() float64 {
+	if x != nil && x.Usage != nil {
+		return *x.Usage
+	}
+	return 0
+}
+
+func (x *CPUData) GetVoltage() float64 {
+	if x != nil && x.Voltage != nil {
+		return *x.Voltage
+	}
+	return 0
+}
+
+// CPU Frequency
+type CPUFrequency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       *float64               `protobuf:"fixed64,1,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Min           *float64               `protobuf:"fixed64,2,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,3,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUFrequency) Reset() {
+	*x = CPUFrequency{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUFrequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUFrequency) ProtoMessage() {}
+
+func (x *CPUFrequency) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUFrequency.ProtoReflect.Descriptor instead.
+func (*CPUFrequency) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CPUFrequency) GetCurrent() float64 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *CPUFrequency) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// CPU Stats
+type CPUStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CtxSwitches    *int64                 `protobuf:"varint,1,opt,name=ctx_switches,json=ctxSwitches,proto3,oneof" json:"ctx_switches,omitempty"`
+	Interrupts     *int64                 `protobuf:"varint,2,opt,name=interrupts,proto3,oneof" json:"interrupts,omitempty"`
+	SoftInterrupts *int64                 `protobuf:"varint,3,opt,name=soft_interrupts,json=softInterrupts,proto3,oneof" json:"soft_interrupts,omitempty"`
+	Syscalls       *int64                 `protobuf:"varint,4,opt,name=syscalls,proto3,oneof" json:"syscalls,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CPUStats) Reset() {
+	*x = CPUStats{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUStats) ProtoMessage() {}
+
+func (x *CPUStats) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUStats.ProtoReflect.Descriptor instead.
+func (*CPUStats) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CPUStats) GetCtxSwitches() int64 {
+	if x != nil && x.CtxSwitches != nil {
+		return *x.CtxSwitches
+	}
+	return 0
+}
+
+func (x *CPUStats) GetInterrupts() int64 {
+	if x != nil && x.Interrupts != nil {
+		return *x.Interrupts
+	}
+	return 0
+}
+
+func (x *CPUStats) GetSoftInterrupts() int64 {
+	if x != nil && x.SoftInterrupts != nil {
+		return *x.SoftInterrupts
+	}
+	return 0
+}
+
+func (x *CPUStats) GetSyscalls() int64 {
+	if x != nil && x.Syscalls != nil {
+		return *x.Syscalls
+	}
+	return 0
+}
+
+// CPU Times
+type CPUTimes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *float64               `protobuf:"fixed64,1,opt,name=user,proto3,oneof" json:"user,omitempty"`
+	System        *float64               `protobuf:"fixed64,2,opt,name=system,proto3,oneof" json:"system,omitempty"`
+	Idle          *float64               `protobuf:"fixed64,3,opt,name=idle,proto3,oneof" json:"idle,omitempty"`
+	Interrupt     *float64               `protobuf:"fixed64,4,opt,name=interrupt,proto3,oneof" json:"interrupt,omitempty"`
+	Dpc           *float64               `protobuf:"fixed64,5,opt,name=dpc,proto3,oneof" json:"dpc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUTimes) Reset() {
+	*x = CPUTimes{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUTimes) ProtoMessage() {}
+
+func (x *CPUTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUTimes.ProtoReflect.Descriptor instead.
+func (*CPUTimes) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CPUTimes) GetUser() float64 {
+	if x != nil && x.User != nil {
+		return *x.User
+	}
+	return 0
+}
+
+func (x *CPUTimes) GetSystem() float64 {
+	if x != nil && x.System != nil {
+		return *x.System
+	}
+	return 0
+}
+
+func (x *CPUTimes) GetIdle() float64 {
+	if x != nil && x.Idle != nil {
+		return *x.Idle
+	}
+	return 0
+}
+
+func (x *CPUTimes) GetInterrupt() float64 {
+	if x != nil && x.Interrupt != nil {
+		return *x.Interrupt
+	}
+	return 0
+}
+
+func (x *CPUTimes) GetDpc() float64 {
+	if x != nil && x.Dpc != nil {
+		return *x.Dpc
+	}
+	return 0
+}
+
+// Disk
+type Disk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Partitions    []*DiskPartition       `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	IoCounters    *DiskIOCounters        `protobuf:"bytes,3,opt,name=io_counters,json=ioCounters,proto3" json:"io_counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Disk) Reset() {
+	*x = Disk{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Disk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disk) ProtoMessage() {}
+
+func (x *Disk) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disk.ProtoReflect.Descriptor instead.
+func (*Disk) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Disk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Disk) GetPartitions() []*DiskPartition {
+	if x != nil {
+		return x.Partitions
+	}
+	return nil
+}
+
+func (x *Disk) GetIoCounters() *DiskIOCounters {
+	if x != nil {
+		return x.IoCounters
+	}
+	return nil
+}
+
+// Disk IO Counters
+type DiskIOCounters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadCount     uint64                 `protobuf:"varint,1,opt,name=read_count,json=readCount,proto3" json:"read_count,omitempty"`
+	WriteCount    uint64                 `protobuf:"varint,2,opt,name=write_count,json=writeCount,proto3" json:"write_count,omitempty"`
+	ReadBytes     uint64                 `protobuf:"varint,3,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes    uint64                 `protobuf:"varint,4,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadTime      uint64                 `protobuf:"varint,5,opt,name=read_time,json=readTime,proto3" json:"read_time,omitempty"`
+	WriteTime     uint64                 `protobuf:"varint,6,opt,name=write_time,json=writeTime,proto3" json:"write_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskIOCounters) Reset() {
+	*x = DiskIOCounters{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskIOCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIOCounters) ProtoMessage() {}
+
+func (x *DiskIOCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIOCounters.ProtoReflect.Descriptor instead.
+func (*DiskIOCounters) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiskIOCounters) GetReadCount() uint64 {
+	if x != nil {
+		return x.ReadCount
+	}
+	return 0
+}
+
+func (x *DiskIOCounters) GetWriteCount() uint64 {
+	if x != nil {
+		return x.WriteCount
+	}
+	return 0
+}
+
+func (x *DiskIOCounters) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *DiskIOCounters) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *DiskIOCounters) GetReadTime() uint64 {
+	if x != nil {
+		return x.ReadTime
+	}
+	return 0
+}
+
+func (x *DiskIOCounters) GetWriteTime() uint64 {
+	if x != nil {
+		return x.WriteTime
+	}
+	return 0
+}
+
+// Disk Partition
+type DiskPartition struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Device         string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	MountPoint     string                 `protobuf:"bytes,2,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	FilesystemType string                 `protobuf:"bytes,3,opt,name=filesystem_type,json=filesystemType,proto3" json:"filesystem_type,omitempty"`
+	Options        string                 `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	MaxFileSize    int64                  `protobuf:"varint,5,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	MaxPathLength  int64                  `protobuf:"varint,6,opt,name=max_path_length,json=maxPathLength,proto3" json:"max_path_length,omitempty"`
+	Usage          *DiskUsage             `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DiskPartition) Reset() {
+	*x = DiskPartition{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskPartition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskPartition) ProtoMessage() {}
+
+func (x *DiskPartition) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskPartition.ProtoReflect.Descriptor instead.
+func (*DiskPartition) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiskPartition) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetFilesystemType() string {
+	if x != nil {
+		return x.FilesystemType
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *DiskPartition) GetMaxPathLength() int64 {
+	if x != nil {
+		return x.MaxPathLength
+	}
+	return 0
+}
+
+func (x *DiskPartition) GetUsage() *DiskUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// Disk Usage
+type DiskUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         uint64                 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used          uint64                 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Free          uint64                 `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	Percent       float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DiskUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+// Disks Module
+type DisksData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Disk                `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	IoCounters    *DiskIOCounters        `protobuf:"bytes,2,opt,name=io_counters,json=ioCounters,proto3" json:"io_counters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisksData) Reset() {
+	*x = DisksData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisksData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisksData) ProtoMessage() {}
+
+func (x *DisksData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisksData.ProtoReflect.Descriptor instead.
+func (*DisksData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DisksData) GetDevices() []*Disk {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *DisksData) GetIoCounters() *DiskIOCounters {
+	if x != nil {
+		return x.IoCounters
+	}
+	return nil
+}
+
+// Display
+type Display struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ResolutionHorizontal int64                  `protobuf:"varint,3,opt,name=resolution_horizontal,json=resolutionHorizontal,proto3" json:"resolution_horizontal,omitempty"`
+	ResolutionVertical   int64                  `protobuf:"varint,4,opt,name=resolution_vertical,json=resolutionVertical,proto3" json:"resolution_vertical,omitempty"`
+	X                    int64                  `protobuf:"varint,5,opt,name=x,proto3" json:"x,omitempty"`
+	Y                    int64                  `protobuf:"varint,6,opt,name=y,proto3" json:"y,omitempty"`
+	Width                *int64                 `protobuf:"varint,7,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Height               *int64                 `protobuf:"varint,8,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	IsPrimary            *bool                  `protobuf:"varint,9,opt,name=is_primary,json=isPrimary,proto3,oneof" json:"is_primary,omitempty"`
+	PixelClock           *float64               `protobuf:"fixed64,10,opt,name=pixel_clock,json=pixelClock,proto3,oneof" json:"pixel_clock,omitempty"`
+	RefreshRate          *float64               `protobuf:"fixed64,11,opt,name=refresh_rate,json=refreshRate,proto3,oneof" json:"refresh_rate,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Display) Reset() {
+	*x = Display{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Display) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Display) ProtoMessage() {}
+
+func (x *Display) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Display.ProtoReflect.Descriptor instead.
+func (*Display) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Display) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Display) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Display) GetResolutionHorizontal() int64 {
+	if x != nil {
+		return x.ResolutionHorizontal
+	}
+	return 0
+}
+
+func (x *Display) GetResolutionVertical() int64 {
+	if x != nil {
+		return x.ResolutionVertical
+	}
+	return 0
+}
+
+func (x *Display) GetX() int64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Display) GetY() int64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *Display) GetWidth() int64 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *Display) GetHeight() int64 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *Display) GetIsPrimary() bool {
+	if x != nil && x.IsPrimary != nil {
+		return *x.IsPrimary
+	}
+	return false
+}
+
+func (x *Display) GetPixelClock() float64 {
+	if x != nil && x.PixelClock != nil {
+		return *x.PixelClock
+	}
+	return 0
+}
+
+func (x *Display) GetRefreshRate() float64 {
+	if x != nil && x.RefreshRate != nil {
+		return *x.RefreshRate
+	}
+	return 0
+}
+
+// Displays Module
+type DisplaysData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Display             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisplaysData) Reset() {
+	*x = DisplaysData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisplaysData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisplaysData) ProtoMessage() {}
+
+func (x *DisplaysData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisplaysData.ProtoReflect.Descriptor instead.
+func (*DisplaysData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DisplaysData) GetItems() []*Display {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GPU
+type GPU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CoreClock     *float64               `protobuf:"fixed64,3,opt,name=core_clock,json=coreClock,proto3,oneof" json:"core_clock,omitempty"`
+	CoreLoad      *float64               `protobuf:"fixed64,4,opt,name=core_load,json=coreLoad,proto3,oneof" json:"core_load,omitempty"`
+	FanSpeed      *float64               `protobuf:"fixed64,5,opt,name=fan_speed,json=fanSpeed,proto3,oneof" json:"fan_speed,omitempty"`
+	MemoryClock   *float64               `protobuf:"fixed64,6,opt,name=memory_clock,json=memoryClock,proto3,oneof" json:"memory_clock,omitempty"`
+	MemoryLoad    *float64               `protobuf:"fixed64,7,opt,name=memory_load,json=memoryLoad,proto3,oneof" json:"memory_load,omitempty"`
+	MemoryFree    *float64               `protobuf:"fixed64,8,opt,name=memory_free,json=memoryFree,proto3,oneof" json:"memory_free,omitempty"`
+	MemoryUsed    *float64               `protobuf:"fixed64,9,opt,name=memory_used,json=memoryUsed,proto3,oneof" json:"memory_used,omitempty"`
+	MemoryTotal   *float64               `protobuf:"fixed64,10,opt,name=memory_total,json=memoryTotal,proto3,oneof" json:"memory_total,omitempty"`
+	PowerUsage    *float64               `protobuf:"fixed64,11,opt,name=power_usage,json=powerUsage,proto3,oneof" json:"power_usage,omitempty"`
+	Temperature   *float64               `protobuf:"fixed64,12,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPU) Reset() {
+	*x = GPU{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPU) ProtoMessage() {}
+
+func (x *GPU) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPU.ProtoReflect.Descriptor instead.
+func (*GPU) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GPU) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GPU) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GPU) GetCoreClock() float64 {
+	if x != nil && x.CoreClock != nil {
+		return *x.CoreClock
+	}
+	return 0
+}
+
+func (x *GPU) GetCoreLoad() float64 {
+	if x != nil && x.CoreLoad != nil {
+		return *x.CoreLoad
+	}
+	return 0
+}
+
+func (x *GPU) GetFanSpeed() float64 {
+	if x != nil && x.FanSpeed != nil {
+		return *x.FanSpeed
+	}
+	return 0
+}
+
+func (x *GPU) GetMemoryClock() float64 {
+	if x != nil && x.MemoryClock != nil {
+		return *x.MemoryClock
+	}
+	return 0
+}
+
+func (x *GPU) GetMemoryLoad() float64 {
+	if x != nil && x.MemoryLoad != nil {
+		return *x.MemoryLoad
+	}
+	return 0
+}
+
+func (x *GPU) GetMemoryFree() float64 {
+	if x != nil && x.MemoryFree != nil {
+		return *x.MemoryFree
+	}
+	return 0
+}
+
+func (x *GPU) GetMemoryUsed() float64 {
+	if x != nil && x.MemoryUsed != nil {
+		return *x.MemoryUsed
+	}
+	return 0
+}
+
+func (x *GPU) GetMemoryTotal() float64 {
+	if x != nil && x.MemoryTotal != nil {
+		return *x.MemoryTotal
+	}
+	return 0
+}
+
+func (x *GPU) GetPowerUsage() float64 {
+	if x != nil && x.PowerUsage != nil {
+		return *x.PowerUsage
+	}
+	return 0
+}
+
+func (x *GPU) GetTemperature() float64 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+// GPUs Module
+type GPUsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*GPU                 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GPUsData) Reset() {
+	*x = GPUsData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GPUsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GPUsData) ProtoMessage() {}
+
+func (x *GPUsData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GPUsData.ProtoReflect.Descriptor instead.
+func (*GPUsData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GPUsData) GetItems() []*GPU {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Media Module
+type MediaData struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AlbumArtist          *string                `protobuf:"bytes,1,opt,name=album_artist,json=albumArtist,proto3,oneof" json:"album_artist,omitempty"`
+	AlbumTitle           *string                `protobuf:"bytes,2,opt,name=album_title,json=albumTitle,proto3,oneof" json:"album_title,omitempty"`
+	Artist               *string                `protobuf:"bytes,3,opt,name=artist,proto3,oneof" json:"artist,omitempty"`
+	Duration             *float64               `protobuf:"fixed64,4,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	IsFastForwardEnabled *bool                  `protobuf:"varint,5,opt,name=is_fast_forward_enabled,json=isFastForwardEnabled,proto3,oneof" json:"is_fast_forward_enabled,omitempty"`
+	IsNextEnabled        *bool                  `protobuf:"varint,6,opt,name=is_next_enabled,json=isNextEnabled,proto3,oneof" json:"is_next_enabled,omitempty"`
+	IsPauseEnabled       *bool                  `protobuf:"varint,7,opt,name=is_pause_enabled,json=isPauseEnabled,proto3,oneof" json:"is_pause_enabled,omitempty"`
+	IsPlayEnabled        *bool                  `protobuf:"varint,8,opt,name=is_play_enabled,json=isPlayEnabled,proto3,oneof" json:"is_play_enabled,omitempty"`
+	IsPreviousEnabled    *bool                  `protobuf:"varint,9,opt,name=is_previous_enabled,json=isPreviousEnabled,proto3,oneof" json:"is_previous_enabled,omitempty"`
+	IsRewindEnabled      *bool                  `protobuf:"varint,10,opt,name=is_rewind_enabled,json=isRewindEnabled,proto3,oneof" json:"is_rewind_enabled,omitempty"`
+	IsStopEnabled        *bool                  `protobuf:"varint,11,opt,name=is_stop_enabled,json=isStopEnabled,proto3,oneof" json:"is_stop_enabled,omitempty"`
+	PlaybackRate         *float64               `protobuf:"fixed64,12,opt,name=playback_rate,json=playbackRate,proto3,oneof" json:"playback_rate,omitempty"`
+	Position             *float64               `protobuf:"fixed64,13,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Repeat               *string                `protobuf:"bytes,14,opt,name=repeat,proto3,oneof" json:"repeat,omitempty"`
+	Shuffle              *bool                  `protobuf:"varint,15,opt,name=shuffle,proto3,oneof" json:"shuffle,omitempty"`
+	Status               *string                `protobuf:"bytes,16,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Subtitle             *string                `protobuf:"bytes,17,opt,name=subtitle,proto3,oneof" json:"subtitle,omitempty"`
+	Thumbnail            *string                `protobuf:"bytes,18,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	Title                *string                `protobuf:"bytes,19,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	TrackNumber          *int64                 `protobuf:"varint,20,opt,name=track_number,json=trackNumber,proto3,oneof" json:"track_number,omitempty"`
+	Type                 *string                `protobuf:"bytes,21,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	UpdatedAt            *float64               `protobuf:"fixed64,22,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	Volume               *float64               `protobuf:"fixed64,23,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Player               *string                `protobuf:"bytes,24,opt,name=player,proto3,oneof" json:"player,omitempty"`
+	Players              []*MediaPlayer         `protobuf:"bytes,25,rep,name=players,proto3" json:"players,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MediaData) Reset() {
+	*x = MediaData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaData) ProtoMessage() {}
+
+func (x *MediaData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaData.ProtoReflect.Descriptor instead.
+func (*MediaData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MediaData) GetAlbumArtist() string {
+	if x != nil && x.AlbumArtist != nil {
+		return *x.AlbumArtist
+	}
+	return ""
+}
+
+func (x *MediaData) GetAlbumTitle() string {
+	if x != nil && x.AlbumTitle != nil {
+		return *x.AlbumTitle
+	}
+	return ""
+}
+
+func (x *MediaData) GetArtist() string {
+	if x != nil && x.Artist != nil {
+		return *x.Artist
+	}
+	return ""
+}
+
+func (x *MediaData) GetDuration() float64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *MediaData) GetIsFastForwardEnabled() bool {
+	if x != nil && x.IsFastForwardEnabled != nil {
+		return *x.IsFastForwardEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsNextEnabled() bool {
+	if x != nil && x.IsNextEnabled != nil {
+		return *x.IsNextEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsPauseEnabled() bool {
+	if x != nil && x.IsPauseEnabled != nil {
+		return *x.IsPauseEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsPlayEnabled() bool {
+	if x != nil && x.IsPlayEnabled != nil {
+		return *x.IsPlayEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsPreviousEnabled() bool {
+	if x != nil && x.IsPreviousEnabled != nil {
+		return *x.IsPreviousEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsRewindEnabled() bool {
+	if x != nil && x.IsRewindEnabled != nil {
+		return *x.IsRewindEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetIsStopEnabled() bool {
+	if x != nil && x.IsStopEnabled != nil {
+		return *x.IsStopEnabled
+	}
+	return false
+}
+
+func (x *MediaData) GetPlaybackRate() float64 {
+	if x != nil && x.PlaybackRate != nil {
+		return *x.PlaybackRate
+	}
+	return 0
+}
+
+func (x *MediaData) GetPosition() float64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *MediaData) GetRepeat() string {
+	if x != nil && x.Repeat != nil {
+		return *x.Repeat
+	}
+	return ""
+}
+
+func (x *MediaData) GetShuffle() bool {
+	if x != nil && x.Shuffle != nil {
+		return *x.Shuffle
+	}
+	return false
+}
+
+func (x *MediaData) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *MediaData) GetSubtitle() string {
+	if x != nil && x.Subtitle != nil {
+		return *x.Subtitle
+	}
+	return ""
+}
+
+func (x *MediaData) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+func (x *MediaData) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *MediaData) GetTrackNumber() int64 {
+	if x != nil && x.TrackNumber != nil {
+		return *x.TrackNumber
+	}
+	return 0
+}
+
+func (x *MediaData) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *MediaData) GetUpdatedAt() float64 {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *MediaData) GetVolume() float64 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+func (x *MediaData) GetPlayer() string {
+	if x != nil && x.Player != nil {
+		return *x.Player
+	}
+	return ""
+}
+
+func (x *MediaData) GetPlayers() []*MediaPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+// Media Player
+type MediaPlayer struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsActive             bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	AlbumArtist          *string                `protobuf:"bytes,3,opt,name=album_artist,json=albumArtist,proto3,oneof" json:"album_artist,omitempty"`
+	AlbumTitle           *string                `protobuf:"bytes,4,opt,name=album_title,json=albumTitle,proto3,oneof" json:"album_title,omitempty"`
+	Artist               *string                `protobuf:"bytes,5,opt,name=artist,proto3,oneof" json:"artist,omitempty"`
+	Duration             *float64               `protobuf:"fixed64,6,opt,name=duration,proto3,oneof" json:"duration,omitempty"`
+	IsFastForwardEnabled *bool                  `protobuf:"varint,7,opt,name=is_fast_forward_enabled,json=isFastForwardEnabled,proto3,oneof" json:"is_fast_forward_enabled,omitempty"`
+	IsNextEnabled        *bool                  `protobuf:"varint,8,opt,name=is_next_enabled,json=isNextEnabled,proto3,oneof" json:"is_next_enabled,omitempty"`
+	IsPauseEnabled       *bool                  `protobuf:"varint,9,opt,name=is_pause_enabled,json=isPauseEnabled,proto3,oneof" json:"is_pause_enabled,omitempty"`
+	IsPlayEnabled        *bool                  `protobuf:"varint,10,opt,name=is_play_enabled,json=isPlayEnabled,proto3,oneof" json:"is_play_enabled,omitempty"`
+	IsPreviousEnabled    *bool                  `protobuf:"varint,11,opt,name=is_previous_enabled,json=isPreviousEnabled,proto3,oneof" json:"is_previous_enabled,omitempty"`
+	IsRewindEnabled      *bool                  `protobuf:"varint,12,opt,name=is_rewind_enabled,json=isRewindEnabled,proto3,oneof" json:"is_rewind_enabled,omitempty"`
+	IsStopEnabled        *bool                  `protobuf:"varint,13,opt,name=is_stop_enabled,json=isStopEnabled,proto3,oneof" json:"is_stop_enabled,omitempty"`
+	PlaybackRate         *float64               `protobuf:"fixed64,14,opt,name=playback_rate,json=playbackRate,proto3,oneof" json:"playback_rate,omitempty"`
+	Position             *float64               `protobuf:"fixed64,15,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	Repeat               *string                `protobuf:"bytes,16,opt,name=repeat,proto3,oneof" json:"repeat,omitempty"`
+	Shuffle              *bool                  `protobuf:"varint,17,opt,name=shuffle,proto3,oneof" json:"shuffle,omitempty"`
+	Status               *string                `protobuf:"bytes,18,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Subtitle             *string                `protobuf:"bytes,19,opt,name=subtitle,proto3,oneof" json:"subtitle,omitempty"`
+	Thumbnail            *string                `protobuf:"bytes,20,opt,name=thumbnail,proto3,oneof" json:"thumbnail,omitempty"`
+	Title                *string                `protobuf:"bytes,21,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	TrackNumber          *int64                 `protobuf:"varint,22,opt,name=track_number,json=trackNumber,proto3,oneof" json:"track_number,omitempty"`
+	Type                 *string                `protobuf:"bytes,23,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Volume               *float64               `protobuf:"fixed64,24,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MediaPlayer) Reset() {
+	*x = MediaPlayer{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaPlayer) ProtoMessage() {}
+
+func (x *MediaPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaPlayer.ProtoReflect.Descriptor instead.
+func (*MediaPlayer) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MediaPlayer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetAlbumArtist() string {
+	if x != nil && x.AlbumArtist != nil {
+		return *x.AlbumArtist
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetAlbumTitle() string {
+	if x != nil && x.AlbumTitle != nil {
+		return *x.AlbumTitle
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetArtist() string {
+	if x != nil && x.Artist != nil {
+		return *x.Artist
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetDuration() float64 {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return 0
+}
+
+func (x *MediaPlayer) GetIsFastForwardEnabled() bool {
+	if x != nil && x.IsFastForwardEnabled != nil {
+		return *x.IsFastForwardEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsNextEnabled() bool {
+	if x != nil && x.IsNextEnabled != nil {
+		return *x.IsNextEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsPauseEnabled() bool {
+	if x != nil && x.IsPauseEnabled != nil {
+		return *x.IsPauseEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsPlayEnabled() bool {
+	if x != nil && x.IsPlayEnabled != nil {
+		return *x.IsPlayEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsPreviousEnabled() bool {
+	if x != nil && x.IsPreviousEnabled != nil {
+		return *x.IsPreviousEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsRewindEnabled() bool {
+	if x != nil && x.IsRewindEnabled != nil {
+		return *x.IsRewindEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetIsStopEnabled() bool {
+	if x != nil && x.IsStopEnabled != nil {
+		return *x.IsStopEnabled
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetPlaybackRate() float64 {
+	if x != nil && x.PlaybackRate != nil {
+		return *x.PlaybackRate
+	}
+	return 0
+}
+
+func (x *MediaPlayer) GetPosition() float64 {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return 0
+}
+
+func (x *MediaPlayer) GetRepeat() string {
+	if x != nil && x.Repeat != nil {
+		return *x.Repeat
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetShuffle() bool {
+	if x != nil && x.Shuffle != nil {
+		return *x.Shuffle
+	}
+	return false
+}
+
+func (x *MediaPlayer) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetSubtitle() string {
+	if x != nil && x.Subtitle != nil {
+		return *x.Subtitle
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetThumbnail() string {
+	if x != nil && x.Thumbnail != nil {
+		return *x.Thumbnail
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetTrackNumber() int64 {
+	if x != nil && x.TrackNumber != nil {
+		return *x.TrackNumber
+	}
+	return 0
+}
+
+func (x *MediaPlayer) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *MediaPlayer) GetVolume() float64 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
+	}
+	return 0
+}
+
+// Memory Module
+type MemoryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Swap          *MemorySwap            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	Virtual       *MemoryVirtual         `protobuf:"bytes,2,opt,name=virtual,proto3" json:"virtual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryData) Reset() {
+	*x = MemoryData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryData) ProtoMessage() {}
+
+func (x *MemoryData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryData.ProtoReflect.Descriptor instead.
+func (*MemoryData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MemoryData) GetSwap() *MemorySwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *MemoryData) GetVirtual() *MemoryVirtual {
+	if x != nil {
+		return x.Virtual
+	}
+	return nil
+}
+
+// Memory Swap
+type MemorySwap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         *uint64                `protobuf:"varint,1,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Used          *uint64                `protobuf:"varint,2,opt,name=used,proto3,oneof" json:"used,omitempty"`
+	Free          *uint64                `protobuf:"varint,3,opt,name=free,proto3,oneof" json:"free,omitempty"`
+	Percent       *float64               `protobuf:"fixed64,4,opt,name=percent,proto3,oneof" json:"percent,omitempty"`
+	Sin           *uint64                `protobuf:"varint,5,opt,name=sin,proto3,oneof" json:"sin,omitempty"`
+	Sout          *uint64                `protobuf:"varint,6,opt,name=sout,proto3,oneof" json:"sout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemorySwap) Reset() {
+	*x = MemorySwap{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemorySwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemorySwap) ProtoMessage() {}
+
+func (x *MemorySwap) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemorySwap.ProtoReflect.Descriptor instead.
+func (*MemorySwap) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MemorySwap) GetTotal() uint64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *MemorySwap) GetUsed() uint64 {
+	if x != nil && x.Used != nil {
+		return *x.Used
+	}
+	return 0
+}
+
+func (x *MemorySwap) GetFree() uint64 {
+	if x != nil && x.Free != nil {
+		return *x.Free
+	}
+	return 0
+}
+
+func (x *MemorySwap) GetPercent() float64 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *MemorySwap) GetSin() uint64 {
+	if x != nil && x.Sin != nil {
+		return *x.Sin
+	}
+	return 0
+}
+
+func (x *MemorySwap) GetSout() uint64 {
+	if x != nil && x.Sout != nil {
+		return *x.Sout
+	}
+	return 0
+}
+
+// Memory Virtual
+type MemoryVirtual struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         *uint64                `protobuf:"varint,1,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	Available     *uint64                `protobuf:"varint,2,opt,name=available,proto3,oneof" json:"available,omitempty"`
+	Percent       *float64               `protobuf:"fixed64,3,opt,name=percent,proto3,oneof" json:"percent,omitempty"`
+	Used          *uint64                `protobuf:"varint,4,opt,name=used,proto3,oneof" json:"used,omitempty"`
+	Free          *uint64                `protobuf:"varint,5,opt,name=free,proto3,oneof" json:"free,omitempty"`
+	Active        *uint64                `protobuf:"varint,6,opt,name=active,proto3,oneof" json:"active,omitempty"`
+	Inactive      *uint64                `protobuf:"varint,7,opt,name=inactive,proto3,oneof" json:"inactive,omitempty"`
+	Buffers       *uint64                `protobuf:"varint,8,opt,name=buffers,proto3,oneof" json:"buffers,omitempty"`
+	Cached        *uint64                `protobuf:"varint,9,opt,name=cached,proto3,oneof" json:"cached,omitempty"`
+	Wired         *uint64                `protobuf:"varint,10,opt,name=wired,proto3,oneof" json:"wired,omitempty"`
+	Shared        *uint64                `protobuf:"varint,11,opt,name=shared,proto3,oneof" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryVirtual) Reset() {
+	*x = MemoryVirtual{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryVirtual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryVirtual) ProtoMessage() {}
+
+func (x *MemoryVirtual) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryVirtual.ProtoReflect.Descriptor instead.
+func (*MemoryVirtual) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MemoryVirtual) GetTotal() uint64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetAvailable() uint64 {
+	if x != nil && x.Available != nil {
+		return *x.Available
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetPercent() float64 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetUsed() uint64 {
+	if x != nil && x.Used != nil {
+		return *x.Used
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetFree() uint64 {
+	if x != nil && x.Free != nil {
+		return *x.Free
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetActive() uint64 {
+	if x != nil && x.Active != nil {
+		return *x.Active
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetInactive() uint64 {
+	if x != nil && x.Inactive != nil {
+		return *x.Inactive
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetBuffers() uint64 {
+	if x != nil && x.Buffers != nil {
+		return *x.Buffers
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetCached() uint64 {
+	if x != nil && x.Cached != nil {
+		return *x.Cached
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetWired() uint64 {
+	if x != nil && x.Wired != nil {
+		return *x.Wired
+	}
+	return 0
+}
+
+func (x *MemoryVirtual) GetShared() uint64 {
+	if x != nil && x.Shared != nil {
+		return *x.Shared
+	}
+	return 0
+}
+
+// Module
+type Module struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Module        string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Data          *structpb.Value        `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Updated       string                 `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Module) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *Module) GetData() *structpb.Value {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Module) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+// Network
+type Network struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Addresses     []*NetworkAddress      `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Stats         *NetworkStats          `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Network) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Network) GetAddresses() []*NetworkAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Network) GetStats() *NetworkStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Network Address
+type NetworkAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *string                `protobuf:"bytes,1,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	Family        *string                `protobuf:"bytes,2,opt,name=family,proto3,oneof" json:"family,omitempty"`
+	Netmask       *string                `protobuf:"bytes,3,opt,name=netmask,proto3,oneof" json:"netmask,omitempty"`
+	Broadcast     *string                `protobuf:"bytes,4,opt,name=broadcast,proto3,oneof" json:"broadcast,omitempty"`
+	Ptp           *string                `protobuf:"bytes,5,opt,name=ptp,proto3,oneof" json:"ptp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkAddress) Reset() {
+	*x = NetworkAddress{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkAddress) ProtoMessage() {}
+
+func (x *NetworkAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkAddress.ProtoReflect.Descriptor instead.
+func (*NetworkAddress) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NetworkAddress) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *NetworkAddress) GetFamily() string {
+	if x != nil && x.Family != nil {
+		return *x.Family
+	}
+	return ""
+}
+
+func (x *NetworkAddress) GetNetmask() string {
+	if x != nil && x.Netmask != nil {
+		return *x.Netmask
+	}
+	return ""
+}
+
+func (x *NetworkAddress) GetBroadcast() string {
+	if x != nil && x.Broadcast != nil {
+		return *x.Broadcast
+	}
+	return ""
+}
+
+func (x *NetworkAddress) GetPtp() string {
+	if x != nil && x.Ptp != nil {
+		return *x.Ptp
+	}
+	return ""
+}
+
+// Network Connection
+type NetworkConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fd            *int64                 `protobuf:"varint,1,opt,name=fd,proto3,oneof" json:"fd,omitempty"`
+	Family        *int64                 `protobuf:"varint,2,opt,name=family,proto3,oneof" json:"family,omitempty"`
+	Type          *int64                 `protobuf:"varint,3,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Laddr         *string                `protobuf:"bytes,4,opt,name=laddr,proto3,oneof" json:"laddr,omitempty"`
+	Raddr         *string                `protobuf:"bytes,5,opt,name=raddr,proto3,oneof" json:"raddr,omitempty"`
+	Status        *string                `protobuf:"bytes,6,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Pid           *int64                 `protobuf:"varint,7,opt,name=pid,proto3,oneof" json:"pid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConnection) Reset() {
+	*x = NetworkConnection{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConnection) ProtoMessage() {}
+
+func (x *NetworkConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConnection.ProtoReflect.Descriptor instead.
+func (*NetworkConnection) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NetworkConnection) GetFd() int64 {
+	if x != nil && x.Fd != nil {
+		return *x.Fd
+	}
+	return 0
+}
+
+func (x *NetworkConnection) GetFamily() int64 {
+	if x != nil && x.Family != nil {
+		return *x.Family
+	}
+	return 0
+}
+
+func (x *NetworkConnection) GetType() int64 {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return 0
+}
+
+func (x *NetworkConnection) GetLaddr() string {
+	if x != nil && x.Laddr != nil {
+		return *x.Laddr
+	}
+	return ""
+}
+
+func (x *NetworkConnection) GetRaddr() string {
+	if x != nil && x.Raddr != nil {
+		return *x.Raddr
+	}
+	return ""
+}
+
+func (x *NetworkConnection) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *NetworkConnection) GetPid() int64 {
+	if x != nil && x.Pid != nil {
+		return *x.Pid
+	}
+	return 0
+}
+
+// Network IO
+type NetworkIO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BytesSent     *int64                 `protobuf:"varint,1,opt,name=bytes_sent,json=bytesSent,proto3,oneof" json:"bytes_sent,omitempty"`
+	BytesRecv     *int64                 `protobuf:"varint,2,opt,name=bytes_recv,json=bytesRecv,proto3,oneof" json:"bytes_recv,omitempty"`
+	PacketsSent   *int64                 `protobuf:"varint,3,opt,name=packets_sent,json=packetsSent,proto3,oneof" json:"packets_sent,omitempty"`
+	PacketsRecv   *int64                 `protobuf:"varint,4,opt,name=packets_recv,json=packetsRecv,proto3,oneof" json:"packets_recv,omitempty"`
+	Errin         *int64                 `protobuf:"varint,5,opt,name=errin,proto3,oneof" json:"errin,omitempty"`
+	Errout        *int64                 `protobuf:"varint,6,opt,name=errout,proto3,oneof" json:"errout,omitempty"`
+	Dropin        *int64                 `protobuf:"varint,7,opt,name=dropin,proto3,oneof" json:"dropin,omitempty"`
+	Dropout       *int64                 `protobuf:"varint,8,opt,name=dropout,proto3,oneof" json:"dropout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkIO) Reset() {
+	*x = NetworkIO{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkIO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkIO) ProtoMessage() {}
+
+func (x *NetworkIO) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkIO.ProtoReflect.Descriptor instead.
+func (*NetworkIO) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NetworkIO) GetBytesSent() int64 {
+	if x != nil && x.BytesSent != nil {
+		return *x.BytesSent
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetBytesRecv() int64 {
+	if x != nil && x.BytesRecv != nil {
+		return *x.BytesRecv
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetPacketsSent() int64 {
+	if x != nil && x.PacketsSent != nil {
+		return *x.PacketsSent
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetPacketsRecv() int64 {
+	if x != nil && x.PacketsRecv != nil {
+		return *x.PacketsRecv
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetErrin() int64 {
+	if x != nil && x.Errin != nil {
+		return *x.Errin
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetErrout() int64 {
+	if x != nil && x.Errout != nil {
+		return *x.Errout
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetDropin() int64 {
+	if x != nil && x.Dropin != nil {
+		return *x.Dropin
+	}
+	return 0
+}
+
+func (x *NetworkIO) GetDropout() int64 {
+	if x != nil && x.Dropout != nil {
+		return *x.Dropout
+	}
+	return 0
+}
+
+// Network Stats
+type NetworkStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Isup          *bool                  `protobuf:"varint,1,opt,name=isup,proto3,oneof" json:"isup,omitempty"`
+	Duplex        *string                `protobuf:"bytes,2,opt,name=duplex,proto3,oneof" json:"duplex,omitempty"`
+	Speed         *int64                 `protobuf:"varint,3,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	Mtu           *int64                 `protobuf:"varint,4,opt,name=mtu,proto3,oneof" json:"mtu,omitempty"`
+	Flags         []string               `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkStats) Reset() {
+	*x = NetworkStats{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStats) ProtoMessage() {}
+
+func (x *NetworkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStats.ProtoReflect.Descriptor instead.
+func (*NetworkStats) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *NetworkStats) GetIsup() bool {
+	if x != nil && x.Isup != nil {
+		return *x.Isup
+	}
+	return false
+}
+
+func (x *NetworkStats) GetDuplex() string {
+	if x != nil && x.Duplex != nil {
+		return *x.Duplex
+	}
+	return ""
+}
+
+func (x *NetworkStats) GetSpeed() int64 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *NetworkStats) GetMtu() int64 {
+	if x != nil && x.Mtu != nil {
+		return *x.Mtu
+	}
+	return 0
+}
+
+func (x *NetworkStats) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// Networks Module
+type NetworksData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*NetworkConnection   `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	Io            *NetworkIO             `protobuf:"bytes,2,opt,name=io,proto3" json:"io,omitempty"`
+	Networks      []*Network             `protobuf:"bytes,3,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworksData) Reset() {
+	*x = NetworksData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworksData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworksData) ProtoMessage() {}
+
+func (x *NetworksData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworksData.ProtoReflect.Descriptor instead.
+func (*NetworksData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NetworksData) GetConnections() []*NetworkConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *NetworksData) GetIo() *NetworkIO {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+func (x *NetworksData) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+// Per-CPU Data
+type PerCPU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Frequency     *CPUFrequency          `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Power         *float64               `protobuf:"fixed64,3,opt,name=power,proto3,oneof" json:"power,omitempty"`
+	Times         *CPUTimes              `protobuf:"bytes,4,opt,name=times,proto3" json:"times,omitempty"`
+	TimesPercent  *CPUTimes              `protobuf:"bytes,5,opt,name=times_percent,json=timesPercent,proto3" json:"times_percent,omitempty"`
+	Usage         *float64               `protobuf:"fixed64,6,opt,name=usage,proto3,oneof" json:"usage,omitempty"`
+	Voltage       *float64               `protobuf:"fixed64,7,opt,name=voltage,proto3,oneof" json:"voltage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PerCPU) Reset() {
+	*x = PerCPU{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PerCPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerCPU) ProtoMessage() {}
+
+func (x *PerCPU) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerCPU.ProtoReflect.Descriptor instead.
+func (*PerCPU) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PerCPU) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PerCPU) GetFrequency() *CPUFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return nil
+}
+
+func (x *PerCPU) GetPower() float64 {
+	if x != nil && x.Power != nil {
+		return *x.Power
+	}
+	return 0
+}
+
+func (x *PerCPU) GetTimes() *CPUTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *PerCPU) GetTimesPercent() *CPUTimes {
+	if x != nil {
+		return x.TimesPercent
+	}
+	return nil
+}
+
+func (x *PerCPU) GetUsage() float64 {
+	if x != nil && x.Usage != nil {
+		return *x.Usage
+	}
+	return 0
+}
+
+func (x *PerCPU) GetVoltage() float64 {
+	if x != nil && x.Voltage != nil {
+		return *x.Voltage
+	}
+	return 0
+}
+
+// Process
+type Process struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               float64                `protobuf:"fixed64,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	CpuUsage         *float64               `protobuf:"fixed64,3,opt,name=cpu_usage,json=cpuUsage,proto3,oneof" json:"cpu_usage,omitempty"`
+	Created          *float64               `protobuf:"fixed64,4,opt,name=created,proto3,oneof" json:"created,omitempty"`
+	MemoryUsage      *float64               `protobuf:"fixed64,5,opt,name=memory_usage,json=memoryUsage,proto3,oneof" json:"memory_usage,omitempty"`
+	Path             *string                `protobuf:"bytes,6,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	Status           *string                `protobuf:"bytes,7,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Username         *string                `protobuf:"bytes,8,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	WorkingDirectory *string                `protobuf:"bytes,9,opt,name=working_directory,json=workingDirectory,proto3,oneof" json:"working_directory,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Process) Reset() {
+	*x = Process{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Process) ProtoMessage() {}
+
+func (x *Process) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Process.ProtoReflect.Descriptor instead.
+func (*Process) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Process) GetId() float64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Process) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Process) GetCpuUsage() float64 {
+	if x != nil && x.CpuUsage != nil {
+		return *x.CpuUsage
+	}
+	return 0
+}
+
+func (x *Process) GetCreated() float64 {
+	if x != nil && x.Created != nil {
+		return *x.Created
+	}
+	return 0
+}
+
+func (x *Process) GetMemoryUsage() float64 {
+	if x != nil && x.MemoryUsage != nil {
+		return *x.MemoryUsage
+	}
+	return 0
+}
+
+func (x *Process) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *Process) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *Process) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *Process) GetWorkingDirectory() string {
+	if x != nil && x.WorkingDirectory != nil {
+		return *x.WorkingDirectory
+	}
+	return ""
+}
+
+// Processes Module
+type ProcessesData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Process             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessesData) Reset() {
+	*x = ProcessesData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessesData) ProtoMessage() {}
+
+func (x *ProcessesData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessesData.ProtoReflect.Descriptor instead.
+func (*ProcessesData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProcessesData) GetItems() []*Process {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Sensors Module
+type SensorsData struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Fans           *structpb.Value        `protobuf:"bytes,1,opt,name=fans,proto3" json:"fans,omitempty"`
+	Temperatures   []*Temperature         `protobuf:"bytes,2,rep,name=temperatures,proto3" json:"temperatures,omitempty"`
+	WindowsSensors *SensorsWindows        `protobuf:"bytes,3,opt,name=windows_sensors,json=windowsSensors,proto3" json:"windows_sensors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SensorsData) Reset() {
+	*x = SensorsData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsData) ProtoMessage() {}
+
+func (x *SensorsData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsData.ProtoReflect.Descriptor instead.
+func (*SensorsData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SensorsData) GetFans() *structpb.Value {
+	if x != nil {
+		return x.Fans
+	}
+	return nil
+}
+
+func (x *SensorsData) GetTemperatures() []*Temperature {
+	if x != nil {
+		return x.Temperatures
+	}
+	return nil
+}
+
+func (x *SensorsData) GetWindowsSensors() *SensorsWindows {
+	if x != nil {
+		return x.WindowsSensors
+	}
+	return nil
+}
+
+// NVIDIA Sensors
+type SensorsNVIDIA struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Chipset       *SensorsNVIDIAChipset   `protobuf:"bytes,1,opt,name=chipset,proto3" json:"chipset,omitempty"`
+	Displays      []*SensorsNVIDIADisplay `protobuf:"bytes,2,rep,name=displays,proto3" json:"displays,omitempty"`
+	Driver        *SensorsNVIDIADriver    `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	Gpus          []*SensorsNVIDIAGPU     `protobuf:"bytes,4,rep,name=gpus,proto3" json:"gpus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorsNVIDIA) Reset() {
+	*x = SensorsNVIDIA{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsNVIDIA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsNVIDIA) ProtoMessage() {}
+
+func (x *SensorsNVIDIA) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsNVIDIA.ProtoReflect.Descriptor instead.
+func (*SensorsNVIDIA) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SensorsNVIDIA) GetChipset() *SensorsNVIDIAChipset {
+	if x != nil {
+		return x.Chipset
+	}
+	return nil
+}
+
+func (x *SensorsNVIDIA) GetDisplays() []*SensorsNVIDIADisplay {
+	if x != nil {
+		return x.Displays
+	}
+	return nil
+}
+
+func (x *SensorsNVIDIA) GetDriver() *SensorsNVIDIADriver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *SensorsNVIDIA) GetGpus() []*SensorsNVIDIAGPU {
+	if x != nil {
+		return x.Gpus
+	}
+	return nil
+}
+
+// NVIDIA Chipset
+type SensorsNVIDIAChipset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Flags         string                 `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"`
+	VendorId      int64                  `protobuf:"varint,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorName    string                 `protobuf:"bytes,5,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorsNVIDIAChipset) Reset() {
+	*x = SensorsNVIDIAChipset{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsNVIDIAChipset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsNVIDIAChipset) ProtoMessage() {}
+
+func (x *SensorsNVIDIAChipset) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsNVIDIAChipset.ProtoReflect.Descriptor instead.
+func (*SensorsNVIDIAChipset) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SensorsNVIDIAChipset) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAChipset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAChipset) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAChipset) GetVendorId() int64 {
+	if x != nil {
+		return x.VendorId
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAChipset) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+// NVIDIA Display
+type SensorsNVIDIADisplay struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Active               bool                   `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	Available            bool                   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	Connected            bool                   `protobuf:"varint,5,opt,name=connected,proto3" json:"connected,omitempty"`
+	Dynamic              bool                   `protobuf:"varint,6,opt,name=dynamic,proto3" json:"dynamic,omitempty"`
+	AspectHorizontal     int64                  `protobuf:"varint,7,opt,name=aspect_horizontal,json=aspectHorizontal,proto3" json:"aspect_horizontal,omitempty"`
+	AspectVertical       int64                  `protobuf:"varint,8,opt,name=aspect_vertical,json=aspectVertical,proto3" json:"aspect_vertical,omitempty"`
+	BrightnessCurrent    int64                  `protobuf:"varint,9,opt,name=brightness_current,json=brightnessCurrent,proto3" json:"brightness_current,omitempty"`
+	BrightnessDefault    int64                  `protobuf:"varint,10,opt,name=brightness_default,json=brightnessDefault,proto3" json:"brightness_default,omitempty"`
+	BrightnessMax        int64                  `protobuf:"varint,11,opt,name=brightness_max,json=brightnessMax,proto3" json:"brightness_max,omitempty"`
+	BrightnessMin        int64                  `protobuf:"varint,12,opt,name=brightness_min,json=brightnessMin,proto3" json:"brightness_min,omitempty"`
+	ColorDepth           string                 `protobuf:"bytes,13,opt,name=color_depth,json=colorDepth,proto3" json:"color_depth,omitempty"`
+	ConnectionType       string                 `protobuf:"bytes,14,opt,name=connection_type,json=connectionType,proto3" json:"connection_type,omitempty"`
+	PixelClock           int64                  `protobuf:"varint,15,opt,name=pixel_clock,json=pixelClock,proto3" json:"pixel_clock,omitempty"`
+	RefreshRate          int64                  `protobuf:"varint,16,opt,name=refresh_rate,json=refreshRate,proto3" json:"refresh_rate,omitempty"`
+	ResolutionHorizontal int64                  `protobuf:"varint,17,opt,name=resolution_horizontal,json=resolutionHorizontal,proto3" json:"resolution_horizontal,omitempty"`
+	ResolutionVertical   int64                  `protobuf:"varint,18,opt,name=resolution_vertical,json=resolutionVertical,proto3" json:"resolution_vertical,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SensorsNVIDIADisplay) Reset() {
+	*x = SensorsNVIDIADisplay{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsNVIDIADisplay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsNVIDIADisplay) ProtoMessage() {}
+
+func (x *SensorsNVIDIADisplay) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsNVIDIADisplay.ProtoReflect.Descriptor instead.
+func (*SensorsNVIDIADisplay) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SensorsNVIDIADisplay) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIADisplay) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SensorsNVIDIADisplay) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *SensorsNVIDIADisplay) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *SensorsNVIDIADisplay) GetDynamic() bool {
+	if x != nil {
+		return x.Dynamic
+	}
+	return false
+}
+
+func (x *SensorsNVIDIADisplay) GetAspectHorizontal() int64 {
+	if x != nil {
+		return x.AspectHorizontal
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetAspectVertical() int64 {
+	if x != nil {
+		return x.AspectVertical
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetBrightnessCurrent() int64 {
+	if x != nil {
+		return x.BrightnessCurrent
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetBrightnessDefault() int64 {
+	if x != nil {
+		return x.BrightnessDefault
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetBrightnessMax() int64 {
+	if x != nil {
+		return x.BrightnessMax
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetBrightnessMin() int64 {
+	if x != nil {
+		return x.BrightnessMin
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetColorDepth() string {
+	if x != nil {
+		return x.ColorDepth
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIADisplay) GetConnectionType() string {
+	if x != nil {
+		return x.ConnectionType
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIADisplay) GetPixelClock() int64 {
+	if x != nil {
+		return x.PixelClock
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetRefreshRate() int64 {
+	if x != nil {
+		return x.RefreshRate
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetResolutionHorizontal() int64 {
+	if x != nil {
+		return x.ResolutionHorizontal
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIADisplay) GetResolutionVertical() int64 {
+	if x != nil {
+		return x.ResolutionVertical
+	}
+	return 0
+}
+
+// NVIDIA Driver
+type SensorsNVIDIADriver struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BranchVersion    string                 `protobuf:"bytes,1,opt,name=branch_version,json=branchVersion,proto3" json:"branch_version,omitempty"`
+	InterfaceVersion string                 `protobuf:"bytes,2,opt,name=interface_version,json=interfaceVersion,proto3" json:"interface_version,omitempty"`
+	Version          int64                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SensorsNVIDIADriver) Reset() {
+	*x = SensorsNVIDIADriver{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsNVIDIADriver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsNVIDIADriver) ProtoMessage() {}
+
+func (x *SensorsNVIDIADriver) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsNVIDIADriver.ProtoReflect.Descriptor instead.
+func (*SensorsNVIDIADriver) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SensorsNVIDIADriver) GetBranchVersion() string {
+	if x != nil {
+		return x.BranchVersion
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIADriver) GetInterfaceVersion() string {
+	if x != nil {
+		return x.InterfaceVersion
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIADriver) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// NVIDIA GPU
+type SensorsNVIDIAGPU struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BiosOemRevision      *int64                 `protobuf:"varint,3,opt,name=bios_oem_revision,json=biosOemRevision,proto3,oneof" json:"bios_oem_revision,omitempty"`
+	BiosRevision         *int64                 `protobuf:"varint,4,opt,name=bios_revision,json=biosRevision,proto3,oneof" json:"bios_revision,omitempty"`
+	BiosVersion          *string                `protobuf:"bytes,5,opt,name=bios_version,json=biosVersion,proto3,oneof" json:"bios_version,omitempty"`
+	CurrentFanSpeedLevel *int64                 `protobuf:"varint,6,opt,name=current_fan_speed_level,json=currentFanSpeedLevel,proto3,oneof" json:"current_fan_speed_level,omitempty"`
+	CurrentFanSpeedRpm   *int64                 `protobuf:"varint,7,opt,name=current_fan_speed_rpm,json=currentFanSpeedRpm,proto3,oneof" json:"current_fan_speed_rpm,omitempty"`
+	DriverModel          *int64                 `protobuf:"varint,8,opt,name=driver_model,json=driverModel,proto3,oneof" json:"driver_model,omitempty"`
+	MemoryAvailable      *int64                 `protobuf:"varint,9,opt,name=memory_available,json=memoryAvailable,proto3,oneof" json:"memory_available,omitempty"`
+	MemoryCapacity       *int64                 `protobuf:"varint,10,opt,name=memory_capacity,json=memoryCapacity,proto3,oneof" json:"memory_capacity,omitempty"`
+	MemoryMaker          *string                `protobuf:"bytes,11,opt,name=memory_maker,json=memoryMaker,proto3,oneof" json:"memory_maker,omitempty"`
+	Serial               *string                `protobuf:"bytes,12,opt,name=serial,proto3,oneof" json:"serial,omitempty"`
+	SystemType           *string                `protobuf:"bytes,13,opt,name=system_type,json=systemType,proto3,oneof" json:"system_type,omitempty"`
+	Type                 *string                `protobuf:"bytes,14,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SensorsNVIDIAGPU) Reset() {
+	*x = SensorsNVIDIAGPU{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsNVIDIAGPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsNVIDIAGPU) ProtoMessage() {}
+
+func (x *SensorsNVIDIAGPU) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsNVIDIAGPU.ProtoReflect.Descriptor instead.
+func (*SensorsNVIDIAGPU) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SensorsNVIDIAGPU) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAGPU) GetBiosOemRevision() int64 {
+	if x != nil && x.BiosOemRevision != nil {
+		return *x.BiosOemRevision
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetBiosRevision() int64 {
+	if x != nil && x.BiosRevision != nil {
+		return *x.BiosRevision
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetBiosVersion() string {
+	if x != nil && x.BiosVersion != nil {
+		return *x.BiosVersion
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAGPU) GetCurrentFanSpeedLevel() int64 {
+	if x != nil && x.CurrentFanSpeedLevel != nil {
+		return *x.CurrentFanSpeedLevel
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetCurrentFanSpeedRpm() int64 {
+	if x != nil && x.CurrentFanSpeedRpm != nil {
+		return *x.CurrentFanSpeedRpm
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetDriverModel() int64 {
+	if x != nil && x.DriverModel != nil {
+		return *x.DriverModel
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetMemoryAvailable() int64 {
+	if x != nil && x.MemoryAvailable != nil {
+		return *x.MemoryAvailable
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetMemoryCapacity() int64 {
+	if x != nil && x.MemoryCapacity != nil {
+		return *x.MemoryCapacity
+	}
+	return 0
+}
+
+func (x *SensorsNVIDIAGPU) GetMemoryMaker() string {
+	if x != nil && x.MemoryMaker != nil {
+		return *x.MemoryMaker
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAGPU) GetSerial() string {
+	if x != nil && x.Serial != nil {
+		return *x.Serial
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAGPU) GetSystemType() string {
+	if x != nil && x.SystemType != nil {
+		return *x.SystemType
+	}
+	return ""
+}
+
+func (x *SensorsNVIDIAGPU) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+// Windows Sensors
+type SensorsWindows struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Hardware      []*SensorsWindowsHardware `protobuf:"bytes,1,rep,name=hardware,proto3" json:"hardware,omitempty"`
+	Nvidia        *SensorsNVIDIA            `protobuf:"bytes,2,opt,name=nvidia,proto3" json:"nvidia,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorsWindows) Reset() {
+	*x = SensorsWindows{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsWindows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsWindows) ProtoMessage() {}
+
+func (x *SensorsWindows) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsWindows.ProtoReflect.Descriptor instead.
+func (*SensorsWindows) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SensorsWindows) GetHardware() []*SensorsWindowsHardware {
+	if x != nil {
+		return x.Hardware
+	}
+	return nil
+}
+
+func (x *SensorsWindows) GetNvidia() *SensorsNVIDIA {
+	if x != nil {
+		return x.Nvidia
+	}
+	return nil
+}
+
+// Windows Hardware
+type SensorsWindowsHardware struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                    `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Subhardware   []*SensorsWindowsHardware `protobuf:"bytes,4,rep,name=subhardware,proto3" json:"subhardware,omitempty"`
+	Sensors       []*SensorsWindowsSensor   `protobuf:"bytes,5,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorsWindowsHardware) Reset() {
+	*x = SensorsWindowsHardware{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsWindowsHardware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsWindowsHardware) ProtoMessage() {}
+
+func (x *SensorsWindowsHardware) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsWindowsHardware.ProtoReflect.Descriptor instead.
+func (*SensorsWindowsHardware) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SensorsWindowsHardware) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SensorsWindowsHardware) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorsWindowsHardware) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SensorsWindowsHardware) GetSubhardware() []*SensorsWindowsHardware {
+	if x != nil {
+		return x.Subhardware
+	}
+	return nil
+}
+
+func (x *SensorsWindowsHardware) GetSensors() []*SensorsWindowsSensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+// Windows Sensor
+type SensorsWindowsSensor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Value         *structpb.Value        `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorsWindowsSensor) Reset() {
+	*x = SensorsWindowsSensor{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorsWindowsSensor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorsWindowsSensor) ProtoMessage() {}
+
+func (x *SensorsWindowsSensor) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorsWindowsSensor.ProtoReflect.Descriptor instead.
+func (*SensorsWindowsSensor) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SensorsWindowsSensor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SensorsWindowsSensor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorsWindowsSensor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SensorsWindowsSensor) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// System Module
+type SystemData struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	BootTime              uint64                 `protobuf:"varint,1,opt,name=boot_time,json=bootTime,proto3" json:"boot_time,omitempty"`
+	Fqdn                  string                 `protobuf:"bytes,2,opt,name=fqdn,proto3" json:"fqdn,omitempty"`
+	Hostname              string                 `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	KernelVersion         string                 `protobuf:"bytes,4,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	IpAddress_4           string                 `protobuf:"bytes,5,opt,name=ip_address_4,json=ipAddress4,proto3" json:"ip_address_4,omitempty"`
+	MacAddress            string                 `protobuf:"bytes,6,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	PlatformVersion       string                 `protobuf:"bytes,7,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"`
+	Platform              string                 `protobuf:"bytes,8,opt,name=platform,proto3" json:"platform,omitempty"`
+	PowerUsage            *float64               `protobuf:"fixed64,9,opt,name=power_usage,json=powerUsage,proto3,oneof" json:"power_usage,omitempty"`
+	Uptime                uint64                 `protobuf:"varint,10,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	Users                 []*SystemUser          `protobuf:"bytes,11,rep,name=users,proto3" json:"users,omitempty"`
+	Uuid                  string                 `protobuf:"bytes,12,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Version               string                 `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
+	CameraUsage           []string               `protobuf:"bytes,14,rep,name=camera_usage,json=cameraUsage,proto3" json:"camera_usage,omitempty"`
+	MicrophoneUsage       []string               `protobuf:"bytes,15,rep,name=microphone_usage,json=microphoneUsage,proto3" json:"microphone_usage,omitempty"`
+	IpAddress_6           string                 `protobuf:"bytes,16,opt,name=ip_address_6,json=ipAddress6,proto3" json:"ip_address_6,omitempty"`
+	PendingReboot         *bool                  `protobuf:"varint,17,opt,name=pending_reboot,json=pendingReboot,proto3,oneof" json:"pending_reboot,omitempty"`
+	RunMode               string                 `protobuf:"bytes,18,opt,name=run_mode,json=runMode,proto3" json:"run_mode,omitempty"`
+	VersionLatestUrl      *string                `protobuf:"bytes,19,opt,name=version_latest_url,json=versionLatestUrl,proto3,oneof" json:"version_latest_url,omitempty"`
+	VersionLatest         *string                `protobuf:"bytes,20,opt,name=version_latest,json=versionLatest,proto3,oneof" json:"version_latest,omitempty"`
+	VersionNewerAvailable *bool                  `protobuf:"varint,21,opt,name=version_newer_available,json=versionNewerAvailable,proto3,oneof" json:"version_newer_available,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SystemData) Reset() {
+	*x = SystemData{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemData) ProtoMessage() {}
+
+func (x *SystemData) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemData.ProtoReflect.Descriptor instead.
+func (*SystemData) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SystemData) GetBootTime() uint64 {
+	if x != nil {
+		return x.BootTime
+	}
+	return 0
+}
+
+func (x *SystemData) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *SystemData) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *SystemData) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *SystemData) GetIpAddress_4() string {
+	if x != nil {
+		return x.IpAddress_4
+	}
+	return ""
+}
+
+func (x *SystemData) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *SystemData) GetPlatformVersion() string {
+	if x != nil {
+		return x.PlatformVersion
+	}
+	return ""
+}
+
+func (x *SystemData) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *SystemData) GetPowerUsage() float64 {
+	if x != nil && x.PowerUsage != nil {
+		return *x.PowerUsage
+	}
+	return 0
+}
+
+func (x *SystemData) GetUptime() uint64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *SystemData) GetUsers() []*SystemUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SystemData) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *SystemData) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemData) GetCameraUsage() []string {
+	if x != nil {
+		return x.CameraUsage
+	}
+	return nil
+}
+
+func (x *SystemData) GetMicrophoneUsage() []string {
+	if x != nil {
+		return x.MicrophoneUsage
+	}
+	return nil
+}
+
+func (x *SystemData) GetIpAddress_6() string {
+	if x != nil {
+		return x.IpAddress_6
+	}
+	return ""
+}
+
+func (x *SystemData) GetPendingReboot() bool {
+	if x != nil && x.PendingReboot != nil {
+		return *x.PendingReboot
+	}
+	return false
+}
+
+func (x *SystemData) GetRunMode() string {
+	if x != nil {
+		return x.RunMode
+	}
+	return ""
+}
+
+func (x *SystemData) GetVersionLatestUrl() string {
+	if x != nil && x.VersionLatestUrl != nil {
+		return *x.VersionLatestUrl
+	}
+	return ""
+}
+
+func (x *SystemData) GetVersionLatest() string {
+	if x != nil && x.VersionLatest != nil {
+		return *x.VersionLatest
+	}
+	return ""
+}
+
+func (x *SystemData) GetVersionNewerAvailable() bool {
+	if x != nil && x.VersionNewerAvailable != nil {
+		return *x.VersionNewerAvailable
+	}
+	return false
+}
+
+// System User
+type SystemUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Terminal      string                 `protobuf:"bytes,3,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Started       int64                  `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+	Pid           float64                `protobuf:"fixed64,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemUser) Reset() {
+	*x = SystemUser{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemUser) ProtoMessage() {}
+
+func (x *SystemUser) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemUser.ProtoReflect.Descriptor instead.
+func (*SystemUser) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SystemUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SystemUser) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *SystemUser) GetTerminal() string {
+	if x != nil {
+		return x.Terminal
+	}
+	return ""
+}
+
+func (x *SystemUser) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SystemUser) GetStarted() int64 {
+	if x != nil {
+		return x.Started
+	}
+	return 0
+}
+
+func (x *SystemUser) GetPid() float64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+// Temperature Sensor
+type Temperature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Temperature   float64                `protobuf:"fixed64,2,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Critical      float64                `protobuf:"fixed64,4,opt,name=critical,proto3" json:"critical,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Temperature) Reset() {
+	*x = Temperature{}
+	mi := &file_systembridge_v1_types_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Temperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Temperature) ProtoMessage() {}
+
+func (x *Temperature) ProtoReflect() protoreflect.Message {
+	mi := &file_systembridge_v1_types_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Temperature.ProtoReflect.Descriptor instead.
+func (*Temperature) Descriptor() ([]byte, []int) {
+	return file_systembridge_v1_types_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Temperature) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Temperature) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *Temperature) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Temperature) GetCritical() float64 {
+	if x != nil {
+		return x.Critical
+	}
+	return 0
+}
+
+var File_systembridge_v1_types_proto protoreflect.FileDescriptor
+
+const file_systembridge_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"\x1bsystembridge/v1/types.proto\x12\x0fsystembridge.v1\x1a\x1cgoogle/protobuf/struct.proto\"\xb6\x01\n" +
+	"\vBatteryData\x12$\n" +
+	"\vis_charging\x18\x01 \x01(\bH\x00R\n" +
+	"isCharging\x88\x01\x01\x12#\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01H\x01R\n" +
+	"percentage\x88\x01\x01\x12*\n" +
+	"\x0etime_remaining\x18\x03 \x01(\x01H\x02R\rtimeRemaining\x88\x01\x01B\x0e\n" +
+	"\f_is_chargingB\r\n" +
+	"\v_percentageB\x11\n" +
+	"\x0f_time_remaining\"\xa4\x04\n" +
+	"\aCPUData\x12\x19\n" +
+	"\x05count\x18\x01 \x01(\x03H\x00R\x05count\x88\x01\x01\x12;\n" +
+	"\tfrequency\x18\x02 \x01(\v2\x1d.systembridge.v1.CPUFrequencyR\tfrequency\x12&\n" +
+	"\fload_average\x18\x03 \x01(\x01H\x01R\vloadAverage\x88\x01\x01\x120\n" +
+	"\aper_cpu\x18\x04 \x03(\v2\x17.systembridge.v1.PerCPUR\x06perCpu\x12\x19\n" +
+	"\x05power\x18\x05 \x01(\x01H\x02R\x05power\x88\x01\x01\x12/\n" +
+	"\x05stats\x18\x06 \x01(\v2\x19.systembridge.v1.CPUStatsR\x05stats\x12%\n" +
+	"\vtemperature\x18\a \x01(\x01H\x03R\vtemperature\x88\x01\x01\x12/\n" +
+	"\x05times\x18\b \x01(\v2\x19.systembridge.v1.CPUTimesR\x05times\x12>\n" +
+	"\rtimes_percent\x18\t \x01(\v2\x19.systembridge.v1.CPUTimesR\ftimesPercent\x12\x19\n" +
+	"\x05usage\x18\n" +
+	" \x01(\x01H\x04R\x05usage\x88\x01\x01\x12\x1d\n" +
+	"\avoltage\x18\v \x01(\x01H\x05R\avoltage\x88\x01\x01B\b\n" +
+	"\x06_countB\x0f\n" +
+	"\r_load_averageB\b\n" +
+	"\x06_powerB\x0e\n" +
+	"\f_temperatureB\b\n" +
+	"\x06_usageB\n" +
+	"\n" +
+	"\b_voltage\"w\n" +
+	"\fCPUFrequency\x12\x1d\n" +
+	"\acurrent\x18\x01 \x01(\x01H\x00R\acurrent\x88\x01\x01\x12\x15\n" +
+	"\x03min\x18\x02 \x01(\x01H\x01R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x03 \x01(\x01H\x02R\x03max\x88\x01\x01B\n" +
+	"\n" +
+	"\b_currentB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xe7\x01\n" +
+	"\bCPUStats\x12&\n" +
+	"\fctx_switches\x18\x01 \x01(\x03H\x00R\vctxSwitches\x88\x01\x01\x12#\n" +
+	"\n" +
+	"interrupts\x18\x02 \x01(\x03H\x01R\n" +
+	"interrupts\x88\x01\x01\x12,\n" +
+	"\x0fsoft_interrupts\x18\x03 \x01(\x03H\x02R\x0esoftInterrupts\x88\x01\x01\x12\x1f\n" +
+	"\bsyscalls\x18\x04 \x01(\x03H\x03R\bsyscalls\x88\x01\x01B\x0f\n" +
+	"\r_ctx_switchesB\r\n" +
+	"\v_interruptsB\x12\n" +
+	"\x10_soft_interruptsB\v\n" +
+	"\t_syscalls\"\xc6\x01\n" +
+	"\bCPUTimes\x12\x17\n" +
+	"\x04user\x18\x01 \x01(\x01H\x00R\x04user\x88\x01\x01\x12\x1b\n" +
+	"\x06system\x18\x02 \x01(\x01H\x01R\x06system\x88\x01\x01\x12\x17\n" +
+	"\x04idle\x18\x03 \x01(\x01H\x02R\x04idle\x88\x01\x01\x12!\n" +
+	"\tinterrupt\x18\x04 \x01(\x01H\x03R\tinterrupt\x88\x01\x01\x12\x15\n" +
+	"\x03dpc\x18\x05 \x01(\x01H\x04R\x03dpc\x88\x01\x01B\a\n" +
+	"\x05_userB\t\n" +
+	"\a_systemB\a\n" +
+	"\x05_idleB\f\n" +
+	"\n" +
+	"_interruptB\x06\n" +
+	"\x04_dpc\"\x9c\x01\n" +
+	"\x04Disk\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12>\n" +
+	"\n" +
+	"partitions\x18\x02 \x03(\v2\x1e.systembridge.v1.DiskPartitionR\n" +
+	"partitions\x12@\n" +
+	"\vio_counters\x18\x03 \x01(\v2\x1f.systembridge.v1.DiskIOCountersR\n" +
+	"ioCounters\"\xcc\x01\n" +
+	"\x0eDiskIOCounters\x12\x1d\n" +
+	"\n" +
+	"read_count\x18\x01 \x01(\x04R\treadCount\x12\x1f\n" +
+	"\vwrite_count\x18\x02 \x01(\x04R\n" +
+	"writeCount\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x03 \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\x04 \x01(\x04R\n" +
+	"writeBytes\x12\x1b\n" +
+	"\tread_time\x18\x05 \x01(\x04R\breadTime\x12\x1d\n" +
+	"\n" +
+	"write_time\x18\x06 \x01(\x04R\twriteTime\"\x89\x02\n" +
+	"\rDiskPartition\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x1f\n" +
+	"\vmount_point\x18\x02 \x01(\tR\n" +
+	"mountPoint\x12'\n" +
+	"\x0ffilesystem_type\x18\x03 \x01(\tR\x0efilesystemType\x12\x18\n" +
+	"\aoptions\x18\x04 \x01(\tR\aoptions\x12\"\n" +
+	"\rmax_file_size\x18\x05 \x01(\x03R\vmaxFileSize\x12&\n" +
+	"\x0fmax_path_length\x18\x06 \x01(\x03R\rmaxPathLength\x120\n" +
+	"\x05usage\x18\a \x01(\v2\x1a.systembridge.v1.DiskUsageR\x05usage\"c\n" +
+	"\tDiskUsage\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x04R\x05total\x12\x12\n" +
+	"\x04used\x18\x02 \x01(\x04R\x04used\x12\x12\n" +
+	"\x04free\x18\x03 \x01(\x04R\x04free\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\"~\n" +
+	"\tDisksData\x12/\n" +
+	"\adevices\x18\x01 \x03(\v2\x15.systembridge.v1.DiskR\adevices\x12@\n" +
+	"\vio_counters\x18\x02 \x01(\v2\x1f.systembridge.v1.DiskIOCountersR\n" +
+	"ioCounters\"\x9e\x03\n" +
+	"\aDisplay\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x15resolution_horizontal\x18\x03 \x01(\x03R\x14resolutionHorizontal\x12/\n" +
+	"\x13resolution_vertical\x18\x04 \x01(\x03R\x12resolutionVertical\x12\f\n" +
+	"\x01x\x18\x05 \x01(\x03R\x01x\x12\f\n" +
+	"\x01y\x18\x06 \x01(\x03R\x01y\x12\x19\n" +
+	"\x05width\x18\a \x01(\x03H\x00R\x05width\x88\x01\x01\x12\x1b\n" +
+	"\x06height\x18\b \x01(\x03H\x01R\x06height\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_primary\x18\t \x01(\bH\x02R\tisPrimary\x88\x01\x01\x12$\n" +
+	"\vpixel_clock\x18\n" +
+	" \x01(\x01H\x03R\n" +
+	"pixelClock\x88\x01\x01\x12&\n" +
+	"\frefresh_rate\x18\v \x01(\x01H\x04R\vrefreshRate\x88\x01\x01B\b\n" +
+	"\x06_widthB\t\n" +
+	"\a_heightB\r\n" +
+	"\v_is_primaryB\x0e\n" +
+	"\f_pixel_clockB\x0f\n" +
+	"\r_refresh_rate\">\n" +
+	"\fDisplaysData\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.systembridge.v1.DisplayR\x05items\"\xbd\x04\n" +
+	"\x03GPU\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"core_clock\x18\x03 \x01(\x01H\x00R\tcoreClock\x88\x01\x01\x12 \n" +
+	"\tcore_load\x18\x04 \x01(\x01H\x01R\bcoreLoad\x88\x01\x01\x12 \n" +
+	"\tfan_speed\x18\x05 \x01(\x01H\x02R\bfanSpeed\x88\x01\x01\x12&\n" +
+	"\fmemory_clock\x18\x06 \x01(\x01H\x03R\vmemoryClock\x88\x01\x01\x12$\n" +
+	"\vmemory_load\x18\a \x01(\x01H\x04R\n" +
+	"memoryLoad\x88\x01\x01\x12$\n" +
+	"\vmemory_free\x18\b \x01(\x01H\x05R\n" +
+	"memoryFree\x88\x01\x01\x12$\n" +
+	"\vmemory_used\x18\t \x01(\x01H\x06R\n" +
+	"memoryUsed\x88\x01\x01\x12&\n" +
+	"\fmemory_total\x18\n" +
+	" \x01(\x01H\aR\vmemoryTotal\x88\x01\x01\x12$\n" +
+	"\vpower_usage\x18\v \x01(\x01H\bR\n" +
+	"powerUsage\x88\x01\x01\x12%\n" +
+	"\vtemperature\x18\f \x01(\x01H\tR\vtemperature\x88\x01\x01B\r\n" +
+	"\v_core_clockB\f\n" +
+	"\n" +
+	"_core_loadB\f\n" +
+	"\n" +
+	"_fan_speedB\x0f\n" +
+	"\r_memory_clockB\x0e\n" +
+	"\f_memory_loadB\x0e\n" +
+	"\f_memory_freeB\x0e\n" +
+	"\f_memory_usedB\x0f\n" +
+	"\r_memory_totalB\x0e\n" +
+	"\f_power_usageB\x0e\n" +
+	"\f_temperature\"6\n" +
+	"\bGPUsData\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.systembridge.v1.GPUR\x05items\"\xc2\n" +
+	"\n" +
+	"\tMediaData\x12&\n" +
+	"\falbum_artist\x18\x01 \x01(\tH\x00R\valbumArtist\x88\x01\x01\x12$\n" +
+	"\valbum_title\x18\x02 \x01(\tH\x01R\n" +
+	"albumTitle\x88\x01\x01\x12\x1b\n" +
+	"\x06artist\x18\x03 \x01(\tH\x02R\x06artist\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x04 \x01(\x01H\x03R\bduration\x88\x01\x01\x12:\n" +
+	"\x17is_fast_forward_enabled\x18\x05 \x01(\bH\x04R\x14isFastForwardEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_next_enabled\x18\x06 \x01(\bH\x05R\risNextEnabled\x88\x01\x01\x12-\n" +
+	"\x10is_pause_enabled\x18\a \x01(\bH\x06R\x0eisPauseEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_play_enabled\x18\b \x01(\bH\aR\risPlayEnabled\x88\x01\x01\x123\n" +
+	"\x13is_previous_enabled\x18\t \x01(\bH\bR\x11isPreviousEnabled\x88\x01\x01\x12/\n" +
+	"\x11is_rewind_enabled\x18\n" +
+	" \x01(\bH\tR\x0fisRewindEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_stop_enabled\x18\v \x01(\bH\n" +
+	"R\risStopEnabled\x88\x01\x01\x12(\n" +
+	"\rplayback_rate\x18\f \x01(\x01H\vR\fplaybackRate\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\r \x01(\x01H\fR\bposition\x88\x01\x01\x12\x1b\n" +
+	"\x06repeat\x18\x0e \x01(\tH\rR\x06repeat\x88\x01\x01\x12\x1d\n" +
+	"\ashuffle\x18\x0f \x01(\bH\x0eR\ashuffle\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x10 \x01(\tH\x0fR\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bsubtitle\x18\x11 \x01(\tH\x10R\bsubtitle\x88\x01\x01\x12!\n" +
+	"\tthumbnail\x18\x12 \x01(\tH\x11R\tthumbnail\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x13 \x01(\tH\x12R\x05title\x88\x01\x01\x12&\n" +
+	"\ftrack_number\x18\x14 \x01(\x03H\x13R\vtrackNumber\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x15 \x01(\tH\x14R\x04type\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x16 \x01(\x01H\x15R\tupdatedAt\x88\x01\x01\x12\x1b\n" +
+	"\x06volume\x18\x17 \x01(\x01H\x16R\x06volume\x88\x01\x01\x12\x1b\n" +
+	"\x06player\x18\x18 \x01(\tH\x17R\x06player\x88\x01\x01\x126\n" +
+	"\aplayers\x18\x19 \x03(\v2\x1c.systembridge.v1.MediaPlayerR\aplayersB\x0f\n" +
+	"\r_album_artistB\x0e\n" +
+	"\f_album_titleB\t\n" +
+	"\a_artistB\v\n" +
+	"\t_durationB\x1a\n" +
+	"\x18_is_fast_forward_enabledB\x12\n" +
+	"\x10_is_next_enabledB\x13\n" +
+	"\x11_is_pause_enabledB\x12\n" +
+	"\x10_is_play_enabledB\x16\n" +
+	"\x14_is_previous_enabledB\x14\n" +
+	"\x12_is_rewind_enabledB\x12\n" +
+	"\x10_is_stop_enabledB\x10\n" +
+	"\x0e_playback_rateB\v\n" +
+	"\t_positionB\t\n" +
+	"\a_repeatB\n" +
+	"\n" +
+	"\b_shuffleB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_subtitleB\f\n" +
+	"\n" +
+	"_thumbnailB\b\n" +
+	"\x06_titleB\x0f\n" +
+	"\r_track_numberB\a\n" +
+	"\x05_typeB\r\n" +
+	"\v_updated_atB\t\n" +
+	"\a_volumeB\t\n" +
+	"\a_player\"\xe2\t\n" +
+	"\vMediaPlayer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12&\n" +
+	"\falbum_artist\x18\x03 \x01(\tH\x00R\valbumArtist\x88\x01\x01\x12$\n" +
+	"\valbum_title\x18\x04 \x01(\tH\x01R\n" +
+	"albumTitle\x88\x01\x01\x12\x1b\n" +
+	"\x06artist\x18\x05 \x01(\tH\x02R\x06artist\x88\x01\x01\x12\x1f\n" +
+	"\bduration\x18\x06 \x01(\x01H\x03R\bduration\x88\x01\x01\x12:\n" +
+	"\x17is_fast_forward_enabled\x18\a \x01(\bH\x04R\x14isFastForwardEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_next_enabled\x18\b \x01(\bH\x05R\risNextEnabled\x88\x01\x01\x12-\n" +
+	"\x10is_pause_enabled\x18\t \x01(\bH\x06R\x0eisPauseEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_play_enabled\x18\n" +
+	" \x01(\bH\aR\risPlayEnabled\x88\x01\x01\x123\n" +
+	"\x13is_previous_enabled\x18\v \x01(\bH\bR\x11isPreviousEnabled\x88\x01\x01\x12/\n" +
+	"\x11is_rewind_enabled\x18\f \x01(\bH\tR\x0fisRewindEnabled\x88\x01\x01\x12+\n" +
+	"\x0fis_stop_enabled\x18\r \x01(\bH\n" +
+	"R\risStopEnabled\x88\x01\x01\x12(\n" +
+	"\rplayback_rate\x18\x0e \x01(\x01H\vR\fplaybackRate\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x0f \x01(\x01H\fR\bposition\x88\x01\x01\x12\x1b\n" +
+	"\x06repeat\x18\x10 \x01(\tH\rR\x06repeat\x88\x01\x01\x12\x1d\n" +
+	"\ashuffle\x18\x11 \x01(\bH\x0eR\ashuffle\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x12 \x01(\tH\x0fR\x06status\x88\x01\x01\x12\x1f\n" +
+	"\bsubtitle\x18\x13 \x01(\tH\x10R\bsubtitle\x88\x01\x01\x12!\n" +
+	"\tthumbnail\x18\x14 \x01(\tH\x11R\tthumbnail\x88\x01\x01\x12\x19\n" +
+	"\x05title\x18\x15 \x01(\tH\x12R\x05title\x88\x01\x01\x12&\n" +
+	"\ftrack_number\x18\x16 \x01(\x03H\x13R\vtrackNumber\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x17 \x01(\tH\x14R\x04type\x88\x01\x01\x12\x1b\n" +
+	"\x06volume\x18\x18 \x01(\x01H\x15R\x06volume\x88\x01\x01B\x0f\n" +
+	"\r_album_artistB\x0e\n" +
+	"\f_album_titleB\t\n" +
+	"\a_artistB\v\n" +
+	"\t_durationB\x1a\n" +
+	"\x18_is_fast_forward_enabledB\x12\n" +
+	"\x10_is_next_enabledB\x13\n" +
+	"\x11_is_pause_enabledB\x12\n" +
+	"\x10_is_play_enabledB\x16\n" +
+	"\x14_is_previous_enabledB\x14\n" +
+	"\x12_is_rewind_enabledB\x12\n" +
+	"\x10_is_stop_enabledB\x10\n" +
+	"\x0e_playback_rateB\v\n" +
+	"\t_positionB\t\n" +
+	"\a_repeatB\n" +
+	"\n" +
+	"\b_shuffleB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_subtitleB\f\n" +
+	"\n" +
+	"_thumbnailB\b\n" +
+	"\x06_titleB\x0f\n" +
+	"\r_track_numberB\a\n" +
+	"\x05_typeB\t\n" +
+	"\a_volume\"w\n" +
+	"\n" +
+	"MemoryData\x12/\n" +
+	"\x04swap\x18\x01 \x01(\v2\x1b.systembridge.v1.MemorySwapR\x04swap\x128\n" +
+	"\avirtual\x18\x02 \x01(\v2\x1e.systembridge.v1.MemoryVirtualR\avirtual\"\xe1\x01\n" +
+	"\n" +
+	"MemorySwap\x12\x19\n" +
+	"\x05total\x18\x01 \x01(\x04H\x00R\x05total\x88\x01\x01\x12\x17\n" +
+	"\x04used\x18\x02 \x01(\x04H\x01R\x04used\x88\x01\x01\x12\x17\n" +
+	"\x04free\x18\x03 \x01(\x04H\x02R\x04free\x88\x01\x01\x12\x1d\n" +
+	"\apercent\x18\x04 \x01(\x01H\x03R\apercent\x88\x01\x01\x12\x15\n" +
+	"\x03sin\x18\x05 \x01(\x04H\x04R\x03sin\x88\x01\x01\x12\x17\n" +
+	"\x04sout\x18\x06 \x01(\x04H\x05R\x04sout\x88\x01\x01B\b\n" +
+	"\x06_totalB\a\n" +
+	"\x05_usedB\a\n" +
+	"\x05_freeB\n" +
+	"\n" +
+	"\b_percentB\x06\n" +
+	"\x04_sinB\a\n" +
+	"\x05_sout\"\xca\x03\n" +
+	"\rMemoryVirtual\x12\x19\n" +
+	"\x05total\x18\x01 \x01(\x04H\x00R\x05total\x88\x01\x01\x12!\n" +
+	"\tavailable\x18\x02 \x01(\x04H\x01R\tavailable\x88\x01\x01\x12\x1d\n" +
+	"\apercent\x18\x03 \x01(\x01H\x02R\apercent\x88\x01\x01\x12\x17\n" +
+	"\x04used\x18\x04 \x01(\x04H\x03R\x04used\x88\x01\x01\x12\x17\n" +
+	"\x04free\x18\x05 \x01(\x04H\x04R\x04free\x88\x01\x01\x12\x1b\n" +
+	"\x06active\x18\x06 \x01(\x04H\x05R\x06active\x88\x01\x01\x12\x1f\n" +
+	"\binactive\x18\a \x01(\x04H\x06R\binactive\x88\x01\x01\x12\x1d\n" +
+	"\abuffers\x18\b \x01(\x04H\aR\abuffers\x88\x01\x01\x12\x1b\n" +
+	"\x06cached\x18\t \x01(\x04H\bR\x06cached\x88\x01\x01\x12\x19\n" +
+	"\x05wired\x18\n" +
+	" \x01(\x04H\tR\x05wired\x88\x01\x01\x12\x1b\n" +
+	"\x06shared\x18\v \x01(\x04H\n" +
+	"R\x06shared\x88\x01\x01B\b\n" +
+	"\x06_totalB\f\n" +
+	"\n" +
+	"_availableB\n" +
+	"\n" +
+	"\b_percentB\a\n" +
+	"\x05_usedB\a\n" +
+	"\x05_freeB\t\n" +
+	"\a_activeB\v\n" +
+	"\t_inactiveB\n" +
+	"\n" +
+	"\b_buffersB\t\n" +
+	"\a_cachedB\b\n" +
+	"\x06_wiredB\t\n" +
+	"\a_shared\"f\n" +
+	"\x06Module\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12*\n" +
+	"\x04data\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x04data\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\tR\aupdated\"\x9f\x01\n" +
+	"\aNetwork\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tH\x00R\x04name\x88\x01\x01\x12=\n" +
+	"\taddresses\x18\x02 \x03(\v2\x1f.systembridge.v1.NetworkAddressR\taddresses\x123\n" +
+	"\x05stats\x18\x03 \x01(\v2\x1d.systembridge.v1.NetworkStatsR\x05statsB\a\n" +
+	"\x05_name\"\xde\x01\n" +
+	"\x0eNetworkAddress\x12\x1d\n" +
+	"\aaddress\x18\x01 \x01(\tH\x00R\aaddress\x88\x01\x01\x12\x1b\n" +
+	"\x06family\x18\x02 \x01(\tH\x01R\x06family\x88\x01\x01\x12\x1d\n" +
+	"\anetmask\x18\x03 \x01(\tH\x02R\anetmask\x88\x01\x01\x12!\n" +
+	"\tbroadcast\x18\x04 \x01(\tH\x03R\tbroadcast\x88\x01\x01\x12\x15\n" +
+	"\x03ptp\x18\x05 \x01(\tH\x04R\x03ptp\x88\x01\x01B\n" +
+	"\n" +
+	"\b_addressB\t\n" +
+	"\a_familyB\n" +
+	"\n" +
+	"\b_netmaskB\f\n" +
+	"\n" +
+	"_broadcastB\x06\n" +
+	"\x04_ptp\"\x8a\x02\n" +
+	"\x11NetworkConnection\x12\x13\n" +
+	"\x02fd\x18\x01 \x01(\x03H\x00R\x02fd\x88\x01\x01\x12\x1b\n" +
+	"\x06family\x18\x02 \x01(\x03H\x01R\x06family\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\x03H\x02R\x04type\x88\x01\x01\x12\x19\n" +
+	"\x05laddr\x18\x04 \x01(\tH\x03R\x05laddr\x88\x01\x01\x12\x19\n" +
+	"\x05raddr\x18\x05 \x01(\tH\x04R\x05raddr\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x06 \x01(\tH\x05R\x06status\x88\x01\x01\x12\x15\n" +
+	"\x03pid\x18\a \x01(\x03H\x06R\x03pid\x88\x01\x01B\x05\n" +
+	"\x03_fdB\t\n" +
+	"\a_familyB\a\n" +
+	"\x05_typeB\b\n" +
+	"\x06_laddrB\b\n" +
+	"\x06_raddrB\t\n" +
+	"\a_statusB\x06\n" +
+	"\x04_pid\"\x83\x03\n" +
+	"\tNetworkIO\x12\"\n" +
+	"\n" +
+	"bytes_sent\x18\x01 \x01(\x03H\x00R\tbytesSent\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"bytes_recv\x18\x02 \x01(\x03H\x01R\tbytesRecv\x88\x01\x01\x12&\n" +
+	"\fpackets_sent\x18\x03 \x01(\x03H\x02R\vpacketsSent\x88\x01\x01\x12&\n" +
+	"\fpackets_recv\x18\x04 \x01(\x03H\x03R\vpacketsRecv\x88\x01\x01\x12\x19\n" +
+	"\x05errin\x18\x05 \x01(\x03H\x04R\x05errin\x88\x01\x01\x12\x1b\n" +
+	"\x06errout\x18\x06 \x01(\x03H\x05R\x06errout\x88\x01\x01\x12\x1b\n" +
+	"\x06dropin\x18\a \x01(\x03H\x06R\x06dropin\x88\x01\x01\x12\x1d\n" +
+	"\adropout\x18\b \x01(\x03H\aR\adropout\x88\x01\x01B\r\n" +
+	"\v_bytes_sentB\r\n" +
+	"\v_bytes_recvB\x0f\n" +
+	"\r_packets_sentB\x0f\n" +
+	"\r_packets_recvB\b\n" +
+	"\x06_errinB\t\n" +
+	"\a_erroutB\t\n" +
+	"\a_dropinB\n" +
+	"\n" +
+	"\b_dropout\"\xb2\x01\n" +
+	"\fNetworkStats\x12\x17\n" +
+	"\x04isup\x18\x01 \x01(\bH\x00R\x04isup\x88\x01\x01\x12\x1b\n" +
+	"\x06duplex\x18\x02 \x01(\tH\x01R\x06duplex\x88\x01\x01\x12\x19\n" +
+	"\x05speed\x18\x03 \x01(\x03H\x02R\x05speed\x88\x01\x01\x12\x15\n" +
+	"\x03mtu\x18\x04 \x01(\x03H\x03R\x03mtu\x88\x01\x01\x12\x14\n" +
+	"\x05flags\x18\x05 \x03(\tR\x05flagsB\a\n" +
+	"\x05_isupB\t\n" +
+	"\a_duplexB\b\n" +
+	"\x06_speedB\x06\n" +
+	"\x04_mtu\"\xb6\x01\n" +
+	"\fNetworksData\x12D\n" +
+	"\vconnections\x18\x01 \x03(\v2\".systembridge.v1.NetworkConnectionR\vconnections\x12*\n" +
+	"\x02io\x18\x02 \x01(\v2\x1a.systembridge.v1.NetworkIOR\x02io\x124\n" +
+	"\bnetworks\x18\x03 \x03(\v2\x18.systembridge.v1.NetworkR\bnetworks\"\xbb\x02\n" +
+	"\x06PerCPU\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\tfrequency\x18\x02 \x01(\v2\x1d.systembridge.v1.CPUFrequencyR\tfrequency\x12\x19\n" +
+	"\x05power\x18\x03 \x01(\x01H\x00R\x05power\x88\x01\x01\x12/\n" +
+	"\x05times\x18\x04 \x01(\v2\x19.systembridge.v1.CPUTimesR\x05times\x12>\n" +
+	"\rtimes_percent\x18\x05 \x01(\v2\x19.systembridge.v1.CPUTimesR\ftimesPercent\x12\x19\n" +
+	"\x05usage\x18\x06 \x01(\x01H\x01R\x05usage\x88\x01\x01\x12\x1d\n" +
+	"\avoltage\x18\a \x01(\x01H\x02R\avoltage\x88\x01\x01B\b\n" +
+	"\x06_powerB\b\n" +
+	"\x06_usageB\n" +
+	"\n" +
+	"\b_voltage\"\x8f\x03\n" +
+	"\aProcess\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x01R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\tcpu_usage\x18\x03 \x01(\x01H\x01R\bcpuUsage\x88\x01\x01\x12\x1d\n" +
+	"\acreated\x18\x04 \x01(\x01H\x02R\acreated\x88\x01\x01\x12&\n" +
+	"\fmemory_usage\x18\x05 \x01(\x01H\x03R\vmemoryUsage\x88\x01\x01\x12\x17\n" +
+	"\x04path\x18\x06 \x01(\tH\x04R\x04path\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\a \x01(\tH\x05R\x06status\x88\x01\x01\x12\x1f\n" +
+	"\busername\x18\b \x01(\tH\x06R\busername\x88\x01\x01\x120\n" +
+	"\x11working_directory\x18\t \x01(\tH\aR\x10workingDirectory\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_cpu_usageB\n" +
+	"\n" +
+	"\b_createdB\x0f\n" +
+	"\r_memory_usageB\a\n" +
+	"\x05_pathB\t\n" +
+	"\a_statusB\v\n" +
+	"\t_usernameB\x14\n" +
+	"\x12_working_directory\"?\n" +
+	"\rProcessesData\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.systembridge.v1.ProcessR\x05items\"\xc5\x01\n" +
+	"\vSensorsData\x12*\n" +
+	"\x04fans\x18\x01 \x01(\v2\x16.google.protobuf.ValueR\x04fans\x12@\n" +
+	"\ftemperatures\x18\x02 \x03(\v2\x1c.systembridge.v1.TemperatureR\ftemperatures\x12H\n" +
+	"\x0fwindows_sensors\x18\x03 \x01(\v2\x1f.systembridge.v1.SensorsWindowsR\x0ewindowsSensors\"\x88\x02\n" +
+	"\rSensorsNVIDIA\x12?\n" +
+	"\achipset\x18\x01 \x01(\v2%.systembridge.v1.SensorsNVIDIAChipsetR\achipset\x12A\n" +
+	"\bdisplays\x18\x02 \x03(\v2%.systembridge.v1.SensorsNVIDIADisplayR\bdisplays\x12<\n" +
+	"\x06driver\x18\x03 \x01(\v2$.systembridge.v1.SensorsNVIDIADriverR\x06driver\x125\n" +
+	"\x04gpus\x18\x04 \x03(\v2!.systembridge.v1.SensorsNVIDIAGPUR\x04gpus\"\x8e\x01\n" +
+	"\x14SensorsNVIDIAChipset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05flags\x18\x03 \x01(\tR\x05flags\x12\x1b\n" +
+	"\tvendor_id\x18\x04 \x01(\x03R\bvendorId\x12\x1f\n" +
+	"\vvendor_name\x18\x05 \x01(\tR\n" +
+	"vendorName\"\x9e\x05\n" +
+	"\x14SensorsNVIDIADisplay\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x03 \x01(\bR\x06active\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\x12\x1c\n" +
+	"\tconnected\x18\x05 \x01(\bR\tconnected\x12\x18\n" +
+	"\adynamic\x18\x06 \x01(\bR\adynamic\x12+\n" +
+	"\x11aspect_horizontal\x18\a \x01(\x03R\x10aspectHorizontal\x12'\n" +
+	"\x0faspect_vertical\x18\b \x01(\x03R\x0easpectVertical\x12-\n" +
+	"\x12brightness_current\x18\t \x01(\x03R\x11brightnessCurrent\x12-\n" +
+	"\x12brightness_default\x18\n" +
+	" \x01(\x03R\x11brightnessDefault\x12%\n" +
+	"\x0ebrightness_max\x18\v \x01(\x03R\rbrightnessMax\x12%\n" +
+	"\x0ebrightness_min\x18\f \x01(\x03R\rbrightnessMin\x12\x1f\n" +
+	"\vcolor_depth\x18\r \x01(\tR\n" +
+	"colorDepth\x12'\n" +
+	"\x0fconnection_type\x18\x0e \x01(\tR\x0econnectionType\x12\x1f\n" +
+	"\vpixel_clock\x18\x0f \x01(\x03R\n" +
+	"pixelClock\x12!\n" +
+	"\frefresh_rate\x18\x10 \x01(\x03R\vrefreshRate\x123\n" +
+	"\x15resolution_horizontal\x18\x11 \x01(\x03R\x14resolutionHorizontal\x12/\n" +
+	"\x13resolution_vertical\x18\x12 \x01(\x03R\x12resolutionVertical\"\x83\x01\n" +
+	"\x13SensorsNVIDIADriver\x12%\n" +
+	"\x0ebranch_version\x18\x01 \x01(\tR\rbranchVersion\x12+\n" +
+	"\x11interface_version\x18\x02 \x01(\tR\x10interfaceVersion\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"\x95\x06\n" +
+	"\x10SensorsNVIDIAGPU\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12/\n" +
+	"\x11bios_oem_revision\x18\x03 \x01(\x03H\x00R\x0fbiosOemRevision\x88\x01\x01\x12(\n" +
+	"\rbios_revision\x18\x04 \x01(\x03H\x01R\fbiosRevision\x88\x01\x01\x12&\n" +
+	"\fbios_version\x18\x05 \x01(\tH\x02R\vbiosVersion\x88\x01\x01\x12:\n" +
+	"\x17current_fan_speed_level\x18\x06 \x01(\x03H\x03R\x14currentFanSpeedLevel\x88\x01\x01\x126\n" +
+	"\x15current_fan_speed_rpm\x18\a \x01(\x03H\x04R\x12currentFanSpeedRpm\x88\x01\x01\x12&\n" +
+	"\fdriver_model\x18\b \x01(\x03H\x05R\vdriverModel\x88\x01\x01\x12.\n" +
+	"\x10memory_available\x18\t \x01(\x03H\x06R\x0fmemoryAvailable\x88\x01\x01\x12,\n" +
+	"\x0fmemory_capacity\x18\n" +
+	" \x01(\x03H\aR\x0ememoryCapacity\x88\x01\x01\x12&\n" +
+	"\fmemory_maker\x18\v \x01(\tH\bR\vmemoryMaker\x88\x01\x01\x12\x1b\n" +
+	"\x06serial\x18\f \x01(\tH\tR\x06serial\x88\x01\x01\x12$\n" +
+	"\vsystem_type\x18\r \x01(\tH\n" +
+	"R\n" +
+	"systemType\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x0e \x01(\tH\vR\x04type\x88\x01\x01B\x14\n" +
+	"\x12_bios_oem_revisionB\x10\n" +
+	"\x0e_bios_revisionB\x0f\n" +
+	"\r_bios_versionB\x1a\n" +
+	"\x18_current_fan_speed_levelB\x18\n" +
+	"\x16_current_fan_speed_rpmB\x0f\n" +
+	"\r_driver_modelB\x13\n" +
+	"\x11_memory_availableB\x12\n" +
+	"\x10_memory_capacityB\x0f\n" +
+	"\r_memory_makerB\t\n" +
+	"\a_serialB\x0e\n" +
+	"\f_system_typeB\a\n" +
+	"\x05_type\"\x8d\x01\n" +
+	"\x0eSensorsWindows\x12C\n" +
+	"\bhardware\x18\x01 \x03(\v2'.systembridge.v1.SensorsWindowsHardwareR\bhardware\x126\n" +
+	"\x06nvidia\x18\x02 \x01(\v2\x1e.systembridge.v1.SensorsNVIDIAR\x06nvidia\"\xdc\x01\n" +
+	"\x16SensorsWindowsHardware\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12I\n" +
+	"\vsubhardware\x18\x04 \x03(\v2'.systembridge.v1.SensorsWindowsHardwareR\vsubhardware\x12?\n" +
+	"\asensors\x18\x05 \x03(\v2%.systembridge.v1.SensorsWindowsSensorR\asensors\"|\n" +
+	"\x14SensorsWindowsSensor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12,\n" +
+	"\x05value\x18\x04 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xe5\x06\n" +
+	"\n" +
+	"SystemData\x12\x1b\n" +
+	"\tboot_time\x18\x01 \x01(\x04R\bbootTime\x12\x12\n" +
+	"\x04fqdn\x18\x02 \x01(\tR\x04fqdn\x12\x1a\n" +
+	"\bhostname\x18\x03 \x01(\tR\bhostname\x12%\n" +
+	"\x0ekernel_version\x18\x04 \x01(\tR\rkernelVersion\x12 \n" +
+	"\fip_address_4\x18\x05 \x01(\tR\n" +
+	"ipAddress4\x12\x1f\n" +
+	"\vmac_address\x18\x06 \x01(\tR\n" +
+	"macAddress\x12)\n" +
+	"\x10platform_version\x18\a \x01(\tR\x0fplatformVersion\x12\x1a\n" +
+	"\bplatform\x18\b \x01(\tR\bplatform\x12$\n" +
+	"\vpower_usage\x18\t \x01(\x01H\x00R\n" +
+	"powerUsage\x88\x01\x01\x12\x16\n" +
+	"\x06uptime\x18\n" +
+	" \x01(\x04R\x06uptime\x121\n" +
+	"\x05users\x18\v \x03(\v2\x1b.systembridge.v1.SystemUserR\x05users\x12\x12\n" +
+	"\x04uuid\x18\f \x01(\tR\x04uuid\x12\x18\n" +
+	"\aversion\x18\r \x01(\tR\aversion\x12!\n" +
+	"\fcamera_usage\x18\x0e \x03(\tR\vcameraUsage\x12)\n" +
+	"\x10microphone_usage\x18\x0f \x03(\tR\x0fmicrophoneUsage\x12 \n" +
+	"\fip_address_6\x18\x10 \x01(\tR\n" +
+	"ipAddress6\x12*\n" +
+	"\x0epending_reboot\x18\x11 \x01(\bH\x01R\rpendingReboot\x88\x01\x01\x12\x19\n" +
+	"\brun_mode\x18\x12 \x01(\tR\arunMode\x121\n" +
+	"\x12version_latest_url\x18\x13 \x01(\tH\x02R\x10versionLatestUrl\x88\x01\x01\x12*\n" +
+	"\x0eversion_latest\x18\x14 \x01(\tH\x03R\rversionLatest\x88\x01\x01\x12;\n" +
+	"\x17version_newer_available\x18\x15 \x01(\bH\x04R\x15versionNewerAvailable\x88\x01\x01B\x0e\n" +
+	"\f_power_usageB\x11\n" +
+	"\x0f_pending_rebootB\x15\n" +
+	"\x13_version_latest_urlB\x11\n" +
+	"\x0f_version_latestB\x1a\n" +
+	"\x18_version_newer_available\"\x94\x01\n" +
+	"\n" +
+	"SystemUser\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x12\x1a\n" +
+	"\bterminal\x18\x03 \x01(\tR\bterminal\x12\x12\n" +
+	"\x04host\x18\x04 \x01(\tR\x04host\x12\x18\n" +
+	"\astarted\x18\x05 \x01(\x03R\astarted\x12\x10\n" +
+	"\x03pid\x18\x06 \x01(\x01R\x03pid\"q\n" +
+	"\vTemperature\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12 \n" +
+	"\vtemperature\x18\x02 \x01(\x01R\vtemperature\x12\x12\n" +
+	"\x04high\x18\x03 \x01(\x01R\x04high\x12\x1a\n" +
+	"\bcritical\x18\x04 \x01(\x01R\bcriticalBHZFgithub.com/timmo001/system-bridge/proto/systembridge/v1;systembridgev1b\x06proto3"
+
+var (
+	file_systembridge_v1_types_proto_rawDescOnce sync.Once
+	file_systembridge_v1_types_proto_rawDescData []byte
+)
+
+func file_systembridge_v1_types_proto_rawDescGZIP() []byte {
+	file_systembridge_v1_types_proto_rawDescOnce.Do(func() {
+		file_systembridge_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_systembridge_v1_types_proto_rawDesc), len(file_systembridge_v1_types_proto_rawDesc)))
+	})
+	return file_systembridge_v1_types_proto_rawDescData
+}
+
+var file_systembridge_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_systembridge_v1_types_proto_goTypes = []any{
+	(*BatteryData)(nil),            // 0: systembridge.v1.BatteryData
+	(*CPUData)(nil),                // 1: systembridge.v1.CPUData
+	(*CPUFrequency)(nil),           // 2: systembridge.v1.CPUFrequency
+	(*CPUStats)(nil),               // 3: systembridge.v1.CPUStats
+	(*CPUTimes)(nil),               // 4: systembridge.v1.CPUTimes
+	(*Disk)(nil),                   // 5: systembridge.v1.Disk
+	(*DiskIOCounters)(nil),         // 6: systembridge.v1.DiskIOCounters
+	(*DiskPartition)(nil),          // 7: systembridge.v1.DiskPartition
+	(*DiskUsage)(nil),              // 8: systembridge.v1.DiskUsage
+	(*DisksData)(nil),              // 9: systembridge.v1.DisksData
+	(*Display)(nil),                // 10: systembridge.v1.Display
+	(*DisplaysData)(nil),           // 11: systembridge.v1.DisplaysData
+	(*GPU)(nil),                    // 12: systembridge.v1.GPU
+	(*GPUsData)(nil),               // 13: systembridge.v1.GPUsData
+	(*MediaData)(nil),              // 14: systembridge.v1.MediaData
+	(*MediaPlayer)(nil),            // 15: systembridge.v1.MediaPlayer
+	(*MemoryData)(nil),             // 16: systembridge.v1.MemoryData
+	(*MemorySwap)(nil),             // 17: systembridge.v1.MemorySwap
+	(*MemoryVirtual)(nil),          // 18: systembridge.v1.MemoryVirtual
+	(*Module)(nil),                 // 19: systembridge.v1.Module
+	(*Network)(nil),                // 20: systembridge.v1.Network
+	(*NetworkAddress)(nil),         // 21: systembridge.v1.NetworkAddress
+	(*NetworkConnection)(nil),      // 22: systembridge.v1.NetworkConnection
+	(*NetworkIO)(nil),              // 23: systembridge.v1.NetworkIO
+	(*NetworkStats)(nil),           // 24: systembridge.v1.NetworkStats
+	(*NetworksData)(nil),           // 25: systembridge.v1.NetworksData
+	(*PerCPU)(nil),                 // 26: systembridge.v1.PerCPU
+	(*Process)(nil),                // 27: systembridge.v1.Process
+	(*ProcessesData)(nil),          // 28: systembridge.v1.ProcessesData
+	(*SensorsData)(nil),            // 29: systembridge.v1.SensorsData
+	(*SensorsNVIDIA)(nil),          // 30: systembridge.v1.SensorsNVIDIA
+	(*SensorsNVIDIAChipset)(nil),   // 31: systembridge.v1.SensorsNVIDIAChipset
+	(*SensorsNVIDIADisplay)(nil),   // 32: systembridge.v1.SensorsNVIDIADisplay
+	(*SensorsNVIDIADriver)(nil),    // 33: systembridge.v1.SensorsNVIDIADriver
+	(*SensorsNVIDIAGPU)(nil),       // 34: systembridge.v1.SensorsNVIDIAGPU
+	(*SensorsWindows)(nil),         // 35: systembridge.v1.SensorsWindows
+	(*SensorsWindowsHardware)(nil), // 36: systembridge.v1.SensorsWindowsHardware
+	(*SensorsWindowsSensor)(nil),   // 37: systembridge.v1.SensorsWindowsSensor
+	(*SystemData)(nil),             // 38: systembridge.v1.SystemData
+	(*SystemUser)(nil),             // 39: systembridge.v1.SystemUser
+	(*Temperature)(nil),            // 40: systembridge.v1.Temperature
+	(*structpb.Value)(nil),         // 41: google.protobuf.Value
+}
+var file_systembridge_v1_types_proto_depIdxs = []int32{
+	2,  // 0: systembridge.v1.CPUData.frequency:type_name -> systembridge.v1.CPUFrequency
+	26, // 1: systembridge.v1.CPUData.per_cpu:type_name -> systembridge.v1.PerCPU
+	3,  // 2: systembridge.v1.CPUData.stats:type_name -> systembridge.v1.CPUStats
+	4,  // 3: systembridge.v1.CPUData.times:type_name -> systembridge.v1.CPUTimes
+	4,  // 4: systembridge.v1.CPUData.times_percent:type_name -> systembridge.v1.CPUTimes
+	7,  // 5: systembridge.v1.Disk.partitions:type_name -> systembridge.v1.DiskPartition
+	6,  // 6: systembridge.v1.Disk.io_counters:type_name -> systembridge.v1.DiskIOCounters
+	8,  // 7: systembridge.v1.DiskPartition.usage:type_name -> systembridge.v1.DiskUsage
+	5,  // 8: systembridge.v1.DisksData.devices:type_name -> systembridge.v1.Disk
+	6,  // 9: systembridge.v1.DisksData.io_counters:type_name -> systembridge.v1.DiskIOCounters
+	10, // 10: systembridge.v1.DisplaysData.items:type_name -> systembridge.v1.Display
+	12, // 11: systembridge.v1.GPUsData.items:type_name -> systembridge.v1.GPU
+	15, // 12: systembridge.v1.MediaData.players:type_name -> systembridge.v1.MediaPlayer
+	17, // 13: systembridge.v1.MemoryData.swap:type_name -> systembridge.v1.MemorySwap
+	18, // 14: systembridge.v1.MemoryData.virtual:type_name -> systembridge.v1.MemoryVirtual
+	41, // 15: systembridge.v1.Module.data:type_name -> google.protobuf.Value
+	21, // 16: systembridge.v1.Network.addresses:type_name -> systembridge.v1.NetworkAddress
+	24, // 17: systembridge.v1.Network.stats:type_name -> systembridge.v1.NetworkStats
+	22, // 18: systembridge.v1.NetworksData.connections:type_name -> systembridge.v1.NetworkConnection
+	23, // 19: systembridge.v1.NetworksData.io:type_name -> systembridge.v1.NetworkIO
+	20, // 20: systembridge.v1.NetworksData.networks:type_name -> systembridge.v1.Network
+	2,  // 21: systembridge.v1.PerCPU.frequency:type_name -> systembridge.v1.CPUFrequency
+	4,  // 22: systembridge.v1.PerCPU.times:type_name -> systembridge.v1.CPUTimes
+	4,  // 23: systembridge.v1.PerCPU.times_percent:type_name -> systembridge.v1.CPUTimes
+	27, // 24: systembridge.v1.ProcessesData.items:type_name -> systembridge.v1.Process
+	41, // 25: systembridge.v1.SensorsData.fans:type_name -> google.protobuf.Value
+	40, // 26: systembridge.v1.SensorsData.temperatures:type_name -> systembridge.v1.Temperature
+	35, // 27: systembridge.v1.SensorsData.windows_sensors:type_name -> systembridge.v1.SensorsWindows
+	31, // 28: systembridge.v1.SensorsNVIDIA.chipset:type_name -> systembridge.v1.SensorsNVIDIAChipset
+	32, // 29: systembridge.v1.SensorsNVIDIA.displays:type_name -> systembridge.v1.SensorsNVIDIADisplay
+	33, // 30: systembridge.v1.SensorsNVIDIA.driver:type_name -> systembridge.v1.SensorsNVIDIADriver
+	34, // 31: systembridge.v1.SensorsNVIDIA.gpus:type_name -> systembridge.v1.SensorsNVIDIAGPU
+	36, // 32: systembridge.v1.SensorsWindows.hardware:type_name -> systembridge.v1.SensorsWindowsHardware
+	30, // 33: systembridge.v1.SensorsWindows.nvidia:type_name -> systembridge.v1.SensorsNVIDIA
+	36, // 34: systembridge.v1.SensorsWindowsHardware.subhardware:type_name -> systembridge.v1.SensorsWindowsHardware
+	37, // 35: systembridge.v1.SensorsWindowsHardware.sensors:type_name -> systembridge.v1.SensorsWindowsSensor
+	41, // 36: systembridge.v1.SensorsWindowsSensor.value:type_name -> google.protobuf.Value
+	39, // 37: systembridge.v1.SystemData.users:type_name -> systembridge.v1.SystemUser
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_systembridge_v1_types_proto_init() }
+func file_systembridge_v1_types_proto_init() {
+	if File_systembridge_v1_types_proto != nil {
+		return
+	}
+	file_systembridge_v1_types_proto_msgTypes[0].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[1].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[2].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[3].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[4].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[10].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[12].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[14].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[15].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[17].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[18].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[20].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[21].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[22].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[23].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[24].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[26].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[27].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[34].OneofWrappers = []any{}
+	file_systembridge_v1_types_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_systembridge_v1_types_proto_rawDesc), len(file_systembridge_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_systembridge_v1_types_proto_goTypes,
+		DependencyIndexes: file_systembridge_v1_types_proto_depIdxs,
+		MessageInfos:      file_systembridge_v1_types_proto_msgTypes,
+	}.Build()
+	File_systembridge_v1_types_proto = out.File
+	file_systembridge_v1_types_proto_goTypes = nil
+	file_systembridge_v1_types_proto_depIdxs = nil
+}
@@ -0,0 +1,24 @@
+package scheduler
+
+import (
+	"sync"
+)
+
+var (
+	globalInstance *Scheduler
+	instanceMutex  sync.RWMutex
+)
+
+// GetInstance returns the global scheduler instance
+func GetInstance() *Scheduler {
+	instanceMutex.RLock()
+	defer instanceMutex.RUnlock()
+	return globalInstance
+}
+
+// SetInstance sets the global scheduler instance
+func SetInstance(instance *Scheduler) {
+	instanceMutex.Lock()
+	defer instanceMutex.Unlock()
+	globalInstance = instance
+}
@@ -0,0 +1,428 @@
+// Package scheduler sends router events on the cron and one-shot schedules
+// configured in the settings.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils"
+	"github.com/timmo001/system-bridge/utils/cron"
+)
+
+const (
+	// Connection is the connection events sent by the scheduler come from
+	Connection = "scheduler"
+	// MissedRunGracePeriod is how late a run can be and still happen, such as
+	// after a restart. Runs missed by longer are skipped.
+	MissedRunGracePeriod = 5 * time.Minute
+	// maxWait is the longest the scheduler sleeps before checking the
+	// settings for changes
+	maxWait = time.Minute
+	// stateFileName is the name of the state file in the data directory
+	stateFileName = "schedule_state.json"
+)
+
+// Run statuses
+const (
+	StatusOK      = "ok"
+	StatusError   = "error"
+	StatusSkipped = "skipped"
+)
+
+// ErrScheduleNotFound is returned when a schedule ID does not match a schedule
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+// State is the persisted run state of a schedule
+type State struct {
+	// Spec is the cron expression or timestamp the state was computed for, so
+	// the next run is recomputed when a schedule changes
+	Spec       string     `json:"spec" mapstructure:"spec"`
+	NextRun    *time.Time `json:"nextRun,omitempty" mapstructure:"nextRun"`
+	LastRun    *time.Time `json:"lastRun,omitempty" mapstructure:"lastRun"`
+	LastStatus string     `json:"lastStatus,omitempty" mapstructure:"lastStatus"`
+	LastError  string     `json:"lastError,omitempty" mapstructure:"lastError"`
+}
+
+// Schedule is a configured schedule with its run state
+type Schedule struct {
+	settings.SettingsSchedule `mapstructure:",squash"`
+	State                     `mapstructure:",squash"`
+}
+
+// Scheduler sends the events of due schedules through the router
+type Scheduler struct {
+	router       *event.MessageRouter
+	loadSettings func() (*settings.Settings, error)
+	now          func() time.Time
+
+	mutex  sync.Mutex
+	state  map[string]*State
+	loaded bool
+	wake   chan struct{}
+	// running are the IDs of schedules whose events are being sent
+	running map[string]bool
+	runs    sync.WaitGroup
+}
+
+// NewScheduler creates a scheduler that sends events through the router
+func NewScheduler(router *event.MessageRouter) *Scheduler {
+	return &Scheduler{
+		router:       router,
+		loadSettings: settings.Load,
+		now:          time.Now,
+		state:        make(map[string]*State),
+		wake:         make(chan struct{}, 1),
+		running:      make(map[string]bool),
+	}
+}
+
+// Run runs due schedules until the context is canceled
+func (s *Scheduler) Run(ctx context.Context) {
+	slog.Info("Scheduler started")
+	for {
+		wait := s.tick()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			slog.Info("Scheduler stopped")
+			return
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// Reload makes the scheduler pick up changed settings straight away
+func (s *Scheduler) Reload() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// List returns the configured schedules with their run state
+func (s *Scheduler) List() ([]Schedule, error) {
+	cfg, err := s.loadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.syncState(cfg.Schedules, s.now())
+
+	schedules := make([]Schedule, 0, len(cfg.Schedules))
+	for _, schedule := range cfg.Schedules {
+		schedules = append(schedules, Schedule{SettingsSchedule: schedule, State: *s.state[schedule.ID]})
+	}
+	return schedules, nil
+}
+
+// Create adds a schedule to the settings, generating an ID if it has none
+func (s *Scheduler) Create(schedule settings.SettingsSchedule) (settings.SettingsSchedule, error) {
+	if schedule.ID == "" {
+		schedule.ID = uuid.NewString()
+	}
+	if err := settings.ValidateSchedule(schedule); err != nil {
+		return settings.SettingsSchedule{}, err
+	}
+
+	cfg, err := s.loadSettings()
+	if err != nil {
+		return settings.SettingsSchedule{}, err
+	}
+	for _, existing := range cfg.Schedules {
+		if existing.ID == schedule.ID {
+			return settings.SettingsSchedule{}, fmt.Errorf("duplicate schedule ID: %s", schedule.ID)
+		}
+	}
+
+	cfg.Schedules = append(cfg.Schedules, schedule)
+	if err := cfg.Save(); err != nil {
+		return settings.SettingsSchedule{}, err
+	}
+
+	slog.Info("Created schedule", "id", schedule.ID, "name", schedule.Name, "event", schedule.Event)
+	s.Reload()
+	return schedule, nil
+}
+
+// Delete removes a schedule from the settings
+func (s *Scheduler) Delete(id string) error {
+	cfg, err := s.loadSettings()
+	if err != nil {
+		return err
+	}
+
+	schedules := make([]settings.SettingsSchedule, 0, len(cfg.Schedules))
+	for _, schedule := range cfg.Schedules {
+		if schedule.ID != id {
+			schedules = append(schedules, schedule)
+		}
+	}
+	if len(schedules) == len(cfg.Schedules) {
+		return fmt.Errorf("%w: %s", ErrScheduleNotFound, id)
+	}
+
+	cfg.Schedules = schedules
+	if err := cfg.Save(); err != nil {
+		return err
+	}
+
+	slog.Info("Deleted schedule", "id", id)
+	s.Reload()
+	return nil
+}
+
+// tick runs the schedules that are due and returns how long to wait until
+// the next one
+func (s *Scheduler) tick() time.Duration {
+	cfg, err := s.loadSettings()
+	if err != nil {
+		slog.Error("Scheduler failed to load settings", "error", err)
+		return maxWait
+	}
+
+	now := s.now()
+
+	s.mutex.Lock()
+	changed := s.syncState(cfg.Schedules, now)
+	due := make([]settings.SettingsSchedule, 0)
+	for _, schedule := range cfg.Schedules {
+		state := s.state[schedule.ID]
+		if schedule.Disabled || state.NextRun == nil || state.NextRun.After(now) {
+			continue
+		}
+		due = append(due, schedule)
+	}
+	s.mutex.Unlock()
+
+	for _, schedule := range due {
+		s.runSchedule(schedule, now)
+	}
+	if changed || len(due) > 0 {
+		s.save()
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	wait := maxWait
+	for _, schedule := range cfg.Schedules {
+		state := s.state[schedule.ID]
+		if schedule.Disabled || state.NextRun == nil {
+			continue
+		}
+		if until := state.NextRun.Sub(now); until < wait {
+			wait = max(until, time.Second)
+		}
+	}
+	return wait
+}
+
+// runSchedule works out a due schedule's next run, then sends its event in
+// the background, or skips it if it is too late or its last run has not
+// finished
+func (s *Scheduler) runSchedule(schedule settings.SettingsSchedule, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state := s.state[schedule.ID]
+	scheduledFor := *state.NextRun
+	state.NextRun = nextRun(schedule, now)
+
+	if late := now.Sub(scheduledFor); late > MissedRunGracePeriod {
+		slog.Warn("Skipping missed schedule run", "id", schedule.ID, "name", schedule.Name, "scheduledFor", scheduledFor, "late", late)
+		s.recordRun(schedule.ID, now, StatusSkipped, fmt.Sprintf("missed by %s", late.Round(time.Second)))
+		return
+	}
+	if s.running[schedule.ID] {
+		slog.Warn("Skipping schedule run, as its last run has not finished", "id", schedule.ID, "name", schedule.Name)
+		s.recordRun(schedule.ID, now, StatusSkipped, "last run has not finished")
+		return
+	}
+
+	s.running[schedule.ID] = true
+	s.runs.Add(1)
+	go func() {
+		defer s.runs.Done()
+		slog.Info("Running schedule", "id", schedule.ID, "name", schedule.Name, "event", schedule.Event)
+		response := s.router.HandleMessage(Connection, event.Message{
+			ID:    uuid.NewString(),
+			Event: event.EventType(schedule.Event),
+			Data:  schedule.Data,
+		})
+		status, errorMessage := StatusOK, ""
+		if response.Type == event.ResponseTypeError {
+			slog.Error("Schedule event failed", "id", schedule.ID, "event", schedule.Event, "error", response.Message)
+			status, errorMessage = StatusError, response.Message
+		}
+
+		s.mutex.Lock()
+		delete(s.running, schedule.ID)
+		s.recordRun(schedule.ID, now, status, errorMessage)
+		s.mutex.Unlock()
+		s.save()
+	}()
+}
+
+// recordRun records the outcome of a run in a schedule's state, unless the
+// schedule has since been deleted. It must be called with the mutex held.
+func (s *Scheduler) recordRun(id string, at time.Time, status, errorMessage string) {
+	state, ok := s.state[id]
+	if !ok {
+		return
+	}
+	state.LastRun = &at
+	state.LastStatus = status
+	state.LastError = errorMessage
+}
+
+// syncState adds state for new or changed schedules and removes it for
+// deleted ones, returning whether anything changed. It must be called with
+// the mutex held.
+func (s *Scheduler) syncState(schedules []settings.SettingsSchedule, now time.Time) bool {
+	if !s.loaded {
+		s.loaded = true
+		state, err := loadState()
+		if err != nil {
+			slog.Warn("Failed to load schedule state", "error", err)
+		} else if state != nil {
+			s.state = state
+		}
+	}
+
+	changed := false
+	ids := make(map[string]bool, len(schedules))
+	for _, schedule := range schedules {
+		ids[schedule.ID] = true
+		spec := scheduleSpec(schedule)
+		if state, ok := s.state[schedule.ID]; ok && state.Spec == spec {
+			continue
+		}
+		s.state[schedule.ID] = &State{Spec: spec, NextRun: firstRun(schedule, now)}
+		changed = true
+	}
+	for id := range s.state {
+		if !ids[id] {
+			delete(s.state, id)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// save persists the run state
+func (s *Scheduler) save() {
+	s.mutex.Lock()
+	content, err := json.Marshal(s.state)
+	s.mutex.Unlock()
+	if err != nil {
+		slog.Error("Failed to encode schedule state", "error", err)
+		return
+	}
+
+	if err := saveState(content); err != nil {
+		slog.Error("Failed to save schedule state", "error", err)
+	}
+}
+
+// scheduleSpec returns the timing of a schedule. Disabling a schedule
+// changes it, so runs missed while disabled are not made up.
+func scheduleSpec(schedule settings.SettingsSchedule) string {
+	spec := "at:" + schedule.At
+	if schedule.Cron != "" {
+		spec = "cron:" + schedule.Cron
+	}
+	if schedule.Disabled {
+		spec += ";disabled"
+	}
+	return spec
+}
+
+// firstRun returns when a new schedule first runs. A one-shot schedule for a
+// time that has already passed still gets a run, which is skipped if it is
+// later than the grace period.
+func firstRun(schedule settings.SettingsSchedule, now time.Time) *time.Time {
+	if schedule.At != "" {
+		at, err := time.Parse(time.RFC3339, schedule.At)
+		if err != nil {
+			return nil
+		}
+		return &at
+	}
+	return nextRun(schedule, now)
+}
+
+// nextRun returns the run of a schedule after now, or nil if it has none
+func nextRun(schedule settings.SettingsSchedule, now time.Time) *time.Time {
+	if schedule.Cron == "" {
+		return nil
+	}
+	expression, err := cron.Parse(schedule.Cron)
+	if err != nil {
+		return nil
+	}
+	next := expression.Next(now.Local())
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// statePath returns the path of the state file
+func statePath() (string, error) {
+	dataPath, err := utils.GetDataPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataPath, stateFileName), nil
+}
+
+// loadState reads the persisted run state
+func loadState() (map[string]*State, error) {
+	path, err := statePath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	state := make(map[string]*State)
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// saveState persists the run state, replacing the file atomically
+func saveState(content []byte) error {
+	path, err := statePath()
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
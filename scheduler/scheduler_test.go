@@ -0,0 +1,228 @@
+package scheduler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/event"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// recorder records the events sent through a router
+type recorder struct {
+	mutex    sync.Mutex
+	messages []event.Message
+}
+
+func (r *recorder) events() []event.Message {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]event.Message(nil), r.messages...)
+}
+
+// newTestScheduler returns a scheduler over the given schedules, with a clock
+// set by the returned function
+func newTestScheduler(t *testing.T, schedules []settings.SettingsSchedule, start time.Time) (*Scheduler, *recorder, func(time.Time)) {
+	t.Helper()
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+
+	rec := &recorder{}
+	router := event.NewMessageRouter()
+	handler := func(connection string, message event.Message) event.MessageResponse {
+		rec.mutex.Lock()
+		defer rec.mutex.Unlock()
+		rec.messages = append(rec.messages, message)
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeNotificationSent}
+	}
+	router.RegisterSimpleHandler(event.EventNotification, handler)
+	router.RegisterSimpleHandler(event.EventPowerLock, handler)
+
+	now := start
+	s := NewScheduler(router)
+	s.loadSettings = func() (*settings.Settings, error) {
+		return &settings.Settings{Schedules: schedules}, nil
+	}
+	s.now = func() time.Time { return now }
+	return s, rec, func(t time.Time) { now = t }
+}
+
+// runDue runs the schedules that are due and waits for their events to be
+// sent
+func runDue(s *Scheduler) {
+	s.tick()
+	s.runs.Wait()
+}
+
+func TestSchedulerRunsCronSchedules(t *testing.T) {
+	start := time.Date(2026, 3, 2, 8, 59, 30, 0, time.Local)
+	s, rec, setNow := newTestScheduler(t, []settings.SettingsSchedule{
+		{ID: "hourly", Cron: "0 * * * *", Event: string(event.EventNotification), Data: map[string]any{"title": "Hourly"}},
+	}, start)
+
+	runDue(s)
+	assert.Empty(t, rec.events(), "nothing is due before the first run")
+
+	schedules, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.NotNil(t, schedules[0].NextRun)
+	assert.Equal(t, time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local), *schedules[0].NextRun)
+
+	setNow(time.Date(2026, 3, 2, 9, 0, 5, 0, time.Local))
+	runDue(s)
+	events := rec.events()
+	require.Len(t, events, 1)
+	assert.Equal(t, event.EventNotification, events[0].Event)
+	assert.Equal(t, map[string]any{"title": "Hourly"}, events[0].Data)
+
+	schedules, err = s.List()
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, schedules[0].LastStatus)
+	assert.Equal(t, time.Date(2026, 3, 2, 10, 0, 0, 0, time.Local), *schedules[0].NextRun)
+
+	runDue(s)
+	assert.Len(t, rec.events(), 1, "a run is not repeated")
+}
+
+func TestSchedulerRunsOneShotSchedulesOnce(t *testing.T) {
+	start := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	s, rec, setNow := newTestScheduler(t, []settings.SettingsSchedule{
+		{ID: "once", At: "2026-03-02T08:30:00Z", Event: string(event.EventPowerLock)},
+	}, start)
+
+	runDue(s)
+	assert.Empty(t, rec.events())
+
+	setNow(start.Add(31 * time.Minute))
+	runDue(s)
+	runDue(s)
+	assert.Len(t, rec.events(), 1)
+
+	schedules, err := s.List()
+	require.NoError(t, err)
+	assert.Nil(t, schedules[0].NextRun)
+	assert.Equal(t, StatusOK, schedules[0].LastStatus)
+}
+
+func TestSchedulerSkipsMissedRuns(t *testing.T) {
+	start := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	s, rec, _ := newTestScheduler(t, []settings.SettingsSchedule{
+		{ID: "missed", At: "2026-03-02T07:00:00Z", Event: string(event.EventPowerLock)},
+		{ID: "late", At: "2026-03-02T07:58:00Z", Event: string(event.EventPowerLock)},
+	}, start)
+
+	runDue(s)
+	assert.Len(t, rec.events(), 1, "only the run within the grace period happens")
+
+	schedules, err := s.List()
+	require.NoError(t, err)
+	assert.Equal(t, StatusSkipped, schedules[0].LastStatus)
+	assert.Equal(t, StatusOK, schedules[1].LastStatus)
+}
+
+func TestSchedulerRecordsFailedEvents(t *testing.T) {
+	start := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	s, _, _ := newTestScheduler(t, []settings.SettingsSchedule{
+		{ID: "unknown", At: "2026-03-02T08:00:00Z", Event: "NOT_AN_EVENT"},
+	}, start)
+
+	runDue(s)
+
+	schedules, err := s.List()
+	require.NoError(t, err)
+	assert.Equal(t, StatusError, schedules[0].LastStatus)
+	assert.NotEmpty(t, schedules[0].LastError)
+}
+
+func TestSchedulerDoesNotWaitForEvents(t *testing.T) {
+	start := time.Date(2026, 3, 2, 8, 59, 30, 0, time.Local)
+	s, _, setNow := newTestScheduler(t, []settings.SettingsSchedule{
+		{ID: "slow", Cron: "* * * * *", Event: string(event.EventMediaControl)},
+	}, start)
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	s.router.RegisterSimpleHandler(event.EventMediaControl, func(connection string, message event.Message) event.MessageResponse {
+		started <- struct{}{}
+		<-release
+		return event.MessageResponse{ID: message.ID, Type: event.ResponseTypeMediaControlled}
+	})
+	runDue(s)
+
+	setNow(time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local))
+	s.tick()
+	<-started
+	schedules, err := s.List()
+	require.NoError(t, err)
+	assert.Nil(t, schedules[0].LastRun, "the run is recorded when it finishes")
+	assert.Equal(t, time.Date(2026, 3, 2, 9, 1, 0, 0, time.Local), *schedules[0].NextRun)
+
+	// The next run is skipped while the last one is still sending its event
+	setNow(time.Date(2026, 3, 2, 9, 1, 0, 0, time.Local))
+	s.tick()
+	schedules, err = s.List()
+	require.NoError(t, err)
+	assert.Equal(t, StatusSkipped, schedules[0].LastStatus)
+
+	close(release)
+	s.runs.Wait()
+	assert.Len(t, started, 0)
+	schedules, err = s.List()
+	require.NoError(t, err)
+	assert.Equal(t, StatusOK, schedules[0].LastStatus)
+	assert.Equal(t, time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local), *schedules[0].LastRun)
+}
+
+func TestSchedulerPersistsState(t *testing.T) {
+	schedules := []settings.SettingsSchedule{
+		{ID: "daily", Cron: "@daily", Event: string(event.EventPowerLock)},
+	}
+	start := time.Date(2026, 3, 2, 23, 59, 0, 0, time.Local)
+	s, rec, _ := newTestScheduler(t, schedules, start)
+	runDue(s)
+
+	// A restart after the run was due, within the grace period, still runs it
+	restarted := NewScheduler(s.router)
+	restarted.loadSettings = s.loadSettings
+	restarted.now = func() time.Time { return time.Date(2026, 3, 3, 0, 2, 0, 0, time.Local) }
+
+	runDue(restarted)
+	assert.Len(t, rec.events(), 1)
+
+	list, err := restarted.List()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local), *list[0].NextRun)
+}
+
+func TestSchedulerCreateAndDelete(t *testing.T) {
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	viper.Reset()
+
+	s := NewScheduler(event.NewMessageRouter())
+
+	created, err := s.Create(settings.SettingsSchedule{Name: "Lock", Cron: "0 22 * * *", Event: string(event.EventPowerLock)})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	_, err = s.Create(settings.SettingsSchedule{ID: created.ID, Cron: "0 22 * * *", Event: string(event.EventPowerLock)})
+	assert.Error(t, err, "duplicate IDs are rejected")
+
+	_, err = s.Create(settings.SettingsSchedule{Cron: "not cron", Event: string(event.EventPowerLock)})
+	assert.Error(t, err)
+
+	schedules, err := s.List()
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "Lock", schedules[0].Name)
+	assert.NotNil(t, schedules[0].NextRun)
+
+	require.NoError(t, s.Delete(created.ID))
+	assert.ErrorIs(t, s.Delete(created.ID), ErrScheduleNotFound)
+
+	schedules, err = s.List()
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+}
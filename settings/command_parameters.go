@@ -0,0 +1,82 @@
+package settings
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+var (
+	// CommandParameterPlaceholder matches {{name}} placeholders in command arguments
+	CommandParameterPlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+	// commandParameterName matches valid parameter names
+	commandParameterName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// validateCommandParameters validates the parameter declarations of a command
+// and that every placeholder in its arguments refers to one
+func validateCommandParameters(cmd SettingsCommandDefinition) error {
+	declared := make(map[string]bool, len(cmd.Parameters))
+	for _, param := range cmd.Parameters {
+		if !commandParameterName.MatchString(param.Name) {
+			return fmt.Errorf("command %s has invalid parameter name %q", cmd.ID, param.Name)
+		}
+		if declared[param.Name] {
+			return fmt.Errorf("command %s has duplicate parameter %s", cmd.ID, param.Name)
+		}
+		declared[param.Name] = true
+
+		if err := validateCommandParameter(param); err != nil {
+			return fmt.Errorf("command %s parameter %s: %w", cmd.ID, param.Name, err)
+		}
+	}
+
+	for _, arg := range cmd.Arguments {
+		for _, match := range CommandParameterPlaceholder.FindAllStringSubmatch(arg, -1) {
+			if !declared[match[1]] {
+				return fmt.Errorf("command %s argument %q uses undeclared parameter %s", cmd.ID, arg, match[1])
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCommandParameter validates a single parameter declaration
+func validateCommandParameter(param SettingsCommandParameter) error {
+	if param.Pattern != "" {
+		if _, err := regexp.Compile(param.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+
+	switch param.Type {
+	case CommandParameterTypeString:
+	case CommandParameterTypeInt:
+		if param.Min != nil && param.Max != nil && *param.Min > *param.Max {
+			return fmt.Errorf("min %d is greater than max %d", *param.Min, *param.Max)
+		}
+		if param.Default != "" {
+			if _, err := strconv.Atoi(param.Default); err != nil {
+				return fmt.Errorf("default %q is not an integer", param.Default)
+			}
+		}
+	case CommandParameterTypeEnum:
+		if len(param.Values) == 0 {
+			return fmt.Errorf("enum has no values")
+		}
+		if param.Default != "" && !slices.Contains(param.Values, param.Default) {
+			return fmt.Errorf("default %q is not one of the enum values", param.Default)
+		}
+	case CommandParameterTypePath:
+		if param.Directory == "" || !filepath.IsAbs(param.Directory) {
+			return fmt.Errorf("path parameters need an absolute directory")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", param.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCommandParameters(t *testing.T) {
+	intPtr := func(value int) *int { return &value }
+
+	tests := []struct {
+		name    string
+		cmd     SettingsCommandDefinition
+		wantErr string
+	}{
+		{
+			name: "Valid parameters",
+			cmd: SettingsCommandDefinition{
+				ID:        "set-brightness",
+				Arguments: []string{"--set", "{{level}}", "--output={{ output }}"},
+				Parameters: []SettingsCommandParameter{
+					{Name: "level", Type: CommandParameterTypeInt, Min: intPtr(0), Max: intPtr(100), Default: "50"},
+					{Name: "output", Type: CommandParameterTypeEnum, Values: []string{"internal", "external"}},
+				},
+			},
+		},
+		{
+			name: "Undeclared placeholder",
+			cmd: SettingsCommandDefinition{
+				ID:        "test",
+				Arguments: []string{"{{missing}}"},
+			},
+			wantErr: "undeclared parameter missing",
+		},
+		{
+			name: "Duplicate parameter",
+			cmd: SettingsCommandDefinition{
+				ID: "test",
+				Parameters: []SettingsCommandParameter{
+					{Name: "value", Type: CommandParameterTypeString},
+					{Name: "value", Type: CommandParameterTypeString},
+				},
+			},
+			wantErr: "duplicate parameter",
+		},
+		{
+			name: "Invalid name",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "my-value", Type: CommandParameterTypeString}},
+			},
+			wantErr: "invalid parameter name",
+		},
+		{
+			name: "Unknown type",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "value", Type: "float"}},
+			},
+			wantErr: "unknown type",
+		},
+		{
+			name: "Min greater than max",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "value", Type: CommandParameterTypeInt, Min: intPtr(10), Max: intPtr(1)}},
+			},
+			wantErr: "greater than max",
+		},
+		{
+			name: "Enum without values",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "value", Type: CommandParameterTypeEnum}},
+			},
+			wantErr: "enum has no values",
+		},
+		{
+			name: "Invalid pattern",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "value", Type: CommandParameterTypeString, Pattern: "["}},
+			},
+			wantErr: "invalid pattern",
+		},
+		{
+			name: "Path without directory",
+			cmd: SettingsCommandDefinition{
+				ID:         "test",
+				Parameters: []SettingsCommandParameter{{Name: "file", Type: CommandParameterTypePath, Directory: "relative"}},
+			},
+			wantErr: "absolute directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCommandParameters(tt.cmd)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package settings
+
+import (
+	"fmt"
+	"slices"
+)
+
+// MaxMacroStepDelayMs is the longest delay before a macro step
+const MaxMacroStepDelayMs = 5 * 60 * 1000
+
+// MacroErrorPolicy is what a macro does when a step fails
+type MacroErrorPolicy string
+
+const (
+	// MacroErrorPolicyStop stops the macro at the failed step
+	MacroErrorPolicyStop MacroErrorPolicy = "stop"
+	// MacroErrorPolicyContinue runs the remaining steps
+	MacroErrorPolicyContinue MacroErrorPolicy = "continue"
+)
+
+// MacroConditionOperator compares a module data value with a condition value
+type MacroConditionOperator string
+
+const (
+	MacroConditionEquals         MacroConditionOperator = "eq"
+	MacroConditionNotEquals      MacroConditionOperator = "ne"
+	MacroConditionGreater        MacroConditionOperator = "gt"
+	MacroConditionGreaterOrEqual MacroConditionOperator = "gte"
+	MacroConditionLess           MacroConditionOperator = "lt"
+	MacroConditionLessOrEqual    MacroConditionOperator = "lte"
+	MacroConditionContains       MacroConditionOperator = "contains"
+	MacroConditionExists         MacroConditionOperator = "exists"
+)
+
+var macroConditionOperators = []MacroConditionOperator{
+	MacroConditionEquals,
+	MacroConditionNotEquals,
+	MacroConditionGreater,
+	MacroConditionGreaterOrEqual,
+	MacroConditionLess,
+	MacroConditionLessOrEqual,
+	MacroConditionContains,
+	MacroConditionExists,
+}
+
+// SettingsMacroCondition checks a value in the current data of a module
+type SettingsMacroCondition struct {
+	// Module is the data module to check, such as battery or media
+	Module string `json:"module" mapstructure:"module"`
+	// Path is the dot separated path of the value in the module data, such as
+	// isCharging or displays.0.name. An empty path is the whole module data.
+	Path     string                 `json:"path,omitempty" mapstructure:"path"`
+	Operator MacroConditionOperator `json:"operator" mapstructure:"operator"`
+	// Value is compared with the module data value. It is not used by exists.
+	Value any `json:"value,omitempty" mapstructure:"value"`
+}
+
+// SettingsMacroStep is a router event sent by a macro
+type SettingsMacroStep struct {
+	// Event is the router event to send, such as MEDIA_CONTROL or POWER_LOCK
+	Event string `json:"event" mapstructure:"event"`
+	// Data is the payload of the event
+	Data any `json:"data,omitempty" mapstructure:"data"`
+	// DelayMs is how long to wait before the step
+	DelayMs int `json:"delayMs,omitempty" mapstructure:"delayMs"`
+	// Conditions must all be met for the step to run, otherwise it is skipped
+	Conditions []SettingsMacroCondition `json:"conditions,omitempty" mapstructure:"conditions"`
+	// OnError overrides the macro's error policy for this step
+	OnError MacroErrorPolicy `json:"onError,omitempty" mapstructure:"onError"`
+}
+
+// SettingsMacro is a named, ordered list of router events
+type SettingsMacro struct {
+	ID    string              `json:"id" mapstructure:"id"`
+	Name  string              `json:"name" mapstructure:"name"`
+	Steps []SettingsMacroStep `json:"steps" mapstructure:"steps"`
+	// OnError is what happens when a step fails, stopping by default
+	OnError MacroErrorPolicy `json:"onError,omitempty" mapstructure:"onError"`
+	// ShowInTray adds the macro to the tray menu
+	ShowInTray bool `json:"showInTray,omitempty" mapstructure:"showInTray"`
+}
+
+// ValidateMacro validates a macro definition
+func ValidateMacro(macro SettingsMacro) error {
+	if macro.ID == "" {
+		return fmt.Errorf("macro has empty ID")
+	}
+	if len(macro.Steps) == 0 {
+		return fmt.Errorf("macro %s has no steps", macro.ID)
+	}
+	if err := validateMacroErrorPolicy(macro.OnError); err != nil {
+		return fmt.Errorf("macro %s: %w", macro.ID, err)
+	}
+
+	for i, step := range macro.Steps {
+		if step.Event == "" {
+			return fmt.Errorf("macro %s step %d has empty event", macro.ID, i)
+		}
+		// Macros cannot run other macros, so they cannot recurse
+		if step.Event == "RUN_MACRO" {
+			return fmt.Errorf("macro %s step %d cannot run another macro", macro.ID, i)
+		}
+		if step.DelayMs < 0 || step.DelayMs > MaxMacroStepDelayMs {
+			return fmt.Errorf("macro %s step %d delayMs must be between 0 and %d", macro.ID, i, MaxMacroStepDelayMs)
+		}
+		if err := validateMacroErrorPolicy(step.OnError); err != nil {
+			return fmt.Errorf("macro %s step %d: %w", macro.ID, i, err)
+		}
+		for _, condition := range step.Conditions {
+			if condition.Module == "" {
+				return fmt.Errorf("macro %s step %d has a condition with no module", macro.ID, i)
+			}
+			if !slices.Contains(macroConditionOperators, condition.Operator) {
+				return fmt.Errorf("macro %s step %d has a condition with invalid operator %q", macro.ID, i, condition.Operator)
+			}
+			if condition.Operator != MacroConditionExists && condition.Value == nil {
+				return fmt.Errorf("macro %s step %d has a %s condition with no value", macro.ID, i, condition.Operator)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMacroErrorPolicy checks an error policy is empty or known
+func validateMacroErrorPolicy(policy MacroErrorPolicy) error {
+	switch policy {
+	case "", MacroErrorPolicyStop, MacroErrorPolicyContinue:
+		return nil
+	default:
+		return fmt.Errorf("invalid onError %q, must be stop or continue", policy)
+	}
+}
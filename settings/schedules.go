@@ -0,0 +1,51 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/timmo001/system-bridge/utils/cron"
+)
+
+// SettingsSchedule sends a router event on a cron schedule, or once at a
+// given time. Exactly one of Cron and At is set.
+type SettingsSchedule struct {
+	ID   string `json:"id" mapstructure:"id"`
+	Name string `json:"name" mapstructure:"name"`
+	// Cron is a five field cron expression, evaluated in local time
+	Cron string `json:"cron,omitempty" mapstructure:"cron"`
+	// At is an RFC 3339 timestamp to run the schedule once at
+	At string `json:"at,omitempty" mapstructure:"at"`
+	// Event is the router event to send, such as MEDIA_CONTROL or POWER_LOCK
+	Event string `json:"event" mapstructure:"event"`
+	// Data is the payload of the event
+	Data     any  `json:"data,omitempty" mapstructure:"data"`
+	Disabled bool `json:"disabled,omitempty" mapstructure:"disabled"`
+}
+
+// ValidateSchedule validates a schedule definition
+func ValidateSchedule(schedule SettingsSchedule) error {
+	if schedule.ID == "" {
+		return fmt.Errorf("schedule has empty ID")
+	}
+	if schedule.Event == "" {
+		return fmt.Errorf("schedule %s has empty event", schedule.ID)
+	}
+
+	switch {
+	case schedule.Cron != "" && schedule.At != "":
+		return fmt.Errorf("schedule %s must set only one of cron and at", schedule.ID)
+	case schedule.Cron != "":
+		if _, err := cron.Parse(schedule.Cron); err != nil {
+			return fmt.Errorf("schedule %s: %w", schedule.ID, err)
+		}
+	case schedule.At != "":
+		if _, err := time.Parse(time.RFC3339, schedule.At); err != nil {
+			return fmt.Errorf("schedule %s at must be an RFC 3339 timestamp: %w", schedule.ID, err)
+		}
+	default:
+		return fmt.Errorf("schedule %s must set cron or at", schedule.ID)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"reflect"
+	"runtime"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
@@ -69,9 +70,66 @@ func ParseLogLevel(levelStr string) (LogLevel, error) {
 	}
 }
 
+// SettingsHotkey binds a global key combination, such as ctrl+alt+l, to an
+// action. At most one of Event, MacroID and CommandID is set. Presses are
+// sent to hotkey listeners whether or not there is an action.
 type SettingsHotkey struct {
 	Name string `json:"name" mapstructure:"name"`
 	Key  string `json:"key" mapstructure:"key"`
+	// Event is a router event to send, with Data as its payload
+	Event string `json:"event,omitempty" mapstructure:"event"`
+	Data  any    `json:"data,omitempty" mapstructure:"data"`
+	// MacroID is a macro to run
+	MacroID string `json:"macroID,omitempty" mapstructure:"macroID"`
+	// CommandID is an allowlisted command to run
+	CommandID string `json:"commandID,omitempty" mapstructure:"commandID"`
+}
+
+// validateHotkey checks a hotkey has at most one action
+func validateHotkey(hotkey SettingsHotkey) error {
+	actions := 0
+	for _, action := range []string{hotkey.Event, hotkey.MacroID, hotkey.CommandID} {
+		if action != "" {
+			actions++
+		}
+	}
+	if actions > 1 {
+		return fmt.Errorf("hotkey %s must set only one of event, macroID and commandID", hotkey.Name)
+	}
+	if actions > 0 && hotkey.Key == "" {
+		return fmt.Errorf("hotkey %s has an action but no key", hotkey.Name)
+	}
+	return nil
+}
+
+// CommandParameterType is the type of a command parameter value
+type CommandParameterType string
+
+const (
+	CommandParameterTypeString CommandParameterType = "string"
+	CommandParameterTypeInt    CommandParameterType = "int"
+	CommandParameterTypeEnum   CommandParameterType = "enum"
+	// CommandParameterTypePath is a path that must be within Directory
+	CommandParameterTypePath CommandParameterType = "path"
+)
+
+// SettingsCommandParameter declares a parameter callers fill in when running
+// a command. It is substituted into arguments wherever {{name}} appears.
+type SettingsCommandParameter struct {
+	Name        string               `json:"name" mapstructure:"name"`
+	Type        CommandParameterType `json:"type" mapstructure:"type"`
+	Description string               `json:"description,omitempty" mapstructure:"description"`
+	Required    bool                 `json:"required,omitempty" mapstructure:"required"`
+	Default     string               `json:"default,omitempty" mapstructure:"default"`
+	// Pattern is a regular expression values must match in full
+	Pattern string `json:"pattern,omitempty" mapstructure:"pattern"`
+	// Min and Max bound int values
+	Min *int `json:"min,omitempty" mapstructure:"min"`
+	Max *int `json:"max,omitempty" mapstructure:"max"`
+	// Values are the allowed values of an enum
+	Values []string `json:"values,omitempty" mapstructure:"values"`
+	// Directory is the absolute directory path values must be within
+	Directory string `json:"directory,omitempty" mapstructure:"directory"`
 }
 
 type SettingsCommandDefinition struct {
@@ -80,6 +138,30 @@ type SettingsCommandDefinition struct {
 	Command    string   `json:"command" mapstructure:"command"`
 	WorkingDir string   `json:"workingDir" mapstructure:"workingDir"`
 	Arguments  []string `json:"arguments" mapstructure:"arguments"`
+	// MaxConcurrent limits how many instances of the command can run at once.
+	// Zero means no limit.
+	MaxConcurrent int                        `json:"maxConcurrent,omitempty" mapstructure:"maxConcurrent"`
+	Parameters    []SettingsCommandParameter `json:"parameters,omitempty" mapstructure:"parameters"`
+	// Environment is a list of NAME=value variables set for the command
+	Environment []string `json:"environment,omitempty" mapstructure:"environment"`
+	// InheritEnvironment controls whether the command inherits the
+	// environment of System Bridge. Defaults to true.
+	InheritEnvironment *bool `json:"inheritEnvironment,omitempty" mapstructure:"inheritEnvironment"`
+	// TimeoutSeconds overrides the default command timeout. Zero uses the default.
+	TimeoutSeconds int `json:"timeoutSeconds,omitempty" mapstructure:"timeoutSeconds"`
+	// Stdin is written to the standard input of the command
+	Stdin string `json:"stdin,omitempty" mapstructure:"stdin"`
+	// MaxOutputSize caps stdout and stderr in bytes. Zero uses the default.
+	MaxOutputSize int64 `json:"maxOutputSize,omitempty" mapstructure:"maxOutputSize"`
+	// RunAs is the name of a local user to run the command as. It is only
+	// supported on Linux, with System Bridge running as root.
+	RunAs string `json:"runAs,omitempty" mapstructure:"runAs"`
+}
+
+// InheritsEnvironment returns whether the command inherits the environment
+// of System Bridge
+func (cmd SettingsCommandDefinition) InheritsEnvironment() bool {
+	return cmd.InheritEnvironment == nil || *cmd.InheritEnvironment
 }
 
 type SettingsCommands struct {
@@ -91,16 +173,53 @@ type SettingsMediaDirectory struct {
 	Path string `json:"path" mapstructure:"path"`
 }
 
+// SettingsMediaPlayerPolicy chooses the active media player, which the media
+// module reports at its top level and media controls target by default
+type SettingsMediaPlayerPolicy string
+
+const (
+	// MediaPlayerPolicyPlaying prefers a playing player, then a paused one
+	MediaPlayerPolicyPlaying SettingsMediaPlayerPolicy = "playing"
+	// MediaPlayerPolicyLastActive prefers the player that most recently
+	// started playing
+	MediaPlayerPolicyLastActive SettingsMediaPlayerPolicy = "last_active"
+	// MediaPlayerPolicyPreferred prefers players in the order of
+	// preferredPlayers, whatever their status
+	MediaPlayerPolicyPreferred SettingsMediaPlayerPolicy = "preferred"
+)
+
 type SettingsMedia struct {
 	Directories []SettingsMediaDirectory `json:"directories" mapstructure:"directories"`
+	// PlayerPolicy chooses the active media player. Empty uses playing.
+	PlayerPolicy SettingsMediaPlayerPolicy `json:"playerPolicy" mapstructure:"playerPolicy"`
+	// PreferredPlayers are player names, or prefixes of them, in order of
+	// preference. They break ties between players the policy ranks equally.
+	PreferredPlayers []string `json:"preferredPlayers" mapstructure:"preferredPlayers"`
+}
+
+// SettingsGRPC configures the optional gRPC API. A port of 0 serves gRPC
+// over h2c on the main HTTP listener.
+type SettingsGRPC struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	Port    int  `json:"port" mapstructure:"port"`
+}
+
+// SettingsMCP configures the MCP server. Tools maps tool names to whether
+// they are exposed; tools without an entry use their default.
+type SettingsMCP struct {
+	Tools map[string]bool `json:"tools" mapstructure:"tools"`
 }
 
 type Settings struct {
-	Autostart bool             `json:"autostart" mapstructure:"autostart"`
-	Hotkeys   []SettingsHotkey `json:"hotkeys" mapstructure:"hotkeys"`
-	LogLevel  LogLevel         `json:"logLevel" mapstructure:"logLevel"`
-	Commands  SettingsCommands `json:"commands" mapstructure:"commands"`
-	Media     SettingsMedia    `json:"media" mapstructure:"media"`
+	Autostart bool               `json:"autostart" mapstructure:"autostart"`
+	Hotkeys   []SettingsHotkey   `json:"hotkeys" mapstructure:"hotkeys"`
+	LogLevel  LogLevel           `json:"logLevel" mapstructure:"logLevel"`
+	Commands  SettingsCommands   `json:"commands" mapstructure:"commands"`
+	Media     SettingsMedia      `json:"media" mapstructure:"media"`
+	GRPC      SettingsGRPC       `json:"grpc" mapstructure:"grpc"`
+	MCP       SettingsMCP        `json:"mcp" mapstructure:"mcp"`
+	Schedules []SettingsSchedule `json:"schedules" mapstructure:"schedules"`
+	Macros    []SettingsMacro    `json:"macros" mapstructure:"macros"`
 }
 
 func Load() (*Settings, error) {
@@ -120,7 +239,14 @@ func Load() (*Settings, error) {
 	viper.SetDefault("hotkeys", []SettingsHotkey{})
 	viper.SetDefault("logLevel", LogLevelWarn)
 	viper.SetDefault("media.directories", []SettingsMediaDirectory{})
+	viper.SetDefault("media.playerPolicy", MediaPlayerPolicyPlaying)
+	viper.SetDefault("media.preferredPlayers", []string{})
 	viper.SetDefault("commands.allowlist", []SettingsCommandDefinition{})
+	viper.SetDefault("grpc.enabled", false)
+	viper.SetDefault("grpc.port", 0)
+	viper.SetDefault("mcp.tools", map[string]bool{})
+	viper.SetDefault("schedules", []SettingsSchedule{})
+	viper.SetDefault("macros", []SettingsMacro{})
 
 	// Read the config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -179,6 +305,15 @@ func (cfg *Settings) Validate() error {
 		if err := utils.ValidateCommand(cmd.ID, cmd.Name, cmd.Command, cmd.WorkingDir, cmd.Arguments); err != nil {
 			return fmt.Errorf("command at index %d: %w", i, err)
 		}
+		if cmd.MaxConcurrent < 0 {
+			return fmt.Errorf("command at index %d: maxConcurrent cannot be negative", i)
+		}
+		if err := validateCommandParameters(cmd); err != nil {
+			return fmt.Errorf("command at index %d: %w", i, err)
+		}
+		if err := validateCommandPolicy(cmd); err != nil {
+			return fmt.Errorf("command at index %d: %w", i, err)
+		}
 
 		// Check for duplicates
 		if seenIDs[cmd.ID] {
@@ -187,6 +322,44 @@ func (cfg *Settings) Validate() error {
 		seenIDs[cmd.ID] = true
 	}
 
+	for i, hotkey := range cfg.Hotkeys {
+		if err := validateHotkey(hotkey); err != nil {
+			return fmt.Errorf("hotkey at index %d: %w", i, err)
+		}
+	}
+
+	if cfg.GRPC.Port < 0 || cfg.GRPC.Port > 65535 {
+		return fmt.Errorf("invalid gRPC port: %d", cfg.GRPC.Port)
+	}
+
+	seenScheduleIDs := make(map[string]bool)
+	for i, schedule := range cfg.Schedules {
+		if err := ValidateSchedule(schedule); err != nil {
+			return fmt.Errorf("schedule at index %d: %w", i, err)
+		}
+		if seenScheduleIDs[schedule.ID] {
+			return fmt.Errorf("duplicate schedule ID: %s", schedule.ID)
+		}
+		seenScheduleIDs[schedule.ID] = true
+	}
+
+	seenMacroIDs := make(map[string]bool)
+	for i, macro := range cfg.Macros {
+		if err := ValidateMacro(macro); err != nil {
+			return fmt.Errorf("macro at index %d: %w", i, err)
+		}
+		if seenMacroIDs[macro.ID] {
+			return fmt.Errorf("duplicate macro ID: %s", macro.ID)
+		}
+		seenMacroIDs[macro.ID] = true
+	}
+
+	switch cfg.Media.PlayerPolicy {
+	case "", MediaPlayerPolicyPlaying, MediaPlayerPolicyLastActive, MediaPlayerPolicyPreferred:
+	default:
+		return fmt.Errorf("invalid media player policy %q, must be %s, %s or %s", cfg.Media.PlayerPolicy, MediaPlayerPolicyPlaying, MediaPlayerPolicyLastActive, MediaPlayerPolicyPreferred)
+	}
+
 	// Validate media directories exist
 	for _, dir := range cfg.Media.Directories {
 		if err := utils.ValidateMediaDirectory(dir.Path); err != nil {
@@ -197,6 +370,26 @@ func (cfg *Settings) Validate() error {
 	return nil
 }
 
+// validateCommandPolicy validates the environment, limits and user of a command
+func validateCommandPolicy(cmd SettingsCommandDefinition) error {
+	for _, variable := range cmd.Environment {
+		name, _, found := strings.Cut(variable, "=")
+		if !found || name == "" {
+			return fmt.Errorf("command %s environment variable %q must be in the form NAME=value", cmd.ID, variable)
+		}
+	}
+	if cmd.TimeoutSeconds < 0 {
+		return fmt.Errorf("command %s timeoutSeconds cannot be negative", cmd.ID)
+	}
+	if cmd.MaxOutputSize < 0 {
+		return fmt.Errorf("command %s maxOutputSize cannot be negative", cmd.ID)
+	}
+	if cmd.RunAs != "" && runtime.GOOS != "linux" {
+		return fmt.Errorf("command %s runAs is only supported on Linux", cmd.ID)
+	}
+	return nil
+}
+
 func (cfg *Settings) Save() error {
 	// Validate settings before saving
 	if err := cfg.Validate(); err != nil {
@@ -208,6 +401,13 @@ func (cfg *Settings) Save() error {
 	viper.Set("logLevel", string(cfg.LogLevel))
 	viper.Set("commands.allowlist", cfg.Commands.Allowlist)
 	viper.Set("media.directories", cfg.Media.Directories)
+	viper.Set("media.playerPolicy", string(cfg.Media.PlayerPolicy))
+	viper.Set("media.preferredPlayers", cfg.Media.PreferredPlayers)
+	viper.Set("grpc.enabled", cfg.GRPC.Enabled)
+	viper.Set("grpc.port", cfg.GRPC.Port)
+	viper.Set("mcp.tools", cfg.MCP.Tools)
+	viper.Set("schedules", cfg.Schedules)
+	viper.Set("macros", cfg.Macros)
 
 	if err := viper.WriteConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -38,6 +38,11 @@ func TestLoad(t *testing.T) {
 		assert.Empty(t, settings.Hotkeys)
 		assert.Equal(t, LogLevelWarn, settings.LogLevel)
 		assert.Empty(t, settings.Media.Directories)
+		assert.Equal(t, MediaPlayerPolicyPlaying, settings.Media.PlayerPolicy)
+		assert.Empty(t, settings.Media.PreferredPlayers)
+		assert.False(t, settings.GRPC.Enabled)
+		assert.Equal(t, 0, settings.GRPC.Port)
+		assert.Empty(t, settings.MCP.Tools)
 	})
 
 	t.Run("Load existing config file", func(t *testing.T) {
@@ -146,6 +151,8 @@ func TestSave(t *testing.T) {
 		settings.Media.Directories = []SettingsMediaDirectory{
 			{Name: "Videos", Path: mediaDir},
 		}
+		settings.GRPC = SettingsGRPC{Enabled: true, Port: 9171}
+		settings.MCP.Tools = map[string]bool{"system_bridge_power_lock": true, "system_bridge_get_data": false}
 
 		// Save settings
 		err = settings.Save()
@@ -168,6 +175,9 @@ func TestSave(t *testing.T) {
 		assert.Len(t, loadedSettings.Media.Directories, 1)
 		assert.Equal(t, "Videos", loadedSettings.Media.Directories[0].Name)
 		assert.Equal(t, mediaDir, loadedSettings.Media.Directories[0].Path)
+		assert.True(t, loadedSettings.GRPC.Enabled)
+		assert.Equal(t, 9171, loadedSettings.GRPC.Port)
+		assert.Equal(t, map[string]bool{"system_bridge_power_lock": true, "system_bridge_get_data": false}, loadedSettings.MCP.Tools)
 	})
 
 	t.Run("Save rejects settings with duplicate command IDs", func(t *testing.T) {
@@ -360,3 +370,68 @@ func TestLogLevel(t *testing.T) {
 		assert.Equal(t, LogLevelInfo, level)
 	})
 }
+
+func TestValidateCommandPolicy(t *testing.T) {
+	assert.NoError(t, validateCommandPolicy(SettingsCommandDefinition{
+		ID:             "backup",
+		Environment:    []string{"PATH=/opt/backup/bin:/usr/bin", "EMPTY="},
+		TimeoutSeconds: 1800,
+		MaxOutputSize:  4096,
+	}))
+
+	assert.ErrorContains(t, validateCommandPolicy(SettingsCommandDefinition{ID: "backup", Environment: []string{"PATH"}}), "NAME=value")
+	assert.ErrorContains(t, validateCommandPolicy(SettingsCommandDefinition{ID: "backup", Environment: []string{"=value"}}), "NAME=value")
+	assert.ErrorContains(t, validateCommandPolicy(SettingsCommandDefinition{ID: "backup", TimeoutSeconds: -1}), "timeoutSeconds")
+	assert.ErrorContains(t, validateCommandPolicy(SettingsCommandDefinition{ID: "backup", MaxOutputSize: -1}), "maxOutputSize")
+}
+
+func TestValidateSchedule(t *testing.T) {
+	assert.NoError(t, ValidateSchedule(SettingsSchedule{ID: "lock", Cron: "0 22 * * 1-5", Event: "POWER_LOCK"}))
+	assert.NoError(t, ValidateSchedule(SettingsSchedule{ID: "lock", At: "2026-03-02T22:00:00Z", Event: "POWER_LOCK"}))
+
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{Cron: "@daily", Event: "POWER_LOCK"}), "empty ID")
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{ID: "lock", Cron: "@daily"}), "empty event")
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{ID: "lock", Event: "POWER_LOCK"}), "must set cron or at")
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{ID: "lock", Cron: "@daily", At: "2026-03-02T22:00:00Z", Event: "POWER_LOCK"}), "only one")
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{ID: "lock", Cron: "61 * * * *", Event: "POWER_LOCK"}), "minute")
+	assert.ErrorContains(t, ValidateSchedule(SettingsSchedule{ID: "lock", At: "tomorrow", Event: "POWER_LOCK"}), "RFC 3339")
+}
+
+func TestValidateMacro(t *testing.T) {
+	valid := SettingsMacro{
+		ID: "leave-desk",
+		Steps: []SettingsMacroStep{
+			{Event: "MEDIA_CONTROL", Data: map[string]any{"action": "PAUSE"}},
+			{Event: "POWER_LOCK", DelayMs: 2000, Conditions: []SettingsMacroCondition{{Module: "battery", Path: "isCharging", Operator: MacroConditionEquals, Value: false}}},
+		},
+		OnError: MacroErrorPolicyContinue,
+	}
+	assert.NoError(t, ValidateMacro(valid))
+
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{Steps: valid.Steps}), "empty ID")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "empty"}), "no steps")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "policy", Steps: valid.Steps, OnError: "retry"}), "onError")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "nested", Steps: []SettingsMacroStep{{Event: "RUN_MACRO"}}}), "another macro")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "delay", Steps: []SettingsMacroStep{{Event: "POWER_LOCK", DelayMs: -1}}}), "delayMs")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "operator", Steps: []SettingsMacroStep{{Event: "POWER_LOCK", Conditions: []SettingsMacroCondition{{Module: "battery", Operator: "like", Value: 1}}}}}), "invalid operator")
+	assert.ErrorContains(t, ValidateMacro(SettingsMacro{ID: "value", Steps: []SettingsMacroStep{{Event: "POWER_LOCK", Conditions: []SettingsMacroCondition{{Module: "battery", Operator: MacroConditionEquals}}}}}), "no value")
+}
+
+func TestValidateHotkey(t *testing.T) {
+	assert.NoError(t, validateHotkey(SettingsHotkey{Name: "test", Key: "ctrl+t"}))
+	assert.NoError(t, validateHotkey(SettingsHotkey{Name: "lock", Key: "super+l", Event: "POWER_LOCK"}))
+	assert.NoError(t, validateHotkey(SettingsHotkey{Name: "macro", Key: "ctrl+alt+m", MacroID: "leave-desk"}))
+
+	assert.ErrorContains(t, validateHotkey(SettingsHotkey{Name: "both", Key: "ctrl+b", Event: "POWER_LOCK", CommandID: "backup"}), "only one")
+	assert.ErrorContains(t, validateHotkey(SettingsHotkey{Name: "nokey", CommandID: "backup"}), "no key")
+}
+
+func TestValidateMediaPlayerPolicy(t *testing.T) {
+	for _, policy := range []SettingsMediaPlayerPolicy{"", MediaPlayerPolicyPlaying, MediaPlayerPolicyLastActive, MediaPlayerPolicyPreferred} {
+		cfg := Settings{Media: SettingsMedia{PlayerPolicy: policy}}
+		assert.NoError(t, cfg.Validate(), "policy %q", policy)
+	}
+
+	cfg := Settings{Media: SettingsMedia{PlayerPolicy: "loudest"}}
+	assert.ErrorContains(t, cfg.Validate(), "invalid media player policy")
+}
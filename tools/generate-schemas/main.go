@@ -35,6 +35,7 @@ func main() {
 	// Command-line flags for configuration
 	typesDir := flag.String("types-dir", "types", "Directory containing Go type definitions")
 	outputFile := flag.String("output", "web-client/src/lib/system-bridge/types-modules-schemas.ts", "Output file for generated TypeScript schemas")
+	protoOutputFile := flag.String("proto-output", "proto/systembridge/v1/types.proto", "Output file for generated protobuf definitions (empty to skip)")
 	flag.Parse()
 
 	// Parse all Go files in types directory
@@ -54,6 +55,19 @@ func main() {
 	}
 
 	fmt.Printf("Generated Zod schemas from %s to %s\n", *typesDir, *outputFile)
+
+	if *protoOutputFile == "" {
+		return
+	}
+
+	// Generate protobuf definitions for the gRPC API
+	protoCode := generateProtoSchema(structs, enums)
+	if err := os.WriteFile(*protoOutputFile, []byte(protoCode), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing proto output file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Generated protobuf definitions from %s to %s\n", *typesDir, *protoOutputFile)
 }
 
 func parseTypesDirectory(dir string) (map[string]StructInfo, map[string]EnumInfo, error) {
@@ -342,6 +356,7 @@ func getStructComment(name string) string {
 		"DiskPartition":          "Disk Partition",
 		"Disk":                   "Disk",
 		"Display":                "Display",
+		"MediaPlayer":            "Media Player",
 		"GPU":                    "GPU",
 		"MemorySwap":             "Memory Swap",
 		"MemoryVirtual":          "Memory Virtual",
@@ -468,6 +483,7 @@ func mapGoTypeToZodSchema(field FieldInfo, parentStruct string) string {
 			strings.HasPrefix(field.Type, "Disk") ||
 			strings.HasPrefix(field.Type, "Display") ||
 			strings.HasPrefix(field.Type, "GPU") ||
+			strings.HasPrefix(field.Type, "Media") ||
 			strings.HasPrefix(field.Type, "Memory") ||
 			strings.HasPrefix(field.Type, "Network") ||
 			strings.HasPrefix(field.Type, "Process") ||
@@ -550,6 +566,7 @@ func orderStructsByDependency(structs map[string]StructInfo) []string {
 		// Structs with simple dependencies
 		"PerCPU",
 		"DiskPartition",
+		"MediaPlayer",
 		"NetworkConnection",
 		"SensorsWindowsSensor",
 		"SensorsNVIDIAChipset",
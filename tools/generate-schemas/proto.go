@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// protoPackage is the protobuf package used for generated definitions
+const protoPackage = "systembridge.v1"
+
+// protoGoPackage is the Go import path for code generated from the definitions
+const protoGoPackage = "github.com/timmo001/system-bridge/proto/systembridge/v1;systembridgev1"
+
+// generateProtoSchema generates protobuf message definitions from Go types.
+//
+// Field numbers follow declaration order in the Go structs, so new fields must
+// be appended to the end of a struct to keep the wire format compatible.
+func generateProtoSchema(structs map[string]StructInfo, enums map[string]EnumInfo) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(`// Code generated by tools/generate-schemas. DO NOT EDIT.
+// Generated from backend types in types/ directory
+
+syntax = "proto3";
+
+`)
+	fmt.Fprintf(&buf, "package %s;\n\n", protoPackage)
+	buf.WriteString("import \"google/protobuf/struct.proto\";\n\n")
+	fmt.Fprintf(&buf, "option go_package = \"%s\";\n", protoGoPackage)
+
+	names := make([]string, 0, len(structs))
+	for name := range structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		structInfo := structs[name]
+
+		fmt.Fprintf(&buf, "\n// %s\n", getStructComment(name))
+		fmt.Fprintf(&buf, "message %s {\n", name)
+
+		// Array type aliases are wrapped in a message with a single repeated field
+		if len(structInfo.Fields) == 1 && structInfo.Fields[0].Name == "__array_element__" {
+			fmt.Fprintf(&buf, "  repeated %s items = 1;\n", mapGoTypeToProto(structInfo.Fields[0].Type, structs, enums))
+			buf.WriteString("}\n")
+			continue
+		}
+
+		for i, field := range structInfo.Fields {
+			fmt.Fprintf(&buf, "  %s = %d;\n", protoFieldDeclaration(field, structs, enums), i+1)
+		}
+		buf.WriteString("}\n")
+	}
+
+	return buf.String()
+}
+
+// protoFieldDeclaration returns the type and name of a protobuf field
+func protoFieldDeclaration(field FieldInfo, structs map[string]StructInfo, enums map[string]EnumInfo) string {
+	protoType := mapGoTypeToProto(field.Type, structs, enums)
+
+	switch {
+	case field.IsArray:
+		return fmt.Sprintf("repeated %s %s", protoType, field.JSONName)
+	case field.IsPtr && isProtoScalar(protoType):
+		// Optional scalars keep presence so nil pointers round-trip as null
+		return fmt.Sprintf("optional %s %s", protoType, field.JSONName)
+	default:
+		return fmt.Sprintf("%s %s", protoType, field.JSONName)
+	}
+}
+
+// mapGoTypeToProto maps a Go type name to a protobuf type name
+func mapGoTypeToProto(goType string, structs map[string]StructInfo, enums map[string]EnumInfo) string {
+	switch goType {
+	case "bool":
+		return "bool"
+	case "string":
+		return "string"
+	case "float64":
+		return "double"
+	case "float32":
+		return "float"
+	case "int", "int64":
+		return "int64"
+	case "int32":
+		return "int32"
+	case "uint", "uint64":
+		return "uint64"
+	case "uint32":
+		return "uint32"
+	}
+
+	if _, ok := enums[goType]; ok {
+		return "string"
+	}
+	if _, ok := structs[goType]; ok {
+		return goType
+	}
+
+	// any, interface{} and unknown types carry arbitrary JSON values
+	return "google.protobuf.Value"
+}
+
+// isProtoScalar reports whether a protobuf type is a scalar value type
+func isProtoScalar(protoType string) bool {
+	switch protoType {
+	case "bool", "string", "double", "float", "int64", "int32", "uint64", "uint32":
+		return true
+	default:
+		return false
+	}
+}
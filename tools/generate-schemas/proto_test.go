@@ -0,0 +1,103 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProtoFieldDeclaration(t *testing.T) {
+	structs := map[string]StructInfo{
+		"CPUFrequency": {Name: "CPUFrequency"},
+	}
+	enums := map[string]EnumInfo{
+		"MediaStatus": {Name: "MediaStatus"},
+	}
+
+	tests := []struct {
+		name     string
+		field    FieldInfo
+		expected string
+	}{
+		{
+			name:     "simple string",
+			field:    FieldInfo{Name: "Name", Type: "string", JSONName: "name"},
+			expected: "string name",
+		},
+		{
+			name:     "optional number",
+			field:    FieldInfo{Name: "Usage", Type: "float64", JSONName: "usage", IsPtr: true},
+			expected: "optional double usage",
+		},
+		{
+			name:     "repeated integer",
+			field:    FieldInfo{Name: "Values", Type: "int", JSONName: "values", IsArray: true},
+			expected: "repeated int64 values",
+		},
+		{
+			name:     "nested struct pointer",
+			field:    FieldInfo{Name: "Frequency", Type: "CPUFrequency", JSONName: "frequency", IsPtr: true},
+			expected: "CPUFrequency frequency",
+		},
+		{
+			name:     "enum",
+			field:    FieldInfo{Name: "Status", Type: "MediaStatus", JSONName: "status", IsPtr: true},
+			expected: "optional string status",
+		},
+		{
+			name:     "unknown type",
+			field:    FieldInfo{Name: "Fans", Type: "any", JSONName: "fans"},
+			expected: "google.protobuf.Value fans",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := protoFieldDeclaration(tt.field, structs, enums)
+			if result != tt.expected {
+				t.Errorf("protoFieldDeclaration() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateProtoSchema(t *testing.T) {
+	structs := map[string]StructInfo{
+		"TestData": {
+			Name: "TestData",
+			Fields: []FieldInfo{
+				{Name: "Name", Type: "string", JSONName: "name"},
+				{Name: "Count", Type: "int", JSONName: "count", IsPtr: true},
+			},
+		},
+		"TestList": {
+			Name: "TestList",
+			Fields: []FieldInfo{
+				{Name: "__array_element__", Type: "TestData", IsArray: true},
+			},
+		},
+	}
+
+	result := generateProtoSchema(structs, map[string]EnumInfo{})
+
+	expectedElements := []string{
+		"Code generated by tools/generate-schemas. DO NOT EDIT.",
+		`syntax = "proto3";`,
+		"package systembridge.v1;",
+		"message TestData {",
+		"  string name = 1;",
+		"  optional int64 count = 2;",
+		"message TestList {",
+		"  repeated TestData items = 1;",
+	}
+
+	for _, expected := range expectedElements {
+		if !strings.Contains(result, expected) {
+			t.Errorf("Generated proto missing expected element: %s", expected)
+		}
+	}
+
+	// Messages are sorted by name so output is stable between runs
+	if strings.Index(result, "message TestData") > strings.Index(result, "message TestList") {
+		t.Error("TestData should come before TestList")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"runtime"
 	"sync"
+	"time"
 
 	"fyne.io/systray"
 )
@@ -17,11 +18,40 @@ var trayIconIcoData []byte
 
 // Handlers holds the callback functions for tray menu actions
 type Handlers struct {
-	OpenWebClient func()
-	OpenLogsDir   func()
-	Quit          func()
+	OpenWebClient    func()
+	OpenLogsDir      func()
+	ListClients      func() []Client
+	DisconnectClient func(sessionID string)
+	ListMacros       func() []Macro
+	RunMacro         func(id string)
+	Quit             func()
 }
 
+// Client is a connected client shown in the clients submenu
+type Client struct {
+	SessionID string
+	Title     string
+	Tooltip   string
+}
+
+// Macro is a macro shown in the macros submenu
+type Macro struct {
+	ID      string
+	Title   string
+	Tooltip string
+}
+
+const (
+	// maxClientItems is the number of clients shown in the clients submenu
+	maxClientItems = 20
+	// clientsRefreshInterval is how often the clients submenu is refreshed
+	clientsRefreshInterval = 5 * time.Second
+	// maxMacroItems is the number of macros shown in the macros submenu
+	maxMacroItems = 20
+	// macrosRefreshInterval is how often the macros submenu is refreshed
+	macrosRefreshInterval = 30 * time.Second
+)
+
 var (
 	handlers   Handlers
 	handlersMu sync.RWMutex
@@ -64,6 +94,9 @@ func OnReady() {
 	systray.AddSeparator()
 	mOpenLogsDirectory := systray.AddMenuItem("Open logs directory", "Open the logs directory")
 	systray.AddSeparator()
+	addMacrosMenu()
+	addClientsMenu()
+	systray.AddSeparator()
 	mQuit := systray.AddMenuItem("Quit", "Quit the application")
 
 	// Handle menu item clicks
@@ -97,6 +130,167 @@ func OnReady() {
 	}()
 }
 
+// clientItem is a reusable entry in the clients submenu. The systray menu
+// cannot remove items, so a fixed set is created up front and shown or hidden
+// as clients connect and disconnect.
+type clientItem struct {
+	item       *systray.MenuItem
+	disconnect *systray.MenuItem
+	mutex      sync.Mutex
+	sessionID  string
+}
+
+// addClientsMenu adds the connected clients submenu and keeps it up to date
+func addClientsMenu() {
+	mClients := systray.AddMenuItem("Connected clients", "Clients connected to the WebSocket and MCP servers")
+	mNone := mClients.AddSubMenuItem("No clients connected", "")
+	mNone.Disable()
+
+	items := make([]*clientItem, maxClientItems)
+	for i := range items {
+		item := mClients.AddSubMenuItem("", "")
+		c := &clientItem{
+			item:       item,
+			disconnect: item.AddSubMenuItem("Disconnect", "Disconnect this client"),
+		}
+		item.Hide()
+		items[i] = c
+
+		go func() {
+			for range c.disconnect.ClickedCh {
+				c.mutex.Lock()
+				sessionID := c.sessionID
+				c.mutex.Unlock()
+
+				h := getHandlers()
+				if h.DisconnectClient == nil {
+					slog.Warn("DisconnectClient handler not registered")
+					continue
+				}
+				if sessionID != "" {
+					h.DisconnectClient(sessionID)
+					refreshClientsMenu(mNone, items)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		ticker := time.NewTicker(clientsRefreshInterval)
+		defer ticker.Stop()
+		for {
+			refreshClientsMenu(mNone, items)
+			<-ticker.C
+		}
+	}()
+}
+
+// refreshClientsMenu updates the clients submenu from the ListClients handler
+func refreshClientsMenu(mNone *systray.MenuItem, items []*clientItem) {
+	h := getHandlers()
+	if h.ListClients == nil {
+		return
+	}
+	clients := h.ListClients()
+
+	if len(clients) == 0 {
+		mNone.Show()
+	} else {
+		mNone.Hide()
+	}
+
+	for i, c := range items {
+		c.mutex.Lock()
+		if i < len(clients) {
+			c.sessionID = clients[i].SessionID
+			c.item.SetTitle(clients[i].Title)
+			c.item.SetTooltip(clients[i].Tooltip)
+			c.item.Show()
+		} else {
+			c.sessionID = ""
+			c.item.Hide()
+		}
+		c.mutex.Unlock()
+	}
+}
+
+// macroItem is a reusable entry in the macros submenu
+type macroItem struct {
+	item    *systray.MenuItem
+	mutex   sync.Mutex
+	macroID string
+}
+
+// addMacrosMenu adds the macros submenu and keeps it up to date with the
+// macros shown in the tray
+func addMacrosMenu() {
+	mMacros := systray.AddMenuItem("Macros", "Run a macro")
+	mNone := mMacros.AddSubMenuItem("No macros in the tray", "")
+	mNone.Disable()
+
+	items := make([]*macroItem, maxMacroItems)
+	for i := range items {
+		m := &macroItem{item: mMacros.AddSubMenuItem("", "")}
+		m.item.Hide()
+		items[i] = m
+
+		go func() {
+			for range m.item.ClickedCh {
+				m.mutex.Lock()
+				macroID := m.macroID
+				m.mutex.Unlock()
+
+				h := getHandlers()
+				if h.RunMacro == nil {
+					slog.Warn("RunMacro handler not registered")
+					continue
+				}
+				if macroID != "" {
+					go h.RunMacro(macroID)
+				}
+			}
+		}()
+	}
+
+	go func() {
+		ticker := time.NewTicker(macrosRefreshInterval)
+		defer ticker.Stop()
+		for {
+			refreshMacrosMenu(mNone, items)
+			<-ticker.C
+		}
+	}()
+}
+
+// refreshMacrosMenu updates the macros submenu from the ListMacros handler
+func refreshMacrosMenu(mNone *systray.MenuItem, items []*macroItem) {
+	h := getHandlers()
+	if h.ListMacros == nil {
+		return
+	}
+	macros := h.ListMacros()
+
+	if len(macros) == 0 {
+		mNone.Show()
+	} else {
+		mNone.Hide()
+	}
+
+	for i, m := range items {
+		m.mutex.Lock()
+		if i < len(macros) {
+			m.macroID = macros[i].ID
+			m.item.SetTitle(macros[i].Title)
+			m.item.SetTooltip(macros[i].Tooltip)
+			m.item.Show()
+		} else {
+			m.macroID = ""
+			m.item.Hide()
+		}
+		m.mutex.Unlock()
+	}
+}
+
 // OnExit is called when the system tray is exiting
 func OnExit() {
 	slog.Info("System tray exiting...")
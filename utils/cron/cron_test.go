@@ -0,0 +1,80 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		"* * * * *",
+		"0 23 * * *",
+		"30 18 * * mon-fri",
+		"*/15 9-17 * * 1,3,5",
+		"0 0 1 jan,jul *",
+		"5/10 * * * 7",
+		"@daily",
+		"@HOURLY",
+	}
+	for _, expression := range valid {
+		_, err := Parse(expression)
+		assert.NoError(t, err, expression)
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@sometimes",
+	}
+	for _, expression := range invalid {
+		_, err := Parse(expression)
+		assert.Error(t, err, expression)
+	}
+}
+
+func TestNext(t *testing.T) {
+	// A Wednesday
+	from := time.Date(2026, time.March, 11, 22, 59, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		want       time.Time
+	}{
+		{"0 23 * * *", time.Date(2026, time.March, 11, 23, 0, 0, 0, time.UTC)},
+		{"30 18 * * *", time.Date(2026, time.March, 12, 18, 30, 0, 0, time.UTC)},
+		{"* * * * *", time.Date(2026, time.March, 11, 23, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, time.March, 11, 23, 0, 0, 0, time.UTC)},
+		{"0 9 * * sat,sun", time.Date(2026, time.March, 14, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		// Either day field matches when both are restricted
+		{"0 0 13 * fri", time.Date(2026, time.March, 13, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+
+	t.Run("No match", func(t *testing.T) {
+		schedule, err := Parse("0 0 30 2 *")
+		require.NoError(t, err)
+		assert.True(t, schedule.Next(from).IsZero())
+	})
+}
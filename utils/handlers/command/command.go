@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/timmo001/system-bridge/backend/websocket"
-	"github.com/timmo001/system-bridge/event"
 	"github.com/timmo001/system-bridge/settings"
 	"github.com/timmo001/system-bridge/utils"
 )
@@ -62,9 +61,14 @@ func getServerContext() context.Context {
 
 // ExecuteRequest contains the data for a command execution request
 type ExecuteRequest struct {
-	CommandID  string `json:"commandID" mapstructure:"commandID"`
-	RequestID  string `json:"-"`
-	Connection string `json:"-"`
+	CommandID string `json:"commandID" mapstructure:"commandID"`
+	// Stream sends output in COMMAND_OUTPUT messages as it is produced,
+	// instead of in the COMMAND_COMPLETED result
+	Stream bool `json:"stream,omitempty" mapstructure:"stream"`
+	// Params are the values of the command's declared parameters
+	Params     map[string]any `json:"params,omitempty" mapstructure:"params"`
+	RequestID  string         `json:"-"`
+	Connection string         `json:"-"`
 }
 
 // ExecuteResult contains the result of a command execution
@@ -74,6 +78,19 @@ type ExecuteResult struct {
 	Stdout    string `json:"stdout" mapstructure:"stdout"`
 	Stderr    string `json:"stderr" mapstructure:"stderr"`
 	Error     string `json:"error,omitempty" mapstructure:"error,omitempty"`
+	JobID     string `json:"jobID,omitempty" mapstructure:"jobID,omitempty"`
+	// Chunks is the number of COMMAND_OUTPUT messages sent for a streaming execution
+	Chunks uint64 `json:"chunks,omitempty" mapstructure:"chunks,omitempty"`
+}
+
+// OutputChunk is a chunk of output from a streaming command execution.
+// Sequence numbers start at 1 and are shared by both streams, so clients can
+// restore the order output was produced in.
+type OutputChunk struct {
+	CommandID string `json:"commandID" mapstructure:"commandID"`
+	Stream    string `json:"stream" mapstructure:"stream"`
+	Sequence  uint64 `json:"sequence" mapstructure:"sequence"`
+	Data      string `json:"data" mapstructure:"data"`
 }
 
 // ValidateCommand validates that the command exists in the allowlist and has valid paths
@@ -114,10 +131,25 @@ func ValidateCommand(commandID string, cfg *settings.Settings) (*settings.Settin
 	return nil, fmt.Errorf("%w: %s", ErrCommandNotFound, commandID)
 }
 
-// Execute validates and executes a command asynchronously
-func Execute(req ExecuteRequest, cfg *settings.Settings) error {
-	// Validate command
+// clientName returns the name a WebSocket connection reported in its HELLO
+// handshake, for audit logging
+func clientName(connection string) string {
+	ws := websocket.GetInstance()
+	if ws == nil {
+		return ""
+	}
+	return ws.ClientName(connection)
+}
+
+// Execute validates and executes a command asynchronously, returning the ID
+// of its job. Output and the result are sent to the sink, if one is given,
+// and the result is kept in the job history either way.
+func Execute(req ExecuteRequest, cfg *settings.Settings, sink ResultSink) (string, error) {
+	// Validate command and resolve its parameters
 	commandDef, err := ValidateCommand(req.CommandID, cfg)
+	if err == nil {
+		commandDef, err = applyParameters(commandDef, req.Params)
+	}
 	if err != nil {
 		// Log unauthorized attempts at higher severity
 		slog.Warn(
@@ -127,121 +159,177 @@ func Execute(req ExecuteRequest, cfg *settings.Settings) error {
 			"requestID", req.RequestID,
 			"error", err.Error(),
 		)
-		return err
+		return "", err
 	}
 
-	// Comprehensive audit logging with connection ID, request ID, timestamp, and full arguments
-	slog.Info(
-		"Executing command",
-		"commandID", commandDef.ID,
-		"name", commandDef.Name,
-		"command", commandDef.Command,
-		"arguments", commandDef.Arguments,
-		"workingDir", commandDef.WorkingDir,
-		"connection", req.Connection,
-		"requestID", req.RequestID,
-	)
+	logExecuting(req, commandDef)
 
-	// Verify connection still exists before spawning goroutine
-	// This prevents orphaned command execution when connection closes between validation and execution
-	// NOTE: TOCTOU race condition exists - the connection could close after this check but before
-	// or during executeAsync. This is acceptable because:
-	// 1. Commands execute atomically once started
-	// 2. Failure to send results is detected and logged in executeAsync (SendMessageToAddress returns false)
-	// 3. No security vulnerability - just a logged failure to send results back
-	// 4. executeAsync performs an additional early-exit check to fail fast if connection is already gone
-	ws := websocket.GetInstance()
-	if ws == nil {
-		return errors.New("WebSocket instance not available")
-	}
-	if !ws.ConnectionExists(req.Connection) {
+	// Register the job before returning so concurrency limits are enforced
+	// and the job ID can be sent back to the client
+	// Use server context as parent so commands are killed when server shuts down
+	ctx, cancel := context.WithTimeout(getServerContext(), commandTimeout(commandDef))
+	job, ctx, err := jobs.startJob(ctx, req, commandDef)
+	if err != nil {
+		cancel()
 		slog.Warn(
-			"Command execution aborted - connection no longer exists",
+			"Command execution denied",
 			"commandID", commandDef.ID,
 			"connection", req.Connection,
 			"requestID", req.RequestID,
+			"error", err.Error(),
 		)
-		return errors.New("connection no longer exists")
+		return "", err
 	}
 
 	// Execute asynchronously
-	go executeAsync(req, commandDef)
+	go func() {
+		defer cancel()
+		executeAsync(ctx, req, commandDef, job, sink)
+	}()
 
-	return nil
+	return job.job.ID, nil
 }
 
-// executeAsync runs the command and sends the result via WebSocket
-func executeAsync(req ExecuteRequest, commandDef *settings.SettingsCommandDefinition) {
-	// Create a context with timeout for command execution
-	// Use server context as parent so commands are killed when server shuts down
-	ctx, cancel := context.WithTimeout(getServerContext(), DefaultCommandTimeout)
+// ExecuteSync validates and executes a command, blocking until it completes.
+// It is used by transports that return the result directly instead of via a
+// WebSocket callback.
+func ExecuteSync(ctx context.Context, req ExecuteRequest, cfg *settings.Settings) (ExecuteResult, error) {
+	commandDef, err := ValidateCommand(req.CommandID, cfg)
+	if err == nil {
+		commandDef, err = applyParameters(commandDef, req.Params)
+	}
+	if err != nil {
+		slog.Warn(
+			"Command execution denied",
+			"commandID", req.CommandID,
+			"connection", req.Connection,
+			"requestID", req.RequestID,
+			"error", err.Error(),
+		)
+		return ExecuteResult{}, err
+	}
+
+	logExecuting(req, commandDef)
 
-	// Ensure cleanup on completion
+	// Stop the command if either the caller or the server goes away
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout(commandDef))
 	defer cancel()
+	stop := context.AfterFunc(getServerContext(), cancel)
+	defer stop()
 
-	// Early check: verify connection still exists before executing command
-	// This provides fail-fast behavior to avoid executing commands when the connection is already gone
-	// Note: This still has a TOCTOU race (connection could close after this check), but it catches
-	// the common case of connections closed before the goroutine starts
-	ws := websocket.GetInstance()
-	if ws == nil {
-		slog.Error("WebSocket instance not available for command execution")
-		return
-	}
-	if !ws.ConnectionExists(req.Connection) {
-		slog.Info(
-			"Skipping command execution - connection closed before execution started",
+	job, ctx, err := jobs.startJob(ctx, req, commandDef)
+	if err != nil {
+		slog.Warn(
+			"Command execution denied",
 			"commandID", commandDef.ID,
 			"connection", req.Connection,
 			"requestID", req.RequestID,
+			"error", err.Error(),
 		)
-		return
+		return ExecuteResult{}, err
 	}
 
-	// Execute the command with context
 	result := execute(ctx, commandDef)
 	result.CommandID = commandDef.ID
+	result.JobID = job.job.ID
+	jobs.finishJob(job, result)
+	logResult(req, result)
 
-	// Get WebSocket instance again to send callback
-	// (re-getting instance is safe even though we got it above)
-	ws = websocket.GetInstance()
-	if ws == nil {
-		slog.Error("WebSocket instance not available for command callback")
-		return
-	}
+	return result, nil
+}
 
-	// Prepare response message
-	responseType := event.ResponseTypeCommandCompleted
-	responseSubtype := event.ResponseSubtypeNone
-	message := "Command executed successfully"
+// executeStreaming runs the command, sending its output to the sink as it is
+// produced
+func executeStreaming(ctx context.Context, req ExecuteRequest, commandDef *settings.SettingsCommandDefinition, sink ResultSink) ExecuteResult {
+	var (
+		mutex    sync.Mutex
+		sequence uint64
+		failed   bool
+	)
 
-	if result.Error != "" {
-		message = result.Error
-	} else if result.ExitCode != 0 {
-		message = fmt.Sprintf("Command exited with code %d", result.ExitCode)
-	}
+	result := executeWithOutput(ctx, commandDef, func(stream string, data []byte) {
+		// Hold the lock while sending so chunks are sent in sequence order
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if failed {
+			return
+		}
+
+		sequence++
+		if !sink.Output(OutputChunk{
+			CommandID: commandDef.ID,
+			Stream:    stream,
+			Sequence:  sequence,
+			Data:      string(data),
+		}) {
+			// The command keeps running so it is not left half done, but there
+			// is no one left to send its output to
+			slog.Warn(
+				"Failed to send command output, discarding the rest",
+				"commandID", commandDef.ID,
+				"connection", req.Connection,
+				"requestID", req.RequestID,
+			)
+			failed = true
+		}
+	})
 
-	// Send callback via WebSocket
-	response := event.MessageResponse{
-		ID:      req.RequestID,
-		Type:    responseType,
-		Subtype: responseSubtype,
-		Data:    result,
-		Message: message,
+	mutex.Lock()
+	result.Chunks = sequence
+	mutex.Unlock()
+	return result
+}
+
+// executeAsync runs the command and sends the result to the sink
+func executeAsync(ctx context.Context, req ExecuteRequest, commandDef *settings.SettingsCommandDefinition, job *runningJob, sink ResultSink) {
+	// Execute the command with context. The command runs even if the
+	// caller has gone, as its result can still be fetched by job ID.
+	var result ExecuteResult
+	if req.Stream && sink != nil {
+		result = executeStreaming(ctx, req, commandDef, sink)
+	} else {
+		result = execute(ctx, commandDef)
 	}
+	result.CommandID = commandDef.ID
+	result.JobID = job.job.ID
+	jobs.finishJob(job, result)
 
-	// Send message to the connection that initiated the request
-	if !ws.SendMessageToAddress(req.Connection, response) {
-		slog.Error("Failed to send command completion callback", "connection", req.Connection)
-		return
+	if sink != nil {
+		sink.Result(result)
 	}
 
-	// Log result with comprehensive audit information
+	logResult(req, result)
+}
+
+// logExecuting writes the audit log entry for a command that is starting,
+// with its connection, request ID and full arguments
+func logExecuting(req ExecuteRequest, commandDef *settings.SettingsCommandDefinition) {
+	slog.Info(
+		"Executing command",
+		"commandID", commandDef.ID,
+		"name", commandDef.Name,
+		"command", commandDef.Command,
+		"arguments", commandDef.Arguments,
+		"workingDir", commandDef.WorkingDir,
+		"runAs", commandDef.RunAs,
+		"timeout", commandTimeout(commandDef),
+		"connection", req.Connection,
+		"client", clientName(req.Connection),
+		"requestID", req.RequestID,
+		"stream", req.Stream,
+	)
+}
+
+// logResult writes the audit log entry for a finished command
+func logResult(req ExecuteRequest, result ExecuteResult) {
 	if result.Error != "" {
 		slog.Error(
 			"Command execution failed",
 			"commandID", result.CommandID,
+			"jobID", result.JobID,
 			"connection", req.Connection,
+			"client", clientName(req.Connection),
 			"requestID", req.RequestID,
 			"error", result.Error,
 		)
@@ -267,8 +355,10 @@ func executeAsync(req ExecuteRequest, commandDef *settings.SettingsCommandDefini
 			logLevel,
 			"Command execution completed",
 			"commandID", result.CommandID,
+			"jobID", result.JobID,
 			"exitCode", result.ExitCode,
 			"connection", req.Connection,
+			"client", clientName(req.Connection),
 			"requestID", req.RequestID,
 			"stdout", strings.TrimSpace(stdout),
 			"stderr", strings.TrimSpace(stderr),
@@ -3,42 +3,136 @@ package command
 import (
 	"bytes"
 	"context"
-	"io"
 	"os/exec"
+	"runtime"
+	"strings"
+	"syscall"
+	"unicode/utf8"
 
 	"github.com/timmo001/system-bridge/settings"
 )
 
-// limitedWriter limits the amount of data written to prevent memory exhaustion
+// Result errors of commands stopped before they exited
+const (
+	errorMessageTimeout  = "command execution timeout"
+	errorMessageCanceled = "command execution canceled"
+)
+
+// truncationMessage is appended to output that reached its size limit
+const truncationMessage = "\n... (output truncated)"
+
+// Output streams of a command
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+)
+
+// limitedWriter limits the amount of data written to prevent memory exhaustion.
+// Output past the limit is discarded rather than failing the write, so the
+// command still runs to completion and reports its exit code.
 type limitedWriter struct {
-	buffer  bytes.Buffer
-	limit   int64
-	written int64
+	buffer    bytes.Buffer
+	limit     int64
+	written   int64
+	truncated bool
 }
 
 func (lw *limitedWriter) Write(p []byte) (n int, err error) {
 	remaining := lw.limit - lw.written
-	if remaining <= 0 {
-		return 0, io.ErrShortWrite
-	}
-	if int64(len(p)) > remaining {
-		p = p[:remaining]
-		err = io.ErrShortWrite
+	data := p
+	if int64(len(data)) > remaining {
+		data = data[:max(remaining, 0)]
+		lw.truncated = true
 	}
-	n, writeErr := lw.buffer.Write(p)
-	lw.written += int64(n)
-	if writeErr != nil {
-		return n, writeErr
+	written, err := lw.buffer.Write(data)
+	lw.written += int64(written)
+	if err != nil {
+		return written, err
 	}
-	return n, err
+	return len(p), nil
 }
 
 func (lw *limitedWriter) String() string {
 	return lw.buffer.String()
 }
 
+// outputHandler receives the output of a streaming command as it is produced
+type outputHandler func(stream string, data []byte)
+
+// streamWriter passes output to an outputHandler as it is written. An
+// incomplete UTF-8 sequence at the end of a write is held back until the rest
+// of it arrives, so every chunk is valid text.
+//
+// Once limit bytes have been sent the rest of the output is discarded, and a
+// truncation message is sent in its place. A limit of zero sends all output.
+type streamWriter struct {
+	stream    string
+	handler   outputHandler
+	pending   []byte
+	limit     int64
+	sent      int64
+	truncated bool
+}
+
+func (sw *streamWriter) Write(p []byte) (int, error) {
+	if sw.truncated {
+		return len(p), nil
+	}
+
+	data := append(sw.pending, p...)
+	if remaining := sw.limit - sw.sent; sw.limit > 0 && int64(len(data)) > remaining {
+		data = data[:remaining]
+		// Drop a partial character left at the cut
+		data = data[:len(data)-incompleteRuneLen(data)]
+		sw.pending = nil
+		sw.truncated = true
+		if len(data) > 0 {
+			sw.handler(sw.stream, data)
+			sw.sent += int64(len(data))
+		}
+		sw.handler(sw.stream, []byte(truncationMessage))
+		return len(p), nil
+	}
+
+	complete := len(data) - incompleteRuneLen(data)
+	sw.pending = append([]byte(nil), data[complete:]...)
+	if complete > 0 {
+		sw.handler(sw.stream, data[:complete])
+		sw.sent += int64(complete)
+	}
+	return len(p), nil
+}
+
+// flush sends any held back output
+func (sw *streamWriter) flush() {
+	if len(sw.pending) > 0 {
+		sw.handler(sw.stream, sw.pending)
+		sw.pending = nil
+	}
+}
+
+// incompleteRuneLen returns the length of an incomplete UTF-8 sequence at the
+// end of data
+func incompleteRuneLen(data []byte) int {
+	for i := 1; i < utf8.UTFMax && i <= len(data); i++ {
+		if utf8.RuneStart(data[len(data)-i]) {
+			if utf8.FullRune(data[len(data)-i:]) {
+				return 0
+			}
+			return i
+		}
+	}
+	return 0
+}
+
 // execute runs the command with context for cancellation and timeout
 func execute(ctx context.Context, commandDef *settings.SettingsCommandDefinition) ExecuteResult {
+	return executeWithOutput(ctx, commandDef, nil)
+}
+
+// executeWithOutput runs the command like execute. If onOutput is set, output
+// is passed to it as it is produced instead of being returned in the result.
+func executeWithOutput(ctx context.Context, commandDef *settings.SettingsCommandDefinition, onOutput outputHandler) ExecuteResult {
 	result := ExecuteResult{
 		CommandID: commandDef.ID,
 		ExitCode:  -1,
@@ -50,14 +144,55 @@ func execute(ctx context.Context, commandDef *settings.SettingsCommandDefinition
 	// This is safe because exec.CommandContext does NOT invoke a shell - it executes the binary directly.
 	cmd := exec.CommandContext(ctx, commandDef.Command, commandDef.Arguments...)
 
+	// Ask the command to exit when the context is done, and kill it if it is
+	// still running after the grace period. Windows has no SIGTERM equivalent
+	// for console processes, so it is killed straight away.
+	cmd.Cancel = func() error {
+		if runtime.GOOS == "windows" {
+			return cmd.Process.Kill()
+		}
+		return cmd.Process.Signal(syscall.SIGTERM)
+	}
+	cmd.WaitDelay = CancelGracePeriod
+
 	// Set working directory if specified
 	if commandDef.WorkingDir != "" {
 		cmd.Dir = commandDef.WorkingDir
 	}
 
+	var userVariables []string
+	if commandDef.RunAs != "" {
+		var err error
+		userVariables, err = setCredentials(cmd, commandDef.RunAs)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+	}
+	cmd.Env = commandEnvironment(commandDef, userVariables)
+
+	if commandDef.Stdin != "" {
+		cmd.Stdin = strings.NewReader(commandDef.Stdin)
+	}
+
+	if onOutput != nil {
+		// Streamed output is not held in memory, so it is only capped when the
+		// command sets its own limit
+		stdoutStream := &streamWriter{stream: StreamStdout, handler: onOutput, limit: commandDef.MaxOutputSize}
+		stderrStream := &streamWriter{stream: StreamStderr, handler: onOutput, limit: commandDef.MaxOutputSize}
+		cmd.Stdout = stdoutStream
+		cmd.Stderr = stderrStream
+
+		err := cmd.Run()
+		stdoutStream.flush()
+		stderrStream.flush()
+		return exitResult(ctx, result, err)
+	}
+
 	// Capture stdout and stderr with size limits
-	stdoutWriter := &limitedWriter{limit: MaxOutputSize}
-	stderrWriter := &limitedWriter{limit: MaxOutputSize}
+	limit := outputLimit(commandDef)
+	stdoutWriter := &limitedWriter{limit: limit}
+	stderrWriter := &limitedWriter{limit: limit}
 	cmd.Stdout = stdoutWriter
 	cmd.Stderr = stderrWriter
 
@@ -65,38 +200,35 @@ func execute(ctx context.Context, commandDef *settings.SettingsCommandDefinition
 	err := cmd.Run()
 
 	// Capture output (may be truncated)
-	// Reserve space for truncation message: "\n... (output truncated)" = 26 bytes
-	const truncationMessage = "\n... (output truncated)"
-	const truncationMessageLen = len(truncationMessage)
-
-	result.Stdout = stdoutWriter.String()
-	result.Stderr = stderrWriter.String()
-
-	// Check if output was truncated and ensure final output doesn't exceed MaxOutputSize
-	if stdoutWriter.written >= MaxOutputSize {
-		// Truncate to make room for the truncation message
-		maxContentLen := int64(len(result.Stdout))
-		if maxContentLen > MaxOutputSize-int64(truncationMessageLen) {
-			result.Stdout = result.Stdout[:MaxOutputSize-int64(truncationMessageLen)]
-		}
-		result.Stdout += truncationMessage
+	result.Stdout = truncatedOutput(stdoutWriter, limit)
+	result.Stderr = truncatedOutput(stderrWriter, limit)
+
+	return exitResult(ctx, result, err)
+}
+
+// truncatedOutput returns the captured output, marking it when it was
+// truncated. The final output does not exceed the limit.
+func truncatedOutput(lw *limitedWriter, limit int64) string {
+	output := lw.String()
+	if !lw.truncated {
+		return output
 	}
-	if stderrWriter.written >= MaxOutputSize {
-		// Truncate to make room for the truncation message
-		maxContentLen := int64(len(result.Stderr))
-		if maxContentLen > MaxOutputSize-int64(truncationMessageLen) {
-			result.Stderr = result.Stderr[:MaxOutputSize-int64(truncationMessageLen)]
-		}
-		result.Stderr += truncationMessage
+	// Truncate to make room for the truncation message
+	if maxContentLen := limit - int64(len(truncationMessage)); int64(len(output)) > maxContentLen {
+		output = output[:max(maxContentLen, 0)]
 	}
+	return output + truncationMessage
+}
 
+// exitResult sets the exit code or error of a finished command on its result
+func exitResult(ctx context.Context, result ExecuteResult, err error) ExecuteResult {
 	// Check for context cancellation
 	if ctx.Err() == context.DeadlineExceeded {
-		result.Error = "command execution timeout"
+		result.Error = errorMessageTimeout
 		return result
 	}
 	if ctx.Err() == context.Canceled {
-		result.Error = "command execution canceled"
+		result.Error = errorMessageCanceled
 		return result
 	}
 
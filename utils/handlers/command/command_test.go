@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -359,6 +360,55 @@ func TestExecuteWithContext(t *testing.T) {
 	})
 }
 
+func TestExecuteWithOutput(t *testing.T) {
+	t.Run("Streams stdout and stderr", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		cmdPath := filepath.Join(tmpDir, "stream-command")
+		script := "#!/bin/sh\necho first\necho oops >&2\necho second\nexit 3\n"
+		err := os.WriteFile(cmdPath, []byte(script), 0755)
+		require.NoError(t, err)
+
+		commandDef := &settings.SettingsCommandDefinition{
+			ID:        "stream-command",
+			Name:      "Stream Command",
+			Command:   cmdPath,
+			Arguments: []string{},
+		}
+
+		// stdout and stderr are copied on separate goroutines
+		var mutex sync.Mutex
+		output := map[string]string{}
+		result := executeWithOutput(context.Background(), commandDef, func(stream string, data []byte) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			output[stream] += string(data)
+		})
+
+		assert.Equal(t, 3, result.ExitCode)
+		assert.Empty(t, result.Error)
+		assert.Empty(t, result.Stdout)
+		assert.Empty(t, result.Stderr)
+		assert.Equal(t, "first\nsecond\n", output[StreamStdout])
+		assert.Equal(t, "oops\n", output[StreamStderr])
+	})
+
+	t.Run("Holds back incomplete UTF-8", func(t *testing.T) {
+		var chunks []string
+		writer := &streamWriter{stream: StreamStdout, handler: func(stream string, data []byte) {
+			chunks = append(chunks, string(data))
+		}}
+
+		euro := []byte("€")
+		_, err := writer.Write(append([]byte("a"), euro[:2]...))
+		require.NoError(t, err)
+		_, err = writer.Write(euro[2:])
+		require.NoError(t, err)
+		writer.flush()
+
+		assert.Equal(t, []string{"a", "€"}, chunks)
+	})
+}
+
 func TestExecuteRequest(t *testing.T) {
 	t.Run("Create execute request", func(t *testing.T) {
 		req := ExecuteRequest{
@@ -639,3 +689,36 @@ func TestServerContextCancellation(t *testing.T) {
 		}
 	})
 }
+
+func TestExecuteSync(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{
+					ID:        "echo",
+					Name:      "Echo",
+					Command:   "/bin/echo",
+					Arguments: []string{"hello"},
+				},
+			},
+		},
+	}
+
+	t.Run("Returns the command result", func(t *testing.T) {
+		result, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "echo", RequestID: "req-1"}, cfg)
+
+		require.NoError(t, err)
+		assert.Equal(t, "echo", result.CommandID)
+		assert.Equal(t, 0, result.ExitCode)
+		assert.Equal(t, "hello\n", result.Stdout)
+	})
+
+	t.Run("Unknown command is denied", func(t *testing.T) {
+		_, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "missing"}, cfg)
+
+		assert.True(t, errors.Is(err, ErrCommandNotFound))
+	})
+}
@@ -0,0 +1,63 @@
+//go:build linux
+
+package command
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// setCredentials runs the command as another local user, returning the
+// environment variables that describe the user. System Bridge must be running
+// as root to switch user.
+func setCredentials(cmd *exec.Cmd, username string) ([]string, error) {
+	if os.Geteuid() != 0 {
+		return nil, errors.New("running a command as another user requires System Bridge to run as root")
+	}
+
+	account, err := user.Lookup(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %s: %w", username, err)
+	}
+	uid, err := strconv.ParseUint(account.Uid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid uid for user %s: %w", username, err)
+	}
+	gid, err := strconv.ParseUint(account.Gid, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gid for user %s: %w", username, err)
+	}
+
+	groupIDs, err := account.GroupIds()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up groups for user %s: %w", username, err)
+	}
+	groups := make([]uint32, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		group, err := strconv.ParseUint(groupID, 10, 32)
+		if err != nil {
+			continue
+		}
+		groups = append(groups, uint32(group))
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{
+		Uid:    uint32(uid),
+		Gid:    uint32(gid),
+		Groups: groups,
+	}
+
+	return []string{
+		"HOME=" + account.HomeDir,
+		"USER=" + account.Username,
+		"LOGNAME=" + account.Username,
+	}, nil
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package command
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// setCredentials is not supported on non-Linux platforms
+func setCredentials(_ *exec.Cmd, _ string) ([]string, error) {
+	return nil, errors.New("running a command as another user is only supported on Linux")
+}
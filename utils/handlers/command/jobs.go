@@ -0,0 +1,321 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils"
+)
+
+const (
+	// MaxHistoryEntries is the number of finished jobs kept in the history
+	MaxHistoryEntries = 200
+	// MaxHistoryOutputLength is the length stdout/stderr are truncated to in the history
+	MaxHistoryOutputLength = 4096
+	// CancelGracePeriod is how long a canceled command has to exit after being
+	// signalled before it is killed
+	CancelGracePeriod = 5 * time.Second
+	// historyFileName is the name of the history file in the data directory
+	historyFileName = "command_history.json"
+)
+
+var (
+	// ErrJobNotFound is returned when a job ID does not match a job
+	ErrJobNotFound = errors.New("job not found")
+	// ErrConcurrencyLimit is returned when a command is already running as many
+	// times as its maxConcurrent setting allows
+	ErrConcurrencyLimit = errors.New("command concurrency limit reached")
+)
+
+// JobStatus is the state of a command job
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusCompleted JobStatus = "completed"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCanceled  JobStatus = "canceled"
+	JobStatusTimedOut  JobStatus = "timeout"
+)
+
+// Job is a single execution of an allowlisted command
+type Job struct {
+	ID         string     `json:"jobID" mapstructure:"jobID"`
+	CommandID  string     `json:"commandID" mapstructure:"commandID"`
+	Name       string     `json:"name" mapstructure:"name"`
+	Status     JobStatus  `json:"status" mapstructure:"status"`
+	Connection string     `json:"connection,omitempty" mapstructure:"connection"`
+	StartedAt  time.Time  `json:"startedAt" mapstructure:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty" mapstructure:"finishedAt"`
+	DurationMs int64      `json:"durationMs" mapstructure:"durationMs"`
+	ExitCode   *int       `json:"exitCode,omitempty" mapstructure:"exitCode"`
+	Stdout     string     `json:"stdout,omitempty" mapstructure:"stdout"`
+	Stderr     string     `json:"stderr,omitempty" mapstructure:"stderr"`
+	Error      string     `json:"error,omitempty" mapstructure:"error"`
+}
+
+// runningJob is a job that has not finished yet
+type runningJob struct {
+	job    Job
+	cancel context.CancelFunc
+}
+
+// jobRegistry tracks running jobs and the history of finished ones
+type jobRegistry struct {
+	mutex     sync.RWMutex
+	saveMutex sync.Mutex
+	running   map[string]*runningJob
+	history   []Job
+	loaded    bool
+}
+
+var jobs = &jobRegistry{running: make(map[string]*runningJob)}
+
+// startJob registers a job for a command, enforcing its concurrency limit.
+// The returned context is canceled when the job is canceled.
+func (r *jobRegistry) startJob(ctx context.Context, req ExecuteRequest, commandDef *settings.SettingsCommandDefinition) (*runningJob, context.Context, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if commandDef.MaxConcurrent > 0 {
+		count := 0
+		for _, rj := range r.running {
+			if rj.job.CommandID == commandDef.ID {
+				count++
+			}
+		}
+		if count >= commandDef.MaxConcurrent {
+			return nil, nil, fmt.Errorf("%w: %s is already running %d time(s)", ErrConcurrencyLimit, commandDef.ID, count)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	rj := &runningJob{
+		job: Job{
+			ID:         uuid.NewString(),
+			CommandID:  commandDef.ID,
+			Name:       commandDef.Name,
+			Status:     JobStatusRunning,
+			Connection: req.Connection,
+			StartedAt:  time.Now(),
+		},
+		cancel: cancel,
+	}
+	r.running[rj.job.ID] = rj
+	return rj, ctx, nil
+}
+
+// finishJob records the result of a job in the history
+func (r *jobRegistry) finishJob(rj *runningJob, result ExecuteResult) Job {
+	rj.cancel()
+
+	finishedAt := time.Now()
+	job := rj.job
+	job.FinishedAt = &finishedAt
+	job.DurationMs = finishedAt.Sub(job.StartedAt).Milliseconds()
+	job.Stdout = truncateOutput(result.Stdout, MaxHistoryOutputLength)
+	job.Stderr = truncateOutput(result.Stderr, MaxHistoryOutputLength)
+	job.Error = result.Error
+
+	switch {
+	case result.Error == errorMessageCanceled:
+		job.Status = JobStatusCanceled
+	case result.Error == errorMessageTimeout:
+		job.Status = JobStatusTimedOut
+	case result.Error != "":
+		job.Status = JobStatusFailed
+	default:
+		exitCode := result.ExitCode
+		job.ExitCode = &exitCode
+		job.Status = JobStatusCompleted
+		if exitCode != 0 {
+			job.Status = JobStatusFailed
+		}
+	}
+
+	r.mutex.Lock()
+	delete(r.running, job.ID)
+	r.ensureLoaded()
+	r.history = append(r.history, job)
+	if len(r.history) > MaxHistoryEntries {
+		r.history = r.history[len(r.history)-MaxHistoryEntries:]
+	}
+	r.mutex.Unlock()
+
+	r.save()
+	return job
+}
+
+// save persists the history. Saves are serialized and each takes its own
+// snapshot, so the last save always writes the latest history.
+func (r *jobRegistry) save() {
+	r.saveMutex.Lock()
+	defer r.saveMutex.Unlock()
+
+	r.mutex.RLock()
+	history := make([]Job, len(r.history))
+	copy(history, r.history)
+	r.mutex.RUnlock()
+
+	if err := saveHistory(history); err != nil {
+		slog.Error("Failed to save command history", "error", err)
+	}
+}
+
+// ensureLoaded loads the persisted history on first use. It must be called
+// with the mutex held for writing.
+func (r *jobRegistry) ensureLoaded() {
+	if r.loaded {
+		return
+	}
+	r.loaded = true
+
+	history, err := loadHistory()
+	if err != nil {
+		slog.Warn("Failed to load command history", "error", err)
+		return
+	}
+	r.history = append(history, r.history...)
+}
+
+// ListRunning returns the running jobs, oldest first
+func ListRunning() []Job {
+	jobs.mutex.RLock()
+	defer jobs.mutex.RUnlock()
+
+	running := make([]Job, 0, len(jobs.running))
+	for _, rj := range jobs.running {
+		running = append(running, rj.job)
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].StartedAt.Before(running[j].StartedAt)
+	})
+	return running
+}
+
+// Cancel stops a running job. The command is signalled first and killed if
+// it has not exited after CancelGracePeriod.
+func Cancel(jobID string) (Job, error) {
+	jobs.mutex.RLock()
+	rj, ok := jobs.running[jobID]
+	jobs.mutex.RUnlock()
+	if !ok {
+		return Job{}, fmt.Errorf("%w: %s", ErrJobNotFound, jobID)
+	}
+
+	slog.Info("Canceling command job", "jobID", jobID, "commandID", rj.job.CommandID)
+	rj.cancel()
+	return rj.job, nil
+}
+
+// History returns finished jobs, newest first. An empty commandID returns
+// jobs for all commands, and a limit of zero or less returns all of them.
+func History(commandID string, limit int) []Job {
+	jobs.mutex.Lock()
+	defer jobs.mutex.Unlock()
+	jobs.ensureLoaded()
+
+	history := make([]Job, 0)
+	for i := len(jobs.history) - 1; i >= 0; i-- {
+		if commandID != "" && jobs.history[i].CommandID != commandID {
+			continue
+		}
+		history = append(history, jobs.history[i])
+		if limit > 0 && len(history) >= limit {
+			break
+		}
+	}
+	return history
+}
+
+// GetJob returns a running or finished job by its ID
+func GetJob(jobID string) (Job, error) {
+	jobs.mutex.Lock()
+	defer jobs.mutex.Unlock()
+
+	if rj, ok := jobs.running[jobID]; ok {
+		return rj.job, nil
+	}
+
+	jobs.ensureLoaded()
+	for i := len(jobs.history) - 1; i >= 0; i-- {
+		if jobs.history[i].ID == jobID {
+			return jobs.history[i], nil
+		}
+	}
+	return Job{}, fmt.Errorf("%w: %s", ErrJobNotFound, jobID)
+}
+
+// truncateOutput truncates output to a maximum length in bytes for the
+// history, without splitting a multi-byte character
+func truncateOutput(output string, maxLength int) string {
+	if len(output) <= maxLength {
+		return output
+	}
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(output[end]) {
+		end--
+	}
+	return output[:end] + "... (truncated)"
+}
+
+// historyPath returns the path of the history file
+func historyPath() (string, error) {
+	dataPath, err := utils.GetDataPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataPath, historyFileName), nil
+}
+
+// loadHistory reads the persisted history
+func loadHistory() ([]Job, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var history []Job
+	if err := json.Unmarshal(content, &history); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return history, nil
+}
+
+// saveHistory persists the history, replacing the file atomically
+func saveHistory(history []Job) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
@@ -0,0 +1,161 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// resetJobs isolates the job registry and its history file for a test
+func resetJobs(t *testing.T) {
+	t.Helper()
+	t.Setenv("SYSTEM_BRIDGE_CONFIG_DIR", t.TempDir())
+	jobs = &jobRegistry{running: make(map[string]*runningJob)}
+}
+
+func TestJobHistory(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{ID: "echo", Name: "Echo", Command: "/bin/echo", Arguments: []string{"hello"}},
+				{ID: "false", Name: "False", Command: "/bin/false", Arguments: []string{}},
+			},
+		},
+	}
+
+	echoResult, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "echo"}, cfg)
+	require.NoError(t, err)
+	require.NotEmpty(t, echoResult.JobID)
+	_, err = ExecuteSync(context.Background(), ExecuteRequest{CommandID: "false"}, cfg)
+	require.NoError(t, err)
+
+	t.Run("Newest first", func(t *testing.T) {
+		history := History("", 0)
+		require.Len(t, history, 2)
+		assert.Equal(t, "false", history[0].CommandID)
+		assert.Equal(t, JobStatusFailed, history[0].Status)
+		require.NotNil(t, history[0].ExitCode)
+		assert.Equal(t, 1, *history[0].ExitCode)
+		assert.Equal(t, JobStatusCompleted, history[1].Status)
+		assert.Equal(t, "hello\n", history[1].Stdout)
+		assert.NotNil(t, history[1].FinishedAt)
+	})
+
+	t.Run("Filtered by command", func(t *testing.T) {
+		history := History("echo", 1)
+		require.Len(t, history, 1)
+		assert.Equal(t, echoResult.JobID, history[0].ID)
+	})
+
+	t.Run("Get job by ID", func(t *testing.T) {
+		job, err := GetJob(echoResult.JobID)
+		require.NoError(t, err)
+		assert.Equal(t, "echo", job.CommandID)
+
+		_, err = GetJob("missing")
+		assert.True(t, errors.Is(err, ErrJobNotFound))
+	})
+
+	t.Run("Persisted", func(t *testing.T) {
+		jobs = &jobRegistry{running: make(map[string]*runningJob)}
+
+		job, err := GetJob(echoResult.JobID)
+		require.NoError(t, err)
+		assert.Equal(t, JobStatusCompleted, job.Status)
+		assert.Len(t, History("", 0), 2)
+	})
+}
+
+func TestJobConcurrencyLimit(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{ID: "backup", Name: "Backup", Command: "/bin/echo", Arguments: []string{}, MaxConcurrent: 1},
+			},
+		},
+	}
+
+	job, _, err := jobs.startJob(context.Background(), ExecuteRequest{}, &cfg.Commands.Allowlist[0])
+	require.NoError(t, err)
+
+	_, err = ExecuteSync(context.Background(), ExecuteRequest{CommandID: "backup"}, cfg)
+	assert.True(t, errors.Is(err, ErrConcurrencyLimit))
+
+	jobs.finishJob(job, ExecuteResult{})
+	_, err = ExecuteSync(context.Background(), ExecuteRequest{CommandID: "backup"}, cfg)
+	assert.NoError(t, err)
+}
+
+func TestJobCancel(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	tmpDir := t.TempDir()
+	cmdPath := filepath.Join(tmpDir, "long-command")
+	err := os.WriteFile(cmdPath, []byte("#!/bin/sh\nexec sleep 30\n"), 0755)
+	require.NoError(t, err)
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{ID: "long", Name: "Long", Command: cmdPath, Arguments: []string{}},
+			},
+		},
+	}
+
+	done := make(chan ExecuteResult, 1)
+	go func() {
+		result, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "long"}, cfg)
+		assert.NoError(t, err)
+		done <- result
+	}()
+
+	require.Eventually(t, func() bool {
+		return len(ListRunning()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	running := ListRunning()[0]
+	assert.Equal(t, JobStatusRunning, running.Status)
+
+	_, err = Cancel(running.ID)
+	require.NoError(t, err)
+
+	select {
+	case result := <-done:
+		assert.Equal(t, errorMessageCanceled, result.Error)
+	case <-time.After(CancelGracePeriod):
+		t.Fatal("command was not stopped by SIGTERM")
+	}
+
+	assert.Empty(t, ListRunning())
+	job, err := GetJob(running.ID)
+	require.NoError(t, err)
+	assert.Equal(t, JobStatusCanceled, job.Status)
+
+	_, err = Cancel(running.ID)
+	assert.True(t, errors.Is(err, ErrJobNotFound))
+}
+
+func TestTruncateOutput(t *testing.T) {
+	assert.Equal(t, "short", truncateOutput("short", 10))
+	assert.Equal(t, "abcde... (truncated)", truncateOutput("abcdefghij", 5))
+
+	// "é" is two bytes, so a cut after "caf" plus one byte backs up to "caf"
+	truncated := truncateOutput("café au lait", 4)
+	assert.Equal(t, "caf... (truncated)", truncated)
+	assert.True(t, utf8.ValidString(truncated))
+}
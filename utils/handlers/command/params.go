@@ -0,0 +1,195 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/timmo001/system-bridge/settings"
+	"github.com/timmo001/system-bridge/utils"
+)
+
+// ErrInvalidParameter is returned when a parameter value is missing, unknown
+// or fails validation
+var ErrInvalidParameter = errors.New("invalid command parameter")
+
+// ResolveArguments validates the parameter values for a command and returns
+// its arguments with every {{name}} placeholder replaced by its value.
+// Values are passed to the command as arguments without shell
+// interpretation, and are checked for shell metacharacters in the same way
+// as configured arguments.
+func ResolveArguments(commandDef *settings.SettingsCommandDefinition, params map[string]any) ([]string, error) {
+	declared := make(map[string]bool, len(commandDef.Parameters))
+	for _, param := range commandDef.Parameters {
+		declared[param.Name] = true
+	}
+	for name := range params {
+		if !declared[name] {
+			return nil, fmt.Errorf("%w: %s does not have a parameter %s", ErrInvalidParameter, commandDef.ID, name)
+		}
+	}
+
+	values := make(map[string]string, len(commandDef.Parameters))
+	for _, param := range commandDef.Parameters {
+		raw, ok := params[param.Name]
+		if !ok || raw == nil {
+			if param.Required {
+				return nil, fmt.Errorf("%w: %s is required", ErrInvalidParameter, param.Name)
+			}
+			if param.Default == "" {
+				values[param.Name] = ""
+				continue
+			}
+			raw = param.Default
+		}
+
+		value, err := parameterValue(commandDef.ID, param, raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidParameter, param.Name, err)
+		}
+		values[param.Name] = value
+	}
+
+	arguments := make([]string, len(commandDef.Arguments))
+	for i, arg := range commandDef.Arguments {
+		arguments[i] = settings.CommandParameterPlaceholder.ReplaceAllStringFunc(arg, func(placeholder string) string {
+			name := settings.CommandParameterPlaceholder.FindStringSubmatch(placeholder)[1]
+			return values[name]
+		})
+	}
+	return arguments, nil
+}
+
+// parameterValue converts and validates a single parameter value
+func parameterValue(commandID string, param settings.SettingsCommandParameter, raw any) (string, error) {
+	var value string
+	switch v := raw.(type) {
+	case string:
+		value = v
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		value = strconv.Itoa(v)
+	case int64:
+		value = strconv.FormatInt(v, 10)
+	case bool:
+		value = strconv.FormatBool(v)
+	default:
+		return "", fmt.Errorf("unsupported value type %T", raw)
+	}
+
+	switch param.Type {
+	case settings.CommandParameterTypeInt:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			// JSON numbers are decoded as floats, so allow ones with no fraction
+			float, floatErr := strconv.ParseFloat(value, 64)
+			if floatErr != nil || float != math.Trunc(float) || math.Abs(float) > math.MaxInt32 {
+				return "", fmt.Errorf("%q is not an integer", value)
+			}
+			number = int(float)
+		}
+		if param.Min != nil && number < *param.Min {
+			return "", fmt.Errorf("%d is less than the minimum of %d", number, *param.Min)
+		}
+		if param.Max != nil && number > *param.Max {
+			return "", fmt.Errorf("%d is greater than the maximum of %d", number, *param.Max)
+		}
+		value = strconv.Itoa(number)
+	case settings.CommandParameterTypeEnum:
+		if !slices.Contains(param.Values, value) {
+			return "", fmt.Errorf("%q is not one of: %s", value, strings.Join(param.Values, ", "))
+		}
+	case settings.CommandParameterTypePath:
+		path, err := pathWithinDirectory(value, param.Directory)
+		if err != nil {
+			return "", err
+		}
+		value = path
+	}
+
+	if param.Pattern != "" {
+		pattern, err := regexp.Compile(`^(?:` + param.Pattern + `)$`)
+		if err != nil {
+			return "", fmt.Errorf("invalid pattern: %w", err)
+		}
+		if !pattern.MatchString(value) {
+			return "", fmt.Errorf("%q does not match the pattern %s", value, param.Pattern)
+		}
+	}
+
+	if err := utils.ValidateCommandArgument(commandID, value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+// pathWithinDirectory resolves a path relative to a directory, and checks the
+// result does not escape it, including through symlinks
+func pathWithinDirectory(path, directory string) (string, error) {
+	if path == "" {
+		return "", errors.New("path is empty")
+	}
+	directory = filepath.Clean(directory)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(directory, path)
+	}
+	path = filepath.Clean(path)
+
+	resolvedDirectory, err := resolveSymlinks(directory)
+	if err != nil {
+		return "", err
+	}
+	resolvedPath, err := resolveSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+
+	relative, err := filepath.Rel(resolvedDirectory, resolvedPath)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is not within %s", path, directory)
+	}
+	return path, nil
+}
+
+// resolveSymlinks resolves the symlinks in a path. Paths that do not exist
+// yet are resolved from their nearest existing parent.
+func resolveSymlinks(path string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err == nil {
+		return resolved, nil
+	}
+	// A link whose target does not exist could be created outside the
+	// directory when it is written to
+	if info, lstatErr := os.Lstat(path); lstatErr == nil && info.Mode()&os.ModeSymlink != 0 {
+		return "", fmt.Errorf("cannot resolve symlink %s: %w", path, err)
+	}
+
+	parent := filepath.Dir(path)
+	if parent == path {
+		return path, nil
+	}
+	resolvedParent, err := resolveSymlinks(parent)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolvedParent, filepath.Base(path)), nil
+}
+
+// applyParameters returns a copy of the command definition with its
+// arguments resolved from the parameter values
+func applyParameters(commandDef *settings.SettingsCommandDefinition, params map[string]any) (*settings.SettingsCommandDefinition, error) {
+	arguments, err := ResolveArguments(commandDef, params)
+	if err != nil {
+		return nil, err
+	}
+	resolved := *commandDef
+	resolved.Arguments = arguments
+	return &resolved, nil
+}
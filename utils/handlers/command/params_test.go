@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+func TestResolveArguments(t *testing.T) {
+	intPtr := func(value int) *int { return &value }
+	dir := t.TempDir()
+
+	commandDef := &settings.SettingsCommandDefinition{
+		ID:        "set-brightness",
+		Arguments: []string{"--set", "{{level}}", "--mode={{mode}}", "{{file}}", "{{label}}"},
+		Parameters: []settings.SettingsCommandParameter{
+			{Name: "level", Type: settings.CommandParameterTypeInt, Required: true, Min: intPtr(0), Max: intPtr(100)},
+			{Name: "mode", Type: settings.CommandParameterTypeEnum, Values: []string{"day", "night"}, Default: "day"},
+			{Name: "file", Type: settings.CommandParameterTypePath, Directory: dir, Default: "default.conf"},
+			{Name: "label", Type: settings.CommandParameterTypeString, Pattern: "[a-z ]+"},
+		},
+	}
+
+	t.Run("Substitutes values and defaults", func(t *testing.T) {
+		arguments, err := ResolveArguments(commandDef, map[string]any{"level": float64(40), "label": "living room"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"--set", "40", "--mode=day", filepath.Join(dir, "default.conf"), "living room"}, arguments)
+	})
+
+	t.Run("Accepts string integers", func(t *testing.T) {
+		arguments, err := ResolveArguments(commandDef, map[string]any{"level": "100", "mode": "night"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "100", arguments[1])
+		assert.Equal(t, "--mode=night", arguments[2])
+	})
+
+	tests := []struct {
+		name   string
+		params map[string]any
+	}{
+		{name: "Missing required parameter", params: map[string]any{}},
+		{name: "Unknown parameter", params: map[string]any{"level": 1, "other": "x"}},
+		{name: "Above max", params: map[string]any{"level": 101}},
+		{name: "Below min", params: map[string]any{"level": -1}},
+		{name: "Not an integer", params: map[string]any{"level": 1.5}},
+		{name: "Not an enum value", params: map[string]any{"level": 1, "mode": "dusk"}},
+		{name: "Path outside directory", params: map[string]any{"level": 1, "file": "../../etc/passwd"}},
+		{name: "Absolute path outside directory", params: map[string]any{"level": 1, "file": "/etc/passwd"}},
+		{name: "Pattern mismatch", params: map[string]any{"level": 1, "label": "Room 1"}},
+		{name: "Shell metacharacters", params: map[string]any{"level": 1, "file": "a;rm -rf"}},
+		{name: "Unsupported type", params: map[string]any{"level": []string{"1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ResolveArguments(commandDef, tt.params)
+
+			assert.True(t, errors.Is(err, ErrInvalidParameter), "expected ErrInvalidParameter, got %v", err)
+		})
+	}
+}
+
+func TestPathWithinDirectorySymlinks(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o755))
+	for link, target := range map[string]string{
+		"out":      outside,
+		"in":       filepath.Join(dir, "sub"),
+		"dangling": filepath.Join(outside, "missing"),
+	} {
+		if err := os.Symlink(target, filepath.Join(dir, link)); err != nil {
+			t.Skipf("symlinks not supported: %v", err)
+		}
+	}
+	linkedDir := filepath.Join(t.TempDir(), "linked")
+	require.NoError(t, os.Symlink(dir, linkedDir))
+
+	for _, path := range []string{"sub/new.conf", "in/new.conf", "new/dir/file"} {
+		resolved, err := pathWithinDirectory(path, dir)
+		assert.NoError(t, err, path)
+		assert.Equal(t, filepath.Join(dir, path), resolved)
+	}
+	_, err := pathWithinDirectory("sub/new.conf", linkedDir)
+	assert.NoError(t, err, "the directory itself can be a symlink")
+
+	for _, path := range []string{"out", "out/file", "out/new/file", "dangling", filepath.Join(linkedDir, "out/file")} {
+		_, err := pathWithinDirectory(path, dir)
+		assert.Error(t, err, path)
+	}
+}
+
+func TestExecuteSyncWithParameters(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{
+					ID:        "greet",
+					Name:      "Greet",
+					Command:   "/bin/echo",
+					Arguments: []string{"hello", "{{name}}"},
+					Parameters: []settings.SettingsCommandParameter{
+						{Name: "name", Type: settings.CommandParameterTypeString, Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Rejects invalid values", func(t *testing.T) {
+		_, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "greet", Params: map[string]any{"name": "world $HOME"}}, cfg)
+
+		assert.True(t, errors.Is(err, ErrInvalidParameter))
+	})
+
+	t.Run("Runs with substituted arguments", func(t *testing.T) {
+		result, err := ExecuteSync(context.Background(), ExecuteRequest{CommandID: "greet", Params: map[string]any{"name": "world"}}, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world\n", result.Stdout)
+		assert.Equal(t, []string{"hello", "{{name}}"}, cfg.Commands.Allowlist[0].Arguments)
+	})
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"os"
+	"time"
+
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// commandTimeout returns how long a command may run before it is stopped
+func commandTimeout(commandDef *settings.SettingsCommandDefinition) time.Duration {
+	if commandDef.TimeoutSeconds > 0 {
+		return time.Duration(commandDef.TimeoutSeconds) * time.Second
+	}
+	return DefaultCommandTimeout
+}
+
+// outputLimit returns the maximum size of each of a command's output streams
+func outputLimit(commandDef *settings.SettingsCommandDefinition) int64 {
+	if commandDef.MaxOutputSize > 0 {
+		return commandDef.MaxOutputSize
+	}
+	return MaxOutputSize
+}
+
+// commandEnvironment returns the environment for a command, with the user
+// variables of a run as user. It returns nil when the command uses the
+// environment of System Bridge unchanged.
+func commandEnvironment(commandDef *settings.SettingsCommandDefinition, userVariables []string) []string {
+	if commandDef.InheritsEnvironment() && len(commandDef.Environment) == 0 && len(userVariables) == 0 {
+		return nil
+	}
+
+	environment := []string{}
+	if commandDef.InheritsEnvironment() {
+		environment = os.Environ()
+	}
+	// Later entries take precedence, so configured variables win
+	environment = append(environment, userVariables...)
+	return append(environment, commandDef.Environment...)
+}
@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"os"
+	"os/user"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+func TestCommandTimeout(t *testing.T) {
+	assert.Equal(t, DefaultCommandTimeout, commandTimeout(&settings.SettingsCommandDefinition{}))
+	assert.Equal(t, 30*time.Minute, commandTimeout(&settings.SettingsCommandDefinition{TimeoutSeconds: 1800}))
+}
+
+func TestCommandEnvironment(t *testing.T) {
+	inherit := false
+
+	t.Run("Unchanged environment", func(t *testing.T) {
+		assert.Nil(t, commandEnvironment(&settings.SettingsCommandDefinition{}, nil))
+	})
+
+	t.Run("Configured variables override inherited ones", func(t *testing.T) {
+		t.Setenv("SYSTEM_BRIDGE_TEST_VALUE", "inherited")
+
+		environment := commandEnvironment(&settings.SettingsCommandDefinition{
+			Environment: []string{"SYSTEM_BRIDGE_TEST_VALUE=configured"},
+		}, nil)
+
+		assert.Equal(t, "SYSTEM_BRIDGE_TEST_VALUE=configured", environment[len(environment)-1])
+		assert.Greater(t, len(environment), 1)
+	})
+
+	t.Run("Environment is not inherited", func(t *testing.T) {
+		environment := commandEnvironment(&settings.SettingsCommandDefinition{
+			Environment:        []string{"PATH=/opt/tools/bin"},
+			InheritEnvironment: &inherit,
+		}, []string{"HOME=/home/test"})
+
+		assert.Equal(t, []string{"HOME=/home/test", "PATH=/opt/tools/bin"}, environment)
+	})
+}
+
+func TestExecutePolicy(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Skipping Unix command tests on Windows")
+	}
+
+	t.Run("Runs with only the configured environment", func(t *testing.T) {
+		inherit := false
+		result := execute(context.Background(), &settings.SettingsCommandDefinition{
+			ID:                 "env",
+			Command:            "/usr/bin/env",
+			Environment:        []string{"PATH=/opt/tools/bin"},
+			InheritEnvironment: &inherit,
+		})
+
+		require.Empty(t, result.Error)
+		assert.Equal(t, "PATH=/opt/tools/bin\n", result.Stdout)
+	})
+
+	t.Run("Writes stdin", func(t *testing.T) {
+		result := execute(context.Background(), &settings.SettingsCommandDefinition{
+			ID:      "cat",
+			Command: "/bin/cat",
+			Stdin:   "from stdin",
+		})
+
+		require.Empty(t, result.Error)
+		assert.Equal(t, "from stdin", result.Stdout)
+	})
+
+	t.Run("Caps output", func(t *testing.T) {
+		result := execute(context.Background(), &settings.SettingsCommandDefinition{
+			ID:            "echo",
+			Command:       "/bin/echo",
+			Arguments:     []string{strings.Repeat("a", 100)},
+			MaxOutputSize: 64,
+		})
+
+		require.Empty(t, result.Error)
+		assert.Len(t, result.Stdout, 64)
+		assert.True(t, strings.HasSuffix(result.Stdout, truncationMessage))
+	})
+
+	t.Run("Caps streamed output", func(t *testing.T) {
+		var streamed strings.Builder
+		result := executeWithOutput(context.Background(), &settings.SettingsCommandDefinition{
+			ID:            "echo",
+			Command:       "/bin/echo",
+			Arguments:     []string{strings.Repeat("a", 100)},
+			MaxOutputSize: 10,
+		}, func(_ string, data []byte) {
+			streamed.Write(data)
+		})
+
+		require.Empty(t, result.Error)
+		assert.Equal(t, strings.Repeat("a", 10)+truncationMessage, streamed.String())
+	})
+
+	t.Run("Runs as another user", func(t *testing.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("Running as another user is only supported on Linux")
+		}
+		nobody, err := user.Lookup("nobody")
+		if err != nil {
+			t.Skip("No nobody user to run as")
+		}
+
+		result := execute(context.Background(), &settings.SettingsCommandDefinition{
+			ID:      "id",
+			Command: "/usr/bin/id",
+			Arguments: []string{
+				"-u",
+			},
+			RunAs: "nobody",
+		})
+
+		if os.Geteuid() != 0 {
+			assert.Contains(t, result.Error, "requires System Bridge to run as root")
+			return
+		}
+		require.Empty(t, result.Error)
+		assert.Equal(t, nobody.Uid+"\n", result.Stdout)
+	})
+}
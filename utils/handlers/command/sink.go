@@ -0,0 +1,68 @@
+package command
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/timmo001/system-bridge/backend/websocket"
+	"github.com/timmo001/system-bridge/event"
+)
+
+// ResultSink receives the output and result of a command run by Execute, so
+// execution does not depend on how the caller is connected
+type ResultSink interface {
+	// Output is called with each chunk of a streaming execution as it is
+	// produced. It returns false if the chunk could not be delivered, after
+	// which no more output is sent to the sink.
+	Output(chunk OutputChunk) bool
+	// Result is called once with the result when the command finishes
+	Result(result ExecuteResult)
+}
+
+// WebSocketSink sends command output and results to a WebSocket connection
+type WebSocketSink struct {
+	Connection string
+	RequestID  string
+}
+
+// Output sends a COMMAND_OUTPUT message
+func (s WebSocketSink) Output(chunk OutputChunk) bool {
+	ws := websocket.GetInstance()
+	if ws == nil {
+		return false
+	}
+	return ws.SendMessageToAddress(s.Connection, event.MessageResponse{
+		ID:      s.RequestID,
+		Type:    event.ResponseTypeCommandOutput,
+		Subtype: event.ResponseSubtypeNone,
+		Data:    chunk,
+	})
+}
+
+// Result sends a COMMAND_COMPLETED message. The connection may have closed
+// while the command ran, in which case the result can still be fetched by
+// job ID.
+func (s WebSocketSink) Result(result ExecuteResult) {
+	ws := websocket.GetInstance()
+	if ws == nil {
+		slog.Error("WebSocket instance not available for command callback")
+		return
+	}
+
+	message := "Command executed successfully"
+	if result.Error != "" {
+		message = result.Error
+	} else if result.ExitCode != 0 {
+		message = fmt.Sprintf("Command exited with code %d", result.ExitCode)
+	}
+
+	if !ws.SendMessageToAddress(s.Connection, event.MessageResponse{
+		ID:      s.RequestID,
+		Type:    event.ResponseTypeCommandCompleted,
+		Subtype: event.ResponseSubtypeNone,
+		Data:    result,
+		Message: message,
+	}) {
+		slog.Error("Failed to send command completion callback", "connection", s.Connection, "jobID", result.JobID)
+	}
+}
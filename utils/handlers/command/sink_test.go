@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/timmo001/system-bridge/settings"
+)
+
+// recordingSink records what it receives, for tests
+type recordingSink struct {
+	mutex  sync.Mutex
+	chunks []OutputChunk
+	result chan ExecuteResult
+}
+
+func (s *recordingSink) Output(chunk OutputChunk) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.chunks = append(s.chunks, chunk)
+	return true
+}
+
+func (s *recordingSink) Result(result ExecuteResult) {
+	s.result <- result
+}
+
+func TestExecuteWithSink(t *testing.T) {
+	resetJobs(t)
+	SetServerContext(context.Background())
+
+	cfg := &settings.Settings{
+		Commands: settings.SettingsCommands{
+			Allowlist: []settings.SettingsCommandDefinition{
+				{ID: "echo", Name: "Echo", Command: "/bin/echo", Arguments: []string{"hello"}},
+			},
+		},
+	}
+
+	t.Run("Streams output and the result to the sink", func(t *testing.T) {
+		sink := &recordingSink{result: make(chan ExecuteResult, 1)}
+
+		jobID, err := Execute(ExecuteRequest{CommandID: "echo", Stream: true}, cfg, sink)
+		require.NoError(t, err)
+
+		select {
+		case result := <-sink.result:
+			assert.Equal(t, jobID, result.JobID)
+			assert.Equal(t, 0, result.ExitCode)
+			assert.EqualValues(t, 1, result.Chunks)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for the result")
+		}
+
+		sink.mutex.Lock()
+		defer sink.mutex.Unlock()
+		require.Len(t, sink.chunks, 1)
+		assert.Equal(t, "hello\n", sink.chunks[0].Data)
+	})
+
+	t.Run("Runs without a sink", func(t *testing.T) {
+		jobID, err := Execute(ExecuteRequest{CommandID: "echo"}, cfg, nil)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			job, err := GetJob(jobID)
+			return err == nil && job.Status == JobStatusCompleted
+		}, 5*time.Second, 10*time.Millisecond)
+
+		job, err := GetJob(jobID)
+		require.NoError(t, err)
+		assert.Equal(t, "hello\n", job.Stdout)
+	})
+}
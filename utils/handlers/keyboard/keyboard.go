@@ -2,7 +2,6 @@ package keyboard
 
 import (
 	"log/slog"
-	"strings"
 	"time"
 
 	"github.com/go-vgo/robotgo"
@@ -42,16 +41,8 @@ func sendKeypress(data KeypressData) error {
 	// Convert modifiers to robotgo format
 	var modifiers []any
 	for _, mod := range data.Modifiers {
-		mod = strings.ToLower(mod)
-		switch mod {
-		case "shift":
-			modifiers = append(modifiers, "shift")
-		case "ctrl", "control":
-			modifiers = append(modifiers, "ctrl")
-		case "alt":
-			modifiers = append(modifiers, "alt")
-		case "cmd", "command":
-			modifiers = append(modifiers, "cmd")
+		if name, ok := normalizeModifier(mod); ok {
+			modifiers = append(modifiers, name)
 		}
 	}
 
@@ -0,0 +1,76 @@
+package keyboard
+
+import (
+	"slices"
+	"strings"
+)
+
+// namedKeys are the keys with names, as understood by robotgo
+var namedKeys = []string{
+	"backspace", "delete", "enter", "tab", "esc", "escape",
+	"up", "down", "right", "left", "home", "end", "pageup", "pagedown",
+	"f1", "f2", "f3", "f4", "f5", "f6", "f7", "f8", "f9", "f10", "f11", "f12",
+	"f13", "f14", "f15", "f16", "f17", "f18", "f19", "f20", "f21", "f22", "f23", "f24",
+	"cmd", "lcmd", "rcmd", "command",
+	"alt", "lalt", "ralt",
+	"ctrl", "lctrl", "rctrl", "control",
+	"shift", "lshift", "rshift", "right_shift",
+	"capslock", "space", "print", "printscreen", "insert", "menu",
+	"audio_mute", "audio_vol_down", "audio_vol_up", "audio_play", "audio_stop",
+	"audio_pause", "audio_prev", "audio_next", "audio_rewind", "audio_forward",
+	"audio_repeat", "audio_random",
+	"num0", "num1", "num2", "num3", "num4", "num5", "num6", "num7", "num8", "num9",
+	"num_lock", "num.", "num+", "num-", "num*", "num/", "num_clear", "num_enter", "num_equal",
+	"numpad_0", "numpad_1", "numpad_2", "numpad_3", "numpad_4",
+	"numpad_5", "numpad_6", "numpad_7", "numpad_8", "numpad_9", "numpad_lock",
+	"lights_mon_up", "lights_mon_down", "lights_kbd_toggle", "lights_kbd_up", "lights_kbd_down",
+}
+
+// modifiers maps the accepted modifier names onto their robotgo names
+var modifiers = map[string]string{
+	"shift":   "shift",
+	"ctrl":    "ctrl",
+	"control": "ctrl",
+	"alt":     "alt",
+	"cmd":     "cmd",
+	"command": "cmd",
+}
+
+// characters are the printable ASCII characters that can be used as keys
+// on their own
+const characters = " !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"
+
+// KeysData lists the keys and modifiers that keyboard events accept
+type KeysData struct {
+	// Keys are the named keys, such as enter, f5 or audio_play
+	Keys []string `json:"keys" mapstructure:"keys"`
+	// Modifiers are the modifiers that can be held while tapping a key
+	Modifiers []string `json:"modifiers" mapstructure:"modifiers"`
+	// Characters are the single characters that can be used as keys.
+	// Uppercase letters are typed with shift held.
+	Characters string `json:"characters" mapstructure:"characters"`
+}
+
+// GetKeys returns the keys and modifiers that keyboard events accept
+func GetKeys() KeysData {
+	return KeysData{
+		Keys:       slices.Clone(namedKeys),
+		Modifiers:  []string{"shift", "ctrl", "alt", "cmd"},
+		Characters: characters,
+	}
+}
+
+// IsValidKey reports whether a key is a single printable character or a
+// named key
+func IsValidKey(key string) bool {
+	if len(key) == 1 {
+		return strings.Contains(characters, key)
+	}
+	return slices.Contains(namedKeys, strings.ToLower(key))
+}
+
+// normalizeModifier returns the robotgo name of a modifier
+func normalizeModifier(modifier string) (string, bool) {
+	name, ok := modifiers[strings.ToLower(modifier)]
+	return name, ok
+}
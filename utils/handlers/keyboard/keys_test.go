@@ -0,0 +1,65 @@
+package keyboard
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// robotgoKeyNames returns the keys of robotgo's keyNames map, read from its
+// source in the module cache
+func robotgoKeyNames(t *testing.T) []string {
+	t.Helper()
+
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "github.com/go-vgo/robotgo").Output()
+	if err != nil {
+		t.Skipf("robotgo source not available: %v", err)
+	}
+	file, err := parser.ParseFile(token.NewFileSet(), filepath.Join(strings.TrimSpace(string(out)), "key.go"), nil, 0)
+	if err != nil {
+		t.Skipf("robotgo source not available: %v", err)
+	}
+
+	var names []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != "keyNames" || len(spec.Values) != 1 {
+			return true
+		}
+		for _, elt := range spec.Values[0].(*ast.CompositeLit).Elts {
+			key, ok := elt.(*ast.KeyValueExpr).Key.(*ast.BasicLit)
+			if !ok {
+				continue
+			}
+			name, err := strconv.Unquote(key.Value)
+			if err == nil {
+				names = append(names, name)
+			}
+		}
+		return false
+	})
+	if len(names) == 0 {
+		t.Fatal("keyNames not found in robotgo's key.go")
+	}
+	return names
+}
+
+func TestNamedKeysMatchRobotgo(t *testing.T) {
+	robotgoKeys := robotgoKeyNames(t)
+	for _, key := range robotgoKeys {
+		if !slices.Contains(namedKeys, key) {
+			t.Errorf("robotgo key %q is missing from namedKeys", key)
+		}
+	}
+	for _, key := range namedKeys {
+		if !slices.Contains(robotgoKeys, key) {
+			t.Errorf("%q in namedKeys is not a robotgo key", key)
+		}
+	}
+}
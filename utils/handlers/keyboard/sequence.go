@@ -0,0 +1,154 @@
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-vgo/robotgo"
+)
+
+// StepType is the kind of a keyboard sequence step
+type StepType string
+
+const (
+	StepKeyDown StepType = "key_down"
+	StepKeyUp   StepType = "key_up"
+	StepTap     StepType = "tap"
+	StepText    StepType = "text"
+	StepSleep   StepType = "sleep"
+)
+
+// MaxSequenceDuration caps the total sleep time of a sequence
+const MaxSequenceDuration = time.Minute
+
+// ErrInvalidSequence is returned for a sequence that fails validation.
+// Invalid sequences are rejected before any step runs.
+var ErrInvalidSequence = errors.New("invalid keyboard sequence")
+
+// SequenceStep is a single step of a keyboard sequence
+type SequenceStep struct {
+	Type StepType `json:"type" mapstructure:"type"`
+	// Key is the key for key_down, key_up and tap steps
+	Key string `json:"key,omitempty" mapstructure:"key"`
+	// Modifiers are held while a tap step's key is pressed
+	Modifiers []string `json:"modifiers,omitempty" mapstructure:"modifiers"`
+	// Text is typed by text steps
+	Text string `json:"text,omitempty" mapstructure:"text"`
+	// Duration is the time to wait in milliseconds for sleep steps
+	Duration int `json:"duration,omitempty" mapstructure:"duration"`
+}
+
+// SequenceData represents the data needed for a keyboard sequence
+type SequenceData struct {
+	Steps []SequenceStep `json:"steps" mapstructure:"steps"`
+}
+
+// indirection for testability
+var (
+	robotKeyDown = robotgo.KeyDown
+	robotKeyUp   = robotgo.KeyUp
+	sleep        = time.Sleep
+)
+
+// sequenceMutex stops sequences interleaving their key presses
+var sequenceMutex sync.Mutex
+
+// SendSequence validates a sequence and runs its steps in order. Keys still
+// held when the sequence ends, or when a step fails, are released.
+func SendSequence(data SequenceData) error {
+	if err := ValidateSequence(data); err != nil {
+		return err
+	}
+
+	sequenceMutex.Lock()
+	defer sequenceMutex.Unlock()
+
+	slog.Info("Sending keyboard sequence", "steps", len(data.Steps))
+
+	held := make([]string, 0)
+	defer func() {
+		for i := len(held) - 1; i >= 0; i-- {
+			if err := robotKeyUp(held[i]); err != nil {
+				slog.Warn("Failed to release held key", "key", held[i], "error", err)
+			}
+		}
+	}()
+
+	for i, step := range data.Steps {
+		switch step.Type {
+		case StepKeyDown:
+			if err := robotKeyDown(step.Key); err != nil {
+				return fmt.Errorf("step %d: failed to press %s: %w", i+1, step.Key, err)
+			}
+			held = append(held, step.Key)
+		case StepKeyUp:
+			if err := robotKeyUp(step.Key); err != nil {
+				return fmt.Errorf("step %d: failed to release %s: %w", i+1, step.Key, err)
+			}
+			held = slices.DeleteFunc(held, func(key string) bool { return key == step.Key })
+		case StepTap:
+			if err := sendKeypress(KeypressData{Key: step.Key, Modifiers: step.Modifiers}); err != nil {
+				return fmt.Errorf("step %d: failed to tap %s: %w", i+1, step.Key, err)
+			}
+		case StepText:
+			if err := sendText(step.Text); err != nil {
+				return fmt.Errorf("step %d: failed to type text: %w", i+1, err)
+			}
+		case StepSleep:
+			sleep(time.Duration(step.Duration) * time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// ValidateSequence checks every step of a sequence, normalizing key names
+func ValidateSequence(data SequenceData) error {
+	if len(data.Steps) == 0 {
+		return fmt.Errorf("%w: no steps", ErrInvalidSequence)
+	}
+
+	var total time.Duration
+	for i := range data.Steps {
+		step := &data.Steps[i]
+		step.Type = StepType(strings.ToLower(string(step.Type)))
+
+		switch step.Type {
+		case StepKeyDown, StepKeyUp, StepTap:
+			if !IsValidKey(step.Key) {
+				return fmt.Errorf("%w: step %d: unknown key %q", ErrInvalidSequence, i+1, step.Key)
+			}
+			if len(step.Key) > 1 {
+				step.Key = strings.ToLower(step.Key)
+			}
+			if step.Type != StepTap && len(step.Modifiers) > 0 {
+				return fmt.Errorf("%w: step %d: modifiers are only used by tap steps, hold them with key_down instead", ErrInvalidSequence, i+1)
+			}
+			for _, modifier := range step.Modifiers {
+				if _, ok := normalizeModifier(modifier); !ok {
+					return fmt.Errorf("%w: step %d: unknown modifier %q", ErrInvalidSequence, i+1, modifier)
+				}
+			}
+		case StepText:
+			if step.Text == "" {
+				return fmt.Errorf("%w: step %d: no text", ErrInvalidSequence, i+1)
+			}
+		case StepSleep:
+			if step.Duration <= 0 {
+				return fmt.Errorf("%w: step %d: duration must be positive", ErrInvalidSequence, i+1)
+			}
+			total += time.Duration(step.Duration) * time.Millisecond
+		default:
+			return fmt.Errorf("%w: step %d: unknown type %q", ErrInvalidSequence, i+1, step.Type)
+		}
+	}
+
+	if total > MaxSequenceDuration {
+		return fmt.Errorf("%w: sleeps total %s, more than %s", ErrInvalidSequence, total, MaxSequenceDuration)
+	}
+	return nil
+}
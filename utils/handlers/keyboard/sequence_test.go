@@ -0,0 +1,153 @@
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withSequenceStubs records every robotgo call and sleep as a string
+func withSequenceStubs(t *testing.T) *[]string {
+	t.Helper()
+
+	var calls []string
+
+	origKeyTap := robotKeyTap
+	origTypeStr := robotTypeStr
+	origKeyDown := robotKeyDown
+	origKeyUp := robotKeyUp
+	origSleep := sleep
+
+	robotKeyTap = func(key string, args ...any) error {
+		calls = append(calls, fmt.Sprintf("tap %s %v", key, args))
+		return nil
+	}
+	robotTypeStr = func(text string, args ...int) {
+		calls = append(calls, "text "+text)
+	}
+	robotKeyDown = func(key string, args ...any) error {
+		calls = append(calls, "down "+key)
+		return nil
+	}
+	robotKeyUp = func(key string, args ...any) error {
+		calls = append(calls, "up "+key)
+		return nil
+	}
+	sleep = func(d time.Duration) {
+		calls = append(calls, "sleep "+d.String())
+	}
+
+	t.Cleanup(func() {
+		robotKeyTap = origKeyTap
+		robotTypeStr = origTypeStr
+		robotKeyDown = origKeyDown
+		robotKeyUp = origKeyUp
+		sleep = origSleep
+	})
+
+	return &calls
+}
+
+func TestSendSequence_HoldShift(t *testing.T) {
+	calls := withSequenceStubs(t)
+
+	err := SendSequence(SequenceData{Steps: []SequenceStep{
+		{Type: StepKeyDown, Key: "Shift"},
+		{Type: StepTap, Key: "w"},
+		{Type: StepSleep, Duration: 250},
+		{Type: StepTap, Key: "a", Modifiers: []string{"ctrl"}},
+		{Type: "KEY_UP", Key: "shift"},
+		{Type: StepText, Text: "gg"},
+	}})
+	if err != nil {
+		t.Fatalf("SendSequence returned error: %v", err)
+	}
+
+	want := []string{"down shift", "tap w []", "sleep 250ms", "tap a [ctrl]", "up shift", "text gg"}
+	if strings.Join(*calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestSendSequence_ReleasesHeldKeys(t *testing.T) {
+	calls := withSequenceStubs(t)
+
+	err := SendSequence(SequenceData{Steps: []SequenceStep{
+		{Type: StepKeyDown, Key: "ctrl"},
+		{Type: StepKeyDown, Key: "shift"},
+		{Type: StepTap, Key: "t"},
+	}})
+	if err != nil {
+		t.Fatalf("SendSequence returned error: %v", err)
+	}
+
+	want := []string{"down ctrl", "down shift", "tap t []", "up shift", "up ctrl"}
+	if strings.Join(*calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestSendSequence_ReleasesHeldKeysOnError(t *testing.T) {
+	calls := withSequenceStubs(t)
+	robotKeyTap = func(key string, args ...any) error { return errors.New("boom") }
+
+	err := SendSequence(SequenceData{Steps: []SequenceStep{
+		{Type: StepKeyDown, Key: "alt"},
+		{Type: StepTap, Key: "tab"},
+		{Type: StepTap, Key: "tab"},
+	}})
+	if err == nil || errors.Is(err, ErrInvalidSequence) {
+		t.Fatalf("expected the tap error, got %v", err)
+	}
+
+	want := []string{"down alt", "up alt"}
+	if strings.Join(*calls, ", ") != strings.Join(want, ", ") {
+		t.Errorf("calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestSendSequence_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []SequenceStep
+	}{
+		{"No steps", nil},
+		{"Unknown type", []SequenceStep{{Type: "press", Key: "a"}}},
+		{"Unknown key", []SequenceStep{{Type: StepTap, Key: "hyper"}}},
+		{"Unknown modifier", []SequenceStep{{Type: StepTap, Key: "a", Modifiers: []string{"fn"}}}},
+		{"Modifiers on key down", []SequenceStep{{Type: StepKeyDown, Key: "a", Modifiers: []string{"shift"}}}},
+		{"Empty text", []SequenceStep{{Type: StepText}}},
+		{"Zero sleep", []SequenceStep{{Type: StepSleep}}},
+		{"Too long", []SequenceStep{{Type: StepSleep, Duration: 40000}, {Type: StepSleep, Duration: 40000}}},
+		{"Invalid after valid", []SequenceStep{{Type: StepTap, Key: "a"}, {Type: StepTap, Key: ""}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := withSequenceStubs(t)
+
+			err := SendSequence(SequenceData{Steps: tt.steps})
+			if !errors.Is(err, ErrInvalidSequence) {
+				t.Fatalf("expected ErrInvalidSequence, got %v", err)
+			}
+			if len(*calls) != 0 {
+				t.Errorf("expected no steps to run, got %v", *calls)
+			}
+		})
+	}
+}
+
+func TestIsValidKey(t *testing.T) {
+	for _, key := range []string{"a", "Z", "5", "/", " ", "enter", "F5", "audio_play", "num+", "numpad_1", "NUMPAD_LOCK"} {
+		if !IsValidKey(key) {
+			t.Errorf("expected %q to be valid", key)
+		}
+	}
+	for _, key := range []string{"", "é", "f25", "hyper", "numpad_10"} {
+		if IsValidKey(key) {
+			t.Errorf("expected %q to be invalid", key)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package media
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 // MediaAction represents the type of media control action
 type MediaAction string
 
@@ -20,9 +26,106 @@ const (
 	MediaActionVolumeDown MediaAction = "VOLUME_DOWN"
 	// MediaActionMute represents the mute action
 	MediaActionMute MediaAction = "MUTE"
+	// MediaActionSeek represents the seek action
+	MediaActionSeek MediaAction = "SEEK"
+	// MediaActionSetVolume represents the set volume action
+	MediaActionSetVolume MediaAction = "SET_VOLUME"
+	// MediaActionSetShuffle represents the set shuffle action
+	MediaActionSetShuffle MediaAction = "SET_SHUFFLE"
+	// MediaActionSetRepeat represents the set repeat action
+	MediaActionSetRepeat MediaAction = "SET_REPEAT"
+	// MediaActionSetRate represents the set playback rate action
+	MediaActionSetRate MediaAction = "SET_RATE"
+)
+
+// Repeat modes for the set repeat action
+const (
+	RepeatNone     = "NONE"
+	RepeatTrack    = "TRACK"
+	RepeatPlaylist = "PLAYLIST"
 )
 
-// Control sends a media control command
-func Control(action MediaAction) error {
-	return control(action)
+var (
+	// ErrInvalidAction is returned for an unknown action
+	ErrInvalidAction = errors.New("invalid media action")
+	// ErrInvalidValue is returned when an action is missing its value, or
+	// its value is out of range
+	ErrInvalidValue = errors.New("invalid media control value")
+	// ErrPlayerNotFound is returned when the player to control is not running
+	ErrPlayerNotFound = errors.New("media player not found")
+	// ErrNotSupported is returned for actions the platform cannot perform
+	ErrNotSupported = errors.New("media action not supported on this platform")
+)
+
+// ControlRequest is a media control action with the values it needs
+type ControlRequest struct {
+	Action MediaAction
+	// Player is the player to control. Empty targets the system's default
+	// player.
+	Player string
+	// Position is the position to seek to in seconds, or the offset to seek
+	// by when Relative is set
+	Position *float64
+	Relative bool
+	// Volume is the volume to set, from 0 to 100
+	Volume *float64
+	// Shuffle is whether to shuffle
+	Shuffle *bool
+	// Repeat is the repeat mode: NONE, TRACK or PLAYLIST
+	Repeat string
+	// Rate is the playback rate to set, where 1 is normal speed
+	Rate *float64
+}
+
+// Validate checks the action is known and has the values it needs,
+// normalizing the repeat mode
+func (r *ControlRequest) Validate() error {
+	switch r.Action {
+	case MediaActionPlay, MediaActionPause, MediaActionNext, MediaActionPrevious, MediaActionStop,
+		MediaActionVolumeUp, MediaActionVolumeDown, MediaActionMute:
+	case MediaActionSeek:
+		if r.Position == nil {
+			return fmt.Errorf("%w: SEEK needs a position", ErrInvalidValue)
+		}
+		if !r.Relative && *r.Position < 0 {
+			return fmt.Errorf("%w: SEEK position cannot be negative", ErrInvalidValue)
+		}
+	case MediaActionSetVolume:
+		if r.Volume == nil || *r.Volume < 0 || *r.Volume > 100 {
+			return fmt.Errorf("%w: SET_VOLUME needs a volume from 0 to 100", ErrInvalidValue)
+		}
+	case MediaActionSetShuffle:
+		if r.Shuffle == nil {
+			return fmt.Errorf("%w: SET_SHUFFLE needs shuffle", ErrInvalidValue)
+		}
+	case MediaActionSetRepeat:
+		switch strings.ToUpper(r.Repeat) {
+		case RepeatNone:
+			r.Repeat = RepeatNone
+		case RepeatTrack:
+			r.Repeat = RepeatTrack
+		// LIST is how the media module reports playlist repeat
+		case RepeatPlaylist, "LIST":
+			r.Repeat = RepeatPlaylist
+		default:
+			return fmt.Errorf("%w: SET_REPEAT needs a repeat of NONE, TRACK or PLAYLIST", ErrInvalidValue)
+		}
+	case MediaActionSetRate:
+		if r.Rate == nil || *r.Rate <= 0 {
+			return fmt.Errorf("%w: SET_RATE needs a rate above 0", ErrInvalidValue)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidAction, r.Action)
+	}
+	return nil
+}
+
+// Control sends a media control command to a player. Only Linux can target
+// a player, as other platforms report and control the system's current
+// media session.
+func Control(request ControlRequest) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	return control(request)
 }
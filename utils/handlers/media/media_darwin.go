@@ -7,10 +7,10 @@ import (
 	"os/exec"
 )
 
-func control(action MediaAction) error {
+func control(request ControlRequest) error {
 	var script string
 
-	switch action {
+	switch request.Action {
 	case MediaActionPlay, MediaActionPause:
 		script = "tell application \"System Events\" to key code 49" // Space
 	case MediaActionNext:
@@ -26,7 +26,7 @@ func control(action MediaAction) error {
 	case MediaActionMute:
 		script = "set volume with output muted"
 	default:
-		return fmt.Errorf("unsupported media action: %s", action)
+		return fmt.Errorf("%w: %s", ErrNotSupported, request.Action)
 	}
 
 	cmd := exec.Command("osascript", "-e", script)
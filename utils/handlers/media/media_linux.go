@@ -3,31 +3,81 @@
 package media
 
 import (
+	"errors"
 	"fmt"
-	"os/exec"
+
+	"github.com/timmo001/system-bridge/utils/mpris"
 )
 
-func control(action MediaAction) error {
-	var cmd *exec.Cmd
+// volumeStep is how much VOLUME_UP and VOLUME_DOWN change the volume by
+const volumeStep = 0.05
+
+// loopStatuses maps repeat modes onto MPRIS loop statuses
+var loopStatuses = map[string]string{
+	RepeatNone:     "None",
+	RepeatTrack:    "Track",
+	RepeatPlaylist: "Playlist",
+}
+
+func control(request ControlRequest) error {
+	client, err := mpris.SessionBus()
+	if err != nil {
+		return err
+	}
 
-	switch action {
-	case MediaActionPlay, MediaActionPause:
-		cmd = exec.Command("playerctl", "play-pause")
+	player, err := client.Resolve(request.Player)
+	if errors.Is(err, mpris.ErrPlayerNotFound) {
+		return fmt.Errorf("%w: %w", ErrPlayerNotFound, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	switch request.Action {
+	case MediaActionPlay:
+		return client.Call(player, "Play")
+	case MediaActionPause:
+		return client.Call(player, "Pause")
 	case MediaActionNext:
-		cmd = exec.Command("playerctl", "next")
+		return client.Call(player, "Next")
 	case MediaActionPrevious:
-		cmd = exec.Command("playerctl", "previous")
+		return client.Call(player, "Previous")
 	case MediaActionStop:
-		cmd = exec.Command("playerctl", "stop")
+		return client.Call(player, "Stop")
 	case MediaActionVolumeUp:
-		cmd = exec.Command("playerctl", "volume", "0.05+")
+		return changeVolume(client, player, volumeStep)
 	case MediaActionVolumeDown:
-		cmd = exec.Command("playerctl", "volume", "0.05-")
+		return changeVolume(client, player, -volumeStep)
 	case MediaActionMute:
-		cmd = exec.Command("playerctl", "volume", "0")
+		return client.Set(player, "Volume", 0.0)
+	case MediaActionSeek:
+		// MPRIS positions are in microseconds
+		position := int64(*request.Position * 1e6)
+		if request.Relative {
+			return client.Seek(player, position)
+		}
+		return client.SetPosition(player, position)
+	case MediaActionSetVolume:
+		return client.Set(player, "Volume", *request.Volume/100)
+	case MediaActionSetShuffle:
+		return client.Set(player, "Shuffle", *request.Shuffle)
+	case MediaActionSetRepeat:
+		return client.Set(player, "LoopStatus", loopStatuses[request.Repeat])
+	case MediaActionSetRate:
+		return client.Set(player, "Rate", *request.Rate)
 	default:
-		return fmt.Errorf("unsupported media action: %s", action)
+		return fmt.Errorf("%w: %s", ErrNotSupported, request.Action)
 	}
+}
 
-	return cmd.Run()
+// changeVolume changes a player's volume by a step, keeping it from 0 to 1
+func changeVolume(client *mpris.Client, player string, step float64) error {
+	state, err := client.Player(player)
+	if err != nil {
+		return err
+	}
+	if state.Volume == nil {
+		return fmt.Errorf("%w: %s has no volume", ErrNotSupported, player)
+	}
+	return client.Set(player, "Volume", min(max(*state.Volume+step, 0), 1))
 }
@@ -0,0 +1,66 @@
+package media
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestControlRequestValidate(t *testing.T) {
+	position := 30.0
+	offset := -10.0
+	volume := 55.0
+	loud := 101.0
+	shuffle := true
+	rate := 1.5
+	stopped := 0.0
+
+	tests := []struct {
+		name    string
+		request ControlRequest
+		wantErr error
+	}{
+		{"play", ControlRequest{Action: MediaActionPlay}, nil},
+		{"unknown action", ControlRequest{Action: "REWIND"}, ErrInvalidAction},
+		{"seek", ControlRequest{Action: MediaActionSeek, Position: &position}, nil},
+		{"seek relative backwards", ControlRequest{Action: MediaActionSeek, Position: &offset, Relative: true}, nil},
+		{"seek negative", ControlRequest{Action: MediaActionSeek, Position: &offset}, ErrInvalidValue},
+		{"seek without position", ControlRequest{Action: MediaActionSeek}, ErrInvalidValue},
+		{"set volume", ControlRequest{Action: MediaActionSetVolume, Volume: &volume}, nil},
+		{"set volume too loud", ControlRequest{Action: MediaActionSetVolume, Volume: &loud}, ErrInvalidValue},
+		{"set shuffle", ControlRequest{Action: MediaActionSetShuffle, Shuffle: &shuffle}, nil},
+		{"set shuffle without value", ControlRequest{Action: MediaActionSetShuffle}, ErrInvalidValue},
+		{"set repeat", ControlRequest{Action: MediaActionSetRepeat, Repeat: "track"}, nil},
+		{"set repeat unknown", ControlRequest{Action: MediaActionSetRepeat, Repeat: "ALWAYS"}, ErrInvalidValue},
+		{"set rate", ControlRequest{Action: MediaActionSetRate, Rate: &rate}, nil},
+		{"set rate zero", ControlRequest{Action: MediaActionSetRate, Rate: &stopped}, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestControlRequestValidate_NormalizesRepeat(t *testing.T) {
+	for repeat, want := range map[string]string{
+		"none":     RepeatNone,
+		"Track":    RepeatTrack,
+		"playlist": RepeatPlaylist,
+		"LIST":     RepeatPlaylist,
+	} {
+		request := ControlRequest{Action: MediaActionSetRepeat, Repeat: repeat}
+		if err := request.Validate(); err != nil {
+			t.Fatalf("Validate(%q) returned error: %v", repeat, err)
+		}
+		if request.Repeat != want {
+			t.Errorf("Validate(%q) repeat = %q, want %q", repeat, request.Repeat, want)
+		}
+	}
+}
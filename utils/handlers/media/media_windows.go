@@ -9,10 +9,10 @@ import (
 	"github.com/timmo001/system-bridge/utils"
 )
 
-func control(action MediaAction) error {
+func control(request ControlRequest) error {
 	var script string
 
-	switch action {
+	switch request.Action {
 	case MediaActionPlay, MediaActionPause:
 		script = "(New-Object -ComObject WScript.Shell).SendKeys([char]179)"
 	case MediaActionNext:
@@ -28,7 +28,7 @@ func control(action MediaAction) error {
 	case MediaActionMute:
 		script = "(New-Object -ComObject WScript.Shell).SendKeys([char]173)"
 	default:
-		return fmt.Errorf("unsupported media action: %s", action)
+		return fmt.Errorf("%w: %s", ErrNotSupported, request.Action)
 	}
 
 	cmd := exec.Command("powershell", "-Command", script)
@@ -0,0 +1,271 @@
+package mouse
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/go-vgo/robotgo"
+	"github.com/timmo001/system-bridge/types"
+)
+
+// dragSteps is the number of moves a drag is made of, so applications see
+// the pointer move between the start and end
+const dragSteps = 10
+
+// dragStepDelay is the delay between the moves of a drag
+const dragStepDelay = 10 * time.Millisecond
+
+var (
+	// ErrInvalidPosition is returned for a position that is not on a display
+	ErrInvalidPosition = errors.New("invalid position")
+	// ErrInvalidRequest is returned for an unknown button or scroll direction
+	ErrInvalidRequest = errors.New("invalid request")
+)
+
+// Position is a point on the screen. Without a display, X and Y are
+// coordinates on the virtual screen spanning every display. With one, they
+// are relative to the top left corner of that display.
+type Position struct {
+	X int `json:"x" mapstructure:"x"`
+	Y int `json:"y" mapstructure:"y"`
+	// Display is the ID or name of a display from the displays module
+	Display string `json:"display,omitempty" mapstructure:"display"`
+}
+
+// MoveData represents the data needed to move the pointer
+type MoveData struct {
+	Position `mapstructure:",squash"`
+	// Relative moves the pointer by X and Y from where it is
+	Relative bool `json:"relative" mapstructure:"relative"`
+}
+
+// ClickData represents the data needed to click a mouse button
+type ClickData struct {
+	// Button is left, right or middle, defaulting to left
+	Button string `json:"button" mapstructure:"button"`
+	Double bool   `json:"double" mapstructure:"double"`
+	// Position moves the pointer before clicking
+	Position *Position `json:"position,omitempty" mapstructure:"position"`
+}
+
+// ScrollData represents the data needed to scroll
+type ScrollData struct {
+	// Direction is up, down, left or right
+	Direction string `json:"direction" mapstructure:"direction"`
+	// Amount is the number of scroll steps, defaulting to 1
+	Amount int `json:"amount" mapstructure:"amount"`
+}
+
+// DragData represents the data needed to drag with a mouse button held
+type DragData struct {
+	// From is where the drag starts, defaulting to the pointer position
+	From *Position `json:"from,omitempty" mapstructure:"from"`
+	To   Position  `json:"to" mapstructure:"to"`
+	// Button is left, right or middle, defaulting to left
+	Button string `json:"button" mapstructure:"button"`
+}
+
+// PositionData is the pointer position, with the display it is on
+type PositionData struct {
+	X int `json:"x" mapstructure:"x"`
+	Y int `json:"y" mapstructure:"y"`
+	// Display is the ID of the display the pointer is on, if it is known
+	Display string `json:"display,omitempty" mapstructure:"display"`
+	// DisplayX and DisplayY are relative to the display's top left corner
+	DisplayX int `json:"displayX" mapstructure:"displayX"`
+	DisplayY int `json:"displayY" mapstructure:"displayY"`
+}
+
+// indirection for testability
+var (
+	robotMove         = robotgo.Move
+	robotMoveRelative = robotgo.MoveRelative
+	robotClick        = robotgo.Click
+	robotToggle       = robotgo.Toggle
+	robotScroll       = robotgo.Scroll
+	robotLocation     = robotgo.Location
+	sleep             = time.Sleep
+)
+
+// Move moves the pointer. Displays are used to resolve display relative
+// positions and check the position is on a display.
+func Move(data MoveData, displays []types.Display) error {
+	slog.Info("Mouse move", "data", data)
+
+	if data.Relative {
+		robotMoveRelative(data.X, data.Y)
+		return nil
+	}
+
+	x, y, err := resolve(data.Position, displays)
+	if err != nil {
+		return err
+	}
+	robotMove(x, y)
+	return nil
+}
+
+// Click clicks a mouse button, moving the pointer first if a position is
+// given
+func Click(data ClickData, displays []types.Display) error {
+	slog.Info("Mouse click", "data", data)
+
+	button, err := normalizeButton(data.Button)
+	if err != nil {
+		return err
+	}
+
+	if data.Position != nil {
+		x, y, err := resolve(*data.Position, displays)
+		if err != nil {
+			return err
+		}
+		robotMove(x, y)
+	}
+
+	robotClick(button, data.Double)
+	return nil
+}
+
+// Scroll scrolls in a direction
+func Scroll(data ScrollData) error {
+	slog.Info("Mouse scroll", "data", data)
+
+	amount := data.Amount
+	if amount == 0 {
+		amount = 1
+	}
+	if amount < 0 {
+		return fmt.Errorf("%w: scroll amount cannot be negative", ErrInvalidRequest)
+	}
+
+	// robotgo scrolls up and left for positive values
+	switch strings.ToLower(data.Direction) {
+	case "up":
+		robotScroll(0, amount)
+	case "down":
+		robotScroll(0, -amount)
+	case "left":
+		robotScroll(amount, 0)
+	case "right":
+		robotScroll(-amount, 0)
+	default:
+		return fmt.Errorf("%w: scroll direction %q must be up, down, left or right", ErrInvalidRequest, data.Direction)
+	}
+	return nil
+}
+
+// Drag holds a mouse button while moving the pointer from one position to
+// another
+func Drag(data DragData, displays []types.Display) error {
+	slog.Info("Mouse drag", "data", data)
+
+	button, err := normalizeButton(data.Button)
+	if err != nil {
+		return err
+	}
+
+	fromX, fromY := robotLocation()
+	if data.From != nil {
+		if fromX, fromY, err = resolve(*data.From, displays); err != nil {
+			return err
+		}
+	}
+	toX, toY, err := resolve(data.To, displays)
+	if err != nil {
+		return err
+	}
+
+	robotMove(fromX, fromY)
+	if err := robotToggle(button, "down"); err != nil {
+		return fmt.Errorf("failed to press %s button: %w", button, err)
+	}
+	for step := 1; step <= dragSteps; step++ {
+		sleep(dragStepDelay)
+		robotMove(fromX+(toX-fromX)*step/dragSteps, fromY+(toY-fromY)*step/dragSteps)
+	}
+	if err := robotToggle(button, "up"); err != nil {
+		return fmt.Errorf("failed to release %s button: %w", button, err)
+	}
+	return nil
+}
+
+// GetPosition returns the pointer position and the display it is on
+func GetPosition(displays []types.Display) PositionData {
+	x, y := robotLocation()
+	position := PositionData{X: x, Y: y, DisplayX: x, DisplayY: y}
+	if display := displayAt(displays, x, y); display != nil {
+		position.Display = display.ID
+		position.DisplayX = x - display.X
+		position.DisplayY = y - display.Y
+	}
+	return position
+}
+
+// resolve converts a position to virtual screen coordinates, checking it is
+// on a display when the displays are known
+func resolve(position Position, displays []types.Display) (int, int, error) {
+	if position.Display != "" {
+		display := findDisplay(displays, position.Display)
+		if display == nil {
+			return 0, 0, fmt.Errorf("%w: display %q not found", ErrInvalidPosition, position.Display)
+		}
+		if !contains(*display, display.X+position.X, display.Y+position.Y) {
+			return 0, 0, fmt.Errorf("%w: %d,%d is outside display %s (%dx%d)", ErrInvalidPosition, position.X, position.Y, display.ID, display.ResolutionHorizontal, display.ResolutionVertical)
+		}
+		return display.X + position.X, display.Y + position.Y, nil
+	}
+
+	if len(displays) > 0 && displayAt(displays, position.X, position.Y) == nil {
+		return 0, 0, fmt.Errorf("%w: %d,%d is not on any display", ErrInvalidPosition, position.X, position.Y)
+	}
+	return position.X, position.Y, nil
+}
+
+// findDisplay finds a display by ID, or by name
+func findDisplay(displays []types.Display, idOrName string) *types.Display {
+	for i := range displays {
+		if displays[i].ID == idOrName {
+			return &displays[i]
+		}
+	}
+	for i := range displays {
+		if displays[i].Name == idOrName {
+			return &displays[i]
+		}
+	}
+	return nil
+}
+
+// displayAt returns the display containing a point
+func displayAt(displays []types.Display, x, y int) *types.Display {
+	for i := range displays {
+		if contains(displays[i], x, y) {
+			return &displays[i]
+		}
+	}
+	return nil
+}
+
+// contains reports whether a point is on a display
+func contains(display types.Display, x, y int) bool {
+	return x >= display.X && x < display.X+display.ResolutionHorizontal &&
+		y >= display.Y && y < display.Y+display.ResolutionVertical
+}
+
+// normalizeButton checks a button name, defaulting to left
+func normalizeButton(button string) (string, error) {
+	switch strings.ToLower(button) {
+	case "", "left":
+		return "left", nil
+	case "right":
+		return "right", nil
+	case "middle", "center":
+		return "center", nil
+	default:
+		return "", fmt.Errorf("%w: mouse button %q must be left, right or middle", ErrInvalidRequest, button)
+	}
+}
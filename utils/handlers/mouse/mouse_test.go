@@ -0,0 +1,235 @@
+package mouse
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/timmo001/system-bridge/types"
+)
+
+// testDisplays are a 1920x1080 primary display with a 2560x1440 display to
+// its right
+var testDisplays = []types.Display{
+	{ID: "0", Name: "DP-1", ResolutionHorizontal: 1920, ResolutionVertical: 1080},
+	{ID: "1", Name: "HDMI-1", ResolutionHorizontal: 2560, ResolutionVertical: 1440, X: 1920},
+}
+
+// withRobotgoStubs records the robotgo calls as strings, with the pointer at
+// 100,200
+func withRobotgoStubs(t *testing.T) *[]string {
+	t.Helper()
+
+	var calls []string
+
+	origMove := robotMove
+	origMoveRelative := robotMoveRelative
+	origClick := robotClick
+	origToggle := robotToggle
+	origScroll := robotScroll
+	origLocation := robotLocation
+	origSleep := sleep
+
+	robotMove = func(x, y int, displayID ...int) {
+		calls = append(calls, fmt.Sprintf("move %d,%d", x, y))
+	}
+	robotMoveRelative = func(x, y int) {
+		calls = append(calls, fmt.Sprintf("moveRelative %d,%d", x, y))
+	}
+	robotClick = func(args ...any) {
+		calls = append(calls, fmt.Sprintf("click %v", args))
+	}
+	robotToggle = func(args ...any) error {
+		calls = append(calls, fmt.Sprintf("toggle %v", args))
+		return nil
+	}
+	robotScroll = func(x, y int, args ...int) {
+		calls = append(calls, fmt.Sprintf("scroll %d,%d", x, y))
+	}
+	robotLocation = func() (int, int) {
+		return 100, 200
+	}
+	sleep = func(time.Duration) {}
+
+	t.Cleanup(func() {
+		robotMove = origMove
+		robotMoveRelative = origMoveRelative
+		robotClick = origClick
+		robotToggle = origToggle
+		robotScroll = origScroll
+		robotLocation = origLocation
+		sleep = origSleep
+	})
+
+	return &calls
+}
+
+func TestMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     MoveData
+		expected string
+	}{
+		{"Absolute", MoveData{Position: Position{X: 2000, Y: 50}}, "move 2000,50"},
+		{"Display by ID", MoveData{Position: Position{X: 10, Y: 20, Display: "1"}}, "move 1930,20"},
+		{"Display by name", MoveData{Position: Position{X: 10, Y: 20, Display: "HDMI-1"}}, "move 1930,20"},
+		{"Relative", MoveData{Position: Position{X: -5, Y: 5}, Relative: true}, "moveRelative -5,5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := withRobotgoStubs(t)
+
+			if err := Move(tt.data, testDisplays); err != nil {
+				t.Fatalf("Move returned error: %v", err)
+			}
+			if len(*calls) != 1 || (*calls)[0] != tt.expected {
+				t.Errorf("expected %q, got %v", tt.expected, *calls)
+			}
+		})
+	}
+}
+
+func TestMove_InvalidPosition(t *testing.T) {
+	tests := []struct {
+		name     string
+		position Position
+	}{
+		{"Unknown display", Position{Display: "DP-9"}},
+		{"Outside display", Position{X: 1920, Y: 0, Display: "0"}},
+		{"Between displays", Position{X: 100, Y: 1200}},
+		{"Negative", Position{X: -1, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := withRobotgoStubs(t)
+
+			err := Move(MoveData{Position: tt.position}, testDisplays)
+			if !errors.Is(err, ErrInvalidPosition) {
+				t.Fatalf("expected ErrInvalidPosition, got %v", err)
+			}
+			if len(*calls) != 0 {
+				t.Errorf("expected no calls, got %v", *calls)
+			}
+		})
+	}
+}
+
+func TestMove_UnknownDisplays(t *testing.T) {
+	calls := withRobotgoStubs(t)
+
+	if err := Move(MoveData{Position: Position{X: 5000, Y: 5000}}, nil); err != nil {
+		t.Fatalf("Move returned error: %v", err)
+	}
+	if len(*calls) != 1 || (*calls)[0] != "move 5000,5000" {
+		t.Errorf("unexpected calls %v", *calls)
+	}
+}
+
+func TestClick(t *testing.T) {
+	calls := withRobotgoStubs(t)
+
+	err := Click(ClickData{Button: "Middle", Double: true, Position: &Position{X: 5, Y: 5, Display: "1"}}, testDisplays)
+	if err != nil {
+		t.Fatalf("Click returned error: %v", err)
+	}
+	expected := []string{"move 1925,5", "click [center true]"}
+	if fmt.Sprint(*calls) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, *calls)
+	}
+
+	if err := Click(ClickData{Button: "back"}, testDisplays); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest for an unknown button, got %v", err)
+	}
+}
+
+func TestScroll(t *testing.T) {
+	tests := []struct {
+		data     ScrollData
+		expected string
+	}{
+		{ScrollData{Direction: "up"}, "scroll 0,1"},
+		{ScrollData{Direction: "DOWN", Amount: 3}, "scroll 0,-3"},
+		{ScrollData{Direction: "left", Amount: 2}, "scroll 2,0"},
+		{ScrollData{Direction: "right", Amount: 2}, "scroll -2,0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.data.Direction, func(t *testing.T) {
+			calls := withRobotgoStubs(t)
+
+			if err := Scroll(tt.data); err != nil {
+				t.Fatalf("Scroll returned error: %v", err)
+			}
+			if len(*calls) != 1 || (*calls)[0] != tt.expected {
+				t.Errorf("expected %q, got %v", tt.expected, *calls)
+			}
+		})
+	}
+
+	if err := Scroll(ScrollData{Direction: "sideways"}); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest for an unknown direction, got %v", err)
+	}
+	if err := Scroll(ScrollData{Direction: "up", Amount: -1}); !errors.Is(err, ErrInvalidRequest) {
+		t.Errorf("expected ErrInvalidRequest for a negative amount, got %v", err)
+	}
+}
+
+func TestDrag(t *testing.T) {
+	calls := withRobotgoStubs(t)
+
+	err := Drag(DragData{To: Position{X: 1100, Y: 1200}}, nil)
+	if err != nil {
+		t.Fatalf("Drag returned error: %v", err)
+	}
+
+	got := *calls
+	if len(got) != dragSteps+3 {
+		t.Fatalf("expected %d calls, got %v", dragSteps+3, got)
+	}
+	if got[0] != "move 100,200" {
+		t.Errorf("expected drag to start at the pointer, got %q", got[0])
+	}
+	if got[1] != "toggle [left down]" {
+		t.Errorf("expected button press, got %q", got[1])
+	}
+	if got[2] != "move 200,300" {
+		t.Errorf("expected first step to 200,300, got %q", got[2])
+	}
+	if got[len(got)-2] != "move 1100,1200" {
+		t.Errorf("expected drag to end at the target, got %q", got[len(got)-2])
+	}
+	if got[len(got)-1] != "toggle [left up]" {
+		t.Errorf("expected button release, got %q", got[len(got)-1])
+	}
+}
+
+func TestDrag_PressFailure(t *testing.T) {
+	withRobotgoStubs(t)
+	robotToggle = func(args ...any) error {
+		return errors.New("no display")
+	}
+
+	if err := Drag(DragData{To: Position{X: 10, Y: 10}}, nil); err == nil {
+		t.Fatal("expected an error when the button cannot be pressed")
+	}
+}
+
+func TestGetPosition(t *testing.T) {
+	withRobotgoStubs(t)
+	robotLocation = func() (int, int) { return 2000, 300 }
+
+	position := GetPosition(testDisplays)
+	expected := PositionData{X: 2000, Y: 300, Display: "1", DisplayX: 80, DisplayY: 300}
+	if position != expected {
+		t.Errorf("expected %+v, got %+v", expected, position)
+	}
+
+	position = GetPosition(nil)
+	expected = PositionData{X: 2000, Y: 300, DisplayX: 2000, DisplayY: 300}
+	if position != expected {
+		t.Errorf("expected %+v without displays, got %+v", expected, position)
+	}
+}
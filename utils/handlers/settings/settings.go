@@ -24,5 +24,9 @@ func Update(current *Settings, new *Settings) error {
 	current.LogLevel = new.LogLevel
 	current.Media = new.Media
 	current.Commands = new.Commands
+	current.Schedules = new.Schedules
+	current.Macros = new.Macros
+	current.GRPC = new.GRPC
+	current.MCP = new.MCP
 	return current.Save()
 }
@@ -0,0 +1,108 @@
+// Package mediaurl signs the URLs media files are served at, so browsers and
+// players can be given a URL that expires instead of the API token, which
+// would otherwise end up in browser history and proxy logs.
+package mediaurl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Path is the path media files are served at
+const Path = "/api/media/file/data"
+
+const (
+	// DefaultExpiry is how long a signed URL lasts when no expiry is given,
+	// long enough to scrub through a film
+	DefaultExpiry = 4 * time.Hour
+	// MaxExpiry is the longest a signed URL can last
+	MaxExpiry = 7 * 24 * time.Hour
+)
+
+// Query parameters of a signed URL
+const (
+	ParamBase      = "base"
+	ParamPath      = "path"
+	ParamDownload  = "download"
+	ParamExpires   = "expires"
+	ParamSignature = "signature"
+)
+
+var (
+	// ErrInvalidSignature is returned for a URL that was not signed with the
+	// token, or was changed after signing
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrExpired is returned for a URL past its expiry
+	ErrExpired = errors.New("signed URL has expired")
+)
+
+// File is a media file in a base directory
+type File struct {
+	Base string
+	Path string
+	// Download serves the file as an attachment instead of inline
+	Download bool
+}
+
+// Sign returns the URL path and query serving a file until it expires
+func Sign(token string, file File, expires time.Time) string {
+	query := url.Values{}
+	query.Set(ParamBase, file.Base)
+	query.Set(ParamPath, file.Path)
+	if file.Download {
+		query.Set(ParamDownload, "1")
+	}
+	query.Set(ParamExpires, strconv.FormatInt(expires.Unix(), 10))
+	query.Set(ParamSignature, signature(token, file, expires.Unix()))
+	return Path + "?" + query.Encode()
+}
+
+// IsSigned reports whether a query has a signature
+func IsSigned(query url.Values) bool {
+	return query.Has(ParamSignature)
+}
+
+// Verify checks a signed URL's query and returns the file it serves
+func Verify(token string, query url.Values, now time.Time) (File, error) {
+	file := FileFromQuery(query)
+
+	expires, err := strconv.ParseInt(query.Get(ParamExpires), 10, 64)
+	if err != nil {
+		return File{}, fmt.Errorf("%w: invalid expiry", ErrInvalidSignature)
+	}
+	expected := signature(token, file, expires)
+	if !hmac.Equal([]byte(query.Get(ParamSignature)), []byte(expected)) {
+		return File{}, ErrInvalidSignature
+	}
+	if now.Unix() > expires {
+		return File{}, ErrExpired
+	}
+	return file, nil
+}
+
+// FileFromQuery returns the file a media file URL's query asks for
+func FileFromQuery(query url.Values) File {
+	download, _ := strconv.ParseBool(query.Get(ParamDownload))
+	return File{
+		Base:     query.Get(ParamBase),
+		Path:     query.Get(ParamPath),
+		Download: download,
+	}
+}
+
+// signature signs a file and expiry with a key derived from the token, so
+// changing the token invalidates every signed URL
+func signature(token string, file File, expires int64) string {
+	key := hmac.New(sha256.New, []byte(token))
+	key.Write([]byte("system-bridge media file URL"))
+
+	mac := hmac.New(sha256.New, key.Sum(nil))
+	fmt.Fprintf(mac, "%s\x00%s\x00%t\x00%d", file.Base, file.Path, file.Download, expires)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,61 @@
+package mediaurl
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signedQuery(t *testing.T, token string, file File, expires time.Time) url.Values {
+	t.Helper()
+	signed := Sign(token, file, expires)
+	path, rawQuery, ok := strings.Cut(signed, "?")
+	require.True(t, ok)
+	require.Equal(t, Path, path)
+	query, err := url.ParseQuery(rawQuery)
+	require.NoError(t, err)
+	return query
+}
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	file := File{Base: "videos", Path: "Holiday 2026/beach & sea.mp4", Download: true}
+	query := signedQuery(t, "token", file, now.Add(time.Hour))
+
+	assert.True(t, IsSigned(query))
+	assert.False(t, query.Has("token"))
+
+	verified, err := Verify("token", query, now)
+	require.NoError(t, err)
+	assert.Equal(t, file, verified)
+
+	_, err = Verify("token", query, now.Add(2*time.Hour))
+	assert.ErrorIs(t, err, ErrExpired)
+
+	_, err = Verify("other-token", query, now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestVerifyRejectsChanges(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	file := File{Base: "videos", Path: "film.mkv"}
+
+	for name, change := range map[string]func(url.Values){
+		"path":     func(q url.Values) { q.Set(ParamPath, "other.mkv") },
+		"base":     func(q url.Values) { q.Set(ParamBase, "documents") },
+		"download": func(q url.Values) { q.Set(ParamDownload, "1") },
+		"expires":  func(q url.Values) { q.Set(ParamExpires, "9999999999") },
+		"missing":  func(q url.Values) { q.Del(ParamSignature) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			query := signedQuery(t, "token", file, now.Add(time.Hour))
+			change(query)
+			_, err := Verify("token", query, now)
+			assert.ErrorIs(t, err, ErrInvalidSignature)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package mpris
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// Call calls a method of a player's player interface, such as PlayPause
+func (c *Client) Call(name, method string, args ...any) error {
+	if err := c.object(name).Call(PlayerInterface+"."+method, 0, args...).Err; err != nil {
+		return fmt.Errorf("failed to call %s on %s: %w", method, name, err)
+	}
+	return nil
+}
+
+// Set sets a property of a player's player interface, such as Volume
+func (c *Client) Set(name, property string, value any) error {
+	if err := c.object(name).SetProperty(PlayerInterface+"."+property, dbus.MakeVariant(value)); err != nil {
+		return fmt.Errorf("failed to set %s on %s: %w", property, name, err)
+	}
+	return nil
+}
+
+// Seek moves the position of a player by an offset in microseconds
+func (c *Client) Seek(name string, offset int64) error {
+	return c.Call(name, "Seek", offset)
+}
+
+// SetPosition moves a player to a position in microseconds. SetPosition
+// needs the current track's ID, so players without one are seeked by the
+// difference from their current position.
+func (c *Client) SetPosition(name string, position int64) error {
+	player, err := c.Player(name)
+	if err != nil {
+		return err
+	}
+	if trackID, ok := player.Metadata.TrackID(); ok {
+		return c.Call(name, "SetPosition", trackID, position)
+	}
+	if player.Position == nil {
+		return fmt.Errorf("failed to seek %s: it has no track ID or position", name)
+	}
+	return c.Seek(name, position-*player.Position)
+}
@@ -0,0 +1,122 @@
+// Package mpris reads, controls and watches media players through their
+// MPRIS D-Bus interfaces.
+//
+// See https://specifications.freedesktop.org/mpris-spec/latest/
+package mpris
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	// BusPrefix is the prefix of every player's bus name
+	BusPrefix = "org.mpris.MediaPlayer2."
+	// ObjectPath is the object players expose their interfaces on
+	ObjectPath = dbus.ObjectPath("/org/mpris/MediaPlayer2")
+	// RootInterface is the interface describing the player application
+	RootInterface = "org.mpris.MediaPlayer2"
+	// PlayerInterface is the interface for playback and its state
+	PlayerInterface = "org.mpris.MediaPlayer2.Player"
+
+	propertiesInterface = "org.freedesktop.DBus.Properties"
+)
+
+// ErrPlayerNotFound is returned when no player matches a name
+var ErrPlayerNotFound = errors.New("player not found")
+
+// Client talks to the players on a bus
+type Client struct {
+	conn *dbus.Conn
+}
+
+// NewClient creates a client for the players on a connection
+func NewClient(conn *dbus.Conn) *Client {
+	return &Client{conn: conn}
+}
+
+// SessionBus returns a client on the shared session bus connection
+func SessionBus() (*Client, error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to session bus: %w", err)
+	}
+	return NewClient(conn), nil
+}
+
+// SessionBusPrivate returns a client on a new connection to the session bus,
+// which the caller must close
+func SessionBusPrivate() (*Client, error) {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to session bus: %w", err)
+	}
+	return NewClient(conn), nil
+}
+
+// Close closes the client's connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// PlayerNames returns the names of the players on the bus, which are their
+// bus names without the MPRIS prefix, such as spotify or
+// firefox.instance_1_42
+func (c *Client) PlayerNames() ([]string, error) {
+	var names []string
+	if err := c.conn.BusObject().Call("org.freedesktop.DBus.ListNames", 0).Store(&names); err != nil {
+		return nil, fmt.Errorf("failed to list bus names: %w", err)
+	}
+
+	players := make([]string, 0)
+	for _, name := range names {
+		if player, ok := strings.CutPrefix(name, BusPrefix); ok {
+			players = append(players, player)
+		}
+	}
+	sort.Strings(players)
+	return players, nil
+}
+
+// Resolve returns the name of the player matching a name. Players are
+// matched by their full name first, then by name without the instance
+// suffix, as playerctl does. An empty name matches the first player.
+func (c *Client) Resolve(name string) (string, error) {
+	players, err := c.PlayerNames()
+	if err != nil {
+		return "", err
+	}
+	if len(players) == 0 {
+		return "", fmt.Errorf("%w: no players are running", ErrPlayerNotFound)
+	}
+	if name == "" {
+		return players[0], nil
+	}
+	for _, player := range players {
+		if strings.EqualFold(player, name) {
+			return player, nil
+		}
+	}
+	for _, player := range players {
+		if strings.EqualFold(BaseName(player), name) {
+			return player, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrPlayerNotFound, name)
+}
+
+// BaseName returns a player name without its instance suffix, such as
+// firefox for firefox.instance_1_42
+func BaseName(player string) string {
+	base, _, _ := strings.Cut(player, ".instance")
+	return base
+}
+
+// object returns a player's MPRIS object
+func (c *Client) object(name string) dbus.BusObject {
+	return c.conn.Object(BusPrefix+name, ObjectPath)
+}
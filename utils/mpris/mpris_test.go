@@ -0,0 +1,265 @@
+package mpris
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/prop"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startBus starts a private session bus for the test, skipping it when
+// dbus-daemon is not installed
+func startBus(t *testing.T) {
+	t.Helper()
+
+	daemon, err := exec.LookPath("dbus-daemon")
+	if err != nil {
+		t.Skip("dbus-daemon is not installed")
+	}
+
+	cmd := exec.Command(daemon, "--session", "--nofork", "--print-address=1")
+	stdout, err := cmd.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	address, err := bufio.NewReader(stdout).ReadString('\n')
+	require.NoError(t, err)
+	t.Setenv("DBUS_SESSION_BUS_ADDRESS", strings.TrimSpace(address))
+}
+
+// fakePlayer is an MPRIS player that records the methods called on it
+type fakePlayer struct {
+	props *prop.Properties
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func (p *fakePlayer) record(call string) *dbus.Error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, call)
+	return nil
+}
+
+func (p *fakePlayer) Calls() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.calls...)
+}
+
+func (p *fakePlayer) PlayPause() *dbus.Error { return p.record("PlayPause") }
+func (p *fakePlayer) Play() *dbus.Error      { return p.record("Play") }
+func (p *fakePlayer) Pause() *dbus.Error     { return p.record("Pause") }
+func (p *fakePlayer) Stop() *dbus.Error      { return p.record("Stop") }
+func (p *fakePlayer) Next() *dbus.Error      { return p.record("Next") }
+func (p *fakePlayer) Previous() *dbus.Error  { return p.record("Previous") }
+
+// SeekBy is exported as Seek, as vet expects a Seek method to be io.Seeker
+func (p *fakePlayer) SeekBy(offset int64) *dbus.Error {
+	return p.record(fmt.Sprintf("Seek %d", offset))
+}
+
+func (p *fakePlayer) SetPosition(trackID dbus.ObjectPath, position int64) *dbus.Error {
+	return p.record(fmt.Sprintf("SetPosition %s %d", trackID, position))
+}
+
+// startFakePlayer puts a fake player on the test bus under a name
+func startFakePlayer(t *testing.T, name string, metadata map[string]dbus.Variant) *fakePlayer {
+	t.Helper()
+
+	conn, err := dbus.ConnectSessionBus()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	player := &fakePlayer{}
+	require.NoError(t, conn.ExportWithMap(player, map[string]string{"SeekBy": "Seek"}, ObjectPath, PlayerInterface))
+
+	writable := func(value any) *prop.Prop {
+		return &prop.Prop{Value: value, Writable: true, Emit: prop.EmitTrue}
+	}
+	player.props, err = prop.Export(conn, ObjectPath, prop.Map{
+		RootInterface: {
+			"Identity": {Value: strings.ToUpper(name[:1]) + name[1:], Emit: prop.EmitConst},
+		},
+		PlayerInterface: {
+			"PlaybackStatus": {Value: "Playing", Emit: prop.EmitTrue},
+			"LoopStatus":     writable("None"),
+			"Shuffle":        writable(false),
+			"Volume":         writable(0.5),
+			"Rate":           writable(1.0),
+			"Position":       {Value: int64(30_000_000), Emit: prop.EmitFalse},
+			"Metadata":       {Value: metadata, Emit: prop.EmitTrue},
+			"CanControl":     {Value: true, Emit: prop.EmitConst},
+			"CanPlay":        {Value: true, Emit: prop.EmitTrue},
+			"CanPause":       {Value: true, Emit: prop.EmitTrue},
+			"CanGoNext":      {Value: true, Emit: prop.EmitTrue},
+			"CanGoPrevious":  {Value: false, Emit: prop.EmitTrue},
+			"CanSeek":        {Value: true, Emit: prop.EmitTrue},
+		},
+	})
+	require.NoError(t, err)
+
+	reply, err := conn.RequestName(BusPrefix+name, dbus.NameFlagDoNotQueue)
+	require.NoError(t, err)
+	require.Equal(t, dbus.RequestNameReplyPrimaryOwner, reply)
+	return player
+}
+
+func songMetadata() map[string]dbus.Variant {
+	return map[string]dbus.Variant{
+		"mpris:trackid": dbus.MakeVariant(dbus.ObjectPath("/org/mpris/MediaPlayer2/Track/1")),
+		"mpris:length":  dbus.MakeVariant(int64(180_000_000)),
+		"mpris:artUrl":  dbus.MakeVariant("https://i.scdn.co/image/1"),
+		"xesam:title":   dbus.MakeVariant("Song"),
+		"xesam:artist":  dbus.MakeVariant([]string{"Band", "Guest"}),
+		"xesam:album":   dbus.MakeVariant("Record"),
+	}
+}
+
+func TestPlayers(t *testing.T) {
+	startBus(t)
+	startFakePlayer(t, "spotify", songMetadata())
+	startFakePlayer(t, "firefox.instance_1_42", map[string]dbus.Variant{
+		"xesam:title": dbus.MakeVariant("Video"),
+	})
+
+	client, err := SessionBusPrivate()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	players, err := client.Players()
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+
+	firefox := players[0]
+	assert.Equal(t, "firefox.instance_1_42", firefox.Name)
+	assert.Equal(t, "Video", firefox.Metadata.Title())
+	_, ok := firefox.Metadata.TrackID()
+	assert.False(t, ok)
+
+	spotify := players[1]
+	assert.Equal(t, "spotify", spotify.Name)
+	assert.Equal(t, "Spotify", spotify.Identity)
+	assert.Equal(t, "Playing", spotify.PlaybackStatus)
+	assert.Equal(t, "None", spotify.LoopStatus)
+	assert.Equal(t, 0.5, *spotify.Volume)
+	assert.False(t, *spotify.Shuffle)
+	assert.Equal(t, int64(30_000_000), *spotify.Position)
+	assert.True(t, spotify.CanGoNext)
+	assert.False(t, spotify.CanGoPrevious)
+	assert.Equal(t, "Song", spotify.Metadata.Title())
+	assert.Equal(t, "Band, Guest", spotify.Metadata.Artist())
+	assert.Equal(t, "Record", spotify.Metadata.Album())
+	assert.Equal(t, "https://i.scdn.co/image/1", spotify.Metadata.ArtURL())
+	length, ok := spotify.Metadata.Length()
+	assert.True(t, ok)
+	assert.Equal(t, int64(180_000_000), length)
+
+	name, err := client.Resolve("")
+	require.NoError(t, err)
+	assert.Equal(t, "firefox.instance_1_42", name)
+	name, err = client.Resolve("Firefox")
+	require.NoError(t, err)
+	assert.Equal(t, "firefox.instance_1_42", name)
+	name, err = client.Resolve("spotify")
+	require.NoError(t, err)
+	assert.Equal(t, "spotify", name)
+	_, err = client.Resolve("vlc")
+	assert.ErrorIs(t, err, ErrPlayerNotFound)
+}
+
+func TestControl(t *testing.T) {
+	startBus(t)
+	spotify := startFakePlayer(t, "spotify", songMetadata())
+	firefox := startFakePlayer(t, "firefox", map[string]dbus.Variant{})
+
+	client, err := SessionBusPrivate()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	require.NoError(t, client.Call("spotify", "PlayPause"))
+	require.NoError(t, client.Seek("spotify", -5_000_000))
+	require.NoError(t, client.SetPosition("spotify", 90_000_000))
+	assert.Equal(t, []string{
+		"PlayPause",
+		"Seek -5000000",
+		"SetPosition /org/mpris/MediaPlayer2/Track/1 90000000",
+	}, spotify.Calls())
+
+	// Without a track ID, SetPosition seeks from the current position
+	require.NoError(t, client.SetPosition("firefox", 10_000_000))
+	assert.Equal(t, []string{"Seek -20000000"}, firefox.Calls())
+
+	require.NoError(t, client.Set("spotify", "Volume", 0.25))
+	require.NoError(t, client.Set("spotify", "LoopStatus", "Playlist"))
+	assert.Equal(t, 0.25, spotify.props.GetMust(PlayerInterface, "Volume"))
+	assert.Equal(t, "Playlist", spotify.props.GetMust(PlayerInterface, "LoopStatus"))
+
+	assert.Error(t, client.Call("vlc", "Play"))
+}
+
+func TestWatch(t *testing.T) {
+	startBus(t)
+	spotify := startFakePlayer(t, "spotify", songMetadata())
+
+	client, err := SessionBusPrivate()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	changes := make(chan struct{}, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- client.Watch(ctx, func() { changes <- struct{}{} })
+	}()
+
+	waitForChange := func(what string) {
+		t.Helper()
+		select {
+		case <-changes:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no change after %s", what)
+		}
+	}
+
+	// Keep changing the volume until the watch has subscribed
+	deadline := time.After(5 * time.Second)
+	for subscribed := false; !subscribed; {
+		spotify.props.SetMust(PlayerInterface, "Volume", 0.75)
+		select {
+		case <-changes:
+			subscribed = true
+		case <-time.After(50 * time.Millisecond):
+		case <-deadline:
+			t.Fatal("no change after setting the volume")
+		}
+	}
+
+	spotify.props.SetMust(PlayerInterface, "PlaybackStatus", "Paused")
+	waitForChange("pausing")
+
+	startFakePlayer(t, "vlc", map[string]dbus.Variant{})
+	waitForChange("a player appeared")
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not stop")
+	}
+}
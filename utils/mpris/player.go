@@ -0,0 +1,169 @@
+package mpris
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// Player is the state of a player
+type Player struct {
+	// Name is the player's bus name without the MPRIS prefix
+	Name string
+	// Identity is the player's friendly name, such as Spotify
+	Identity string
+	// PlaybackStatus is Playing, Paused or Stopped
+	PlaybackStatus string
+	// LoopStatus is None, Track or Playlist, or empty when the player does
+	// not support looping
+	LoopStatus string
+	Shuffle    *bool
+	// Volume is from 0 to 1
+	Volume *float64
+	Rate   *float64
+	// Position is in microseconds
+	Position *int64
+	Metadata Metadata
+
+	CanControl    bool
+	CanPlay       bool
+	CanPause      bool
+	CanGoNext     bool
+	CanGoPrevious bool
+	CanSeek       bool
+}
+
+// Metadata is the metadata of a player's current track
+type Metadata map[string]dbus.Variant
+
+// TrackID returns the ID of the track, if it has one
+func (m Metadata) TrackID() (dbus.ObjectPath, bool) {
+	trackID, ok := m["mpris:trackid"].Value().(dbus.ObjectPath)
+	// Some players send the ID as a string
+	if !ok {
+		id, isString := m["mpris:trackid"].Value().(string)
+		trackID, ok = dbus.ObjectPath(id), isString
+	}
+	// NoTrack means the track list is empty
+	if !ok || !trackID.IsValid() || trackID == "/org/mpris/MediaPlayer2/TrackList/NoTrack" {
+		return "", false
+	}
+	return trackID, true
+}
+
+// Title returns the track title
+func (m Metadata) Title() string {
+	return m.string("xesam:title")
+}
+
+// Artist returns the track artists joined by commas
+func (m Metadata) Artist() string {
+	switch artist := m["xesam:artist"].Value().(type) {
+	case []string:
+		return strings.Join(artist, ", ")
+	case string:
+		return artist
+	}
+	return ""
+}
+
+// Album returns the album title
+func (m Metadata) Album() string {
+	return m.string("xesam:album")
+}
+
+// ArtURL returns the URL of the album art
+func (m Metadata) ArtURL() string {
+	return m.string("mpris:artUrl")
+}
+
+// Length returns the track length in microseconds, if it is known
+func (m Metadata) Length() (int64, bool) {
+	return toInt64(m["mpris:length"].Value())
+}
+
+func (m Metadata) string(key string) string {
+	value, _ := m[key].Value().(string)
+	return value
+}
+
+// Player reads the state of a player
+func (c *Client) Player(name string) (Player, error) {
+	object := c.object(name)
+
+	var properties map[string]dbus.Variant
+	if err := object.Call(propertiesInterface+".GetAll", 0, PlayerInterface).Store(&properties); err != nil {
+		return Player{}, fmt.Errorf("failed to get properties of %s: %w", name, err)
+	}
+
+	player := Player{Name: name}
+	if identity, err := object.GetProperty(RootInterface + ".Identity"); err == nil {
+		player.Identity, _ = identity.Value().(string)
+	}
+
+	player.PlaybackStatus, _ = properties["PlaybackStatus"].Value().(string)
+	player.LoopStatus, _ = properties["LoopStatus"].Value().(string)
+	if shuffle, ok := properties["Shuffle"].Value().(bool); ok {
+		player.Shuffle = &shuffle
+	}
+	if volume, ok := properties["Volume"].Value().(float64); ok {
+		player.Volume = &volume
+	}
+	if rate, ok := properties["Rate"].Value().(float64); ok {
+		player.Rate = &rate
+	}
+	if position, ok := toInt64(properties["Position"].Value()); ok {
+		player.Position = &position
+	}
+	if metadata, ok := properties["Metadata"].Value().(map[string]dbus.Variant); ok {
+		player.Metadata = metadata
+	}
+
+	player.CanControl, _ = properties["CanControl"].Value().(bool)
+	player.CanPlay, _ = properties["CanPlay"].Value().(bool)
+	player.CanPause, _ = properties["CanPause"].Value().(bool)
+	player.CanGoNext, _ = properties["CanGoNext"].Value().(bool)
+	player.CanGoPrevious, _ = properties["CanGoPrevious"].Value().(bool)
+	player.CanSeek, _ = properties["CanSeek"].Value().(bool)
+
+	return player, nil
+}
+
+// Players reads the state of every player, skipping players that cannot be
+// read
+func (c *Client) Players() ([]Player, error) {
+	names, err := c.PlayerNames()
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]Player, 0, len(names))
+	for _, name := range names {
+		player, err := c.Player(name)
+		if err != nil {
+			slog.Warn("Failed to read MPRIS player", "player", name, "error", err)
+			continue
+		}
+		players = append(players, player)
+	}
+	return players, nil
+}
+
+// toInt64 converts the integer types players use for positions and lengths
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case uint64:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
@@ -0,0 +1,84 @@
+package mpris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// ErrDisconnected is returned by Watch when the bus connection is lost
+var ErrDisconnected = errors.New("disconnected from bus")
+
+// Watch calls changed whenever a player appears, goes away or changes its
+// state, until the context is canceled or the connection is lost. Watch
+// should have its own connection, as it receives every signal on it.
+func (c *Client) Watch(ctx context.Context, changed func()) error {
+	matches := [][]dbus.MatchOption{
+		{
+			dbus.WithMatchObjectPath(ObjectPath),
+			dbus.WithMatchInterface(propertiesInterface),
+			dbus.WithMatchMember("PropertiesChanged"),
+		},
+		{
+			dbus.WithMatchObjectPath(ObjectPath),
+			dbus.WithMatchInterface(PlayerInterface),
+			dbus.WithMatchMember("Seeked"),
+		},
+		{
+			dbus.WithMatchSender("org.freedesktop.DBus"),
+			dbus.WithMatchInterface("org.freedesktop.DBus"),
+			dbus.WithMatchMember("NameOwnerChanged"),
+			dbus.WithMatchArg0Namespace(strings.TrimSuffix(BusPrefix, ".")),
+		},
+	}
+	for _, match := range matches {
+		if err := c.conn.AddMatchSignalContext(ctx, match...); err != nil {
+			return fmt.Errorf("failed to watch players: %w", err)
+		}
+		defer func() {
+			_ = c.conn.RemoveMatchSignal(match...)
+		}()
+	}
+
+	signals := make(chan *dbus.Signal, 16)
+	c.conn.Signal(signals)
+	defer c.conn.RemoveSignal(signals)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case signal, ok := <-signals:
+			if !ok {
+				return ErrDisconnected
+			}
+			if isPlayerSignal(signal) {
+				changed()
+			}
+		}
+	}
+}
+
+// isPlayerSignal reports whether a signal is about a player
+func isPlayerSignal(signal *dbus.Signal) bool {
+	switch signal.Name {
+	case propertiesInterface + ".PropertiesChanged":
+		if signal.Path != ObjectPath || len(signal.Body) == 0 {
+			return false
+		}
+		iface, _ := signal.Body[0].(string)
+		return iface == PlayerInterface || iface == RootInterface
+	case PlayerInterface + ".Seeked":
+		return signal.Path == ObjectPath
+	case "org.freedesktop.DBus.NameOwnerChanged":
+		if len(signal.Body) == 0 {
+			return false
+		}
+		name, _ := signal.Body[0].(string)
+		return strings.HasPrefix(name, BusPrefix)
+	}
+	return false
+}
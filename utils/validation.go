@@ -112,13 +112,21 @@ func ValidateCommand(id, name, command, workingDir string, arguments []string) e
 		}
 	}
 
-	// Validate arguments don't contain shell metacharacters to prevent command injection attacks
-	// Forbidden characters: ; | & $ \n \r ` < > ( )
 	for _, arg := range arguments {
-		if strings.ContainsAny(arg, ";|&$\n\r`<>()") {
-			return fmt.Errorf("argument for command %s contains forbidden characters (these are not allowed: ; | & $ ` < > ( ) newlines)", id)
+		if err := ValidateCommandArgument(id, arg); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
+
+// ValidateCommandArgument validates that an argument doesn't contain shell
+// metacharacters, to prevent command injection attacks.
+// Forbidden characters: ; | & $ \n \r ` < > ( )
+func ValidateCommandArgument(id, argument string) error {
+	if strings.ContainsAny(argument, ";|&$\n\r`<>()") {
+		return fmt.Errorf("argument for command %s contains forbidden characters (these are not allowed: ; | & $ ` < > ( ) newlines)", id)
+	}
+	return nil
+}
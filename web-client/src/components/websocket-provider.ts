@@ -326,7 +326,13 @@ export class WebSocketProvider extends ProviderElement {
           },
           media: {
             directories: receivedSettings.media?.directories ?? [],
+            playerPolicy: receivedSettings.media?.playerPolicy,
+            preferredPlayers: receivedSettings.media?.preferredPlayers ?? [],
           },
+          grpc: receivedSettings.grpc,
+          mcp: receivedSettings.mcp,
+          schedules: receivedSettings.schedules,
+          macros: receivedSettings.macros,
         };
         this._isRequestingData = false;
         break;
@@ -354,7 +360,18 @@ export class WebSocketProvider extends ProviderElement {
               updatedSettings.media?.directories ??
               this._settings?.media.directories ??
               [],
+            playerPolicy:
+              updatedSettings.media?.playerPolicy ??
+              this._settings?.media.playerPolicy,
+            preferredPlayers:
+              updatedSettings.media?.preferredPlayers ??
+              this._settings?.media.preferredPlayers ??
+              [],
           },
+          grpc: updatedSettings.grpc ?? this._settings?.grpc,
+          mcp: updatedSettings.mcp ?? this._settings?.mcp,
+          schedules: updatedSettings.schedules ?? this._settings?.schedules,
+          macros: updatedSettings.macros ?? this._settings?.macros,
         };
         this._isSettingsUpdatePending = false;
         if (this._settingsUpdateTimeout) {
@@ -766,6 +783,18 @@ export class WebSocketProvider extends ProviderElement {
 
       if (!this._isRequestingData) {
         this._isRequestingData = true;
+        this.sendRequest({
+          id: generateUUID(),
+          event: "HELLO",
+          data: {
+            name: "System Bridge Web Client",
+            version: "",
+            platform: navigator.userAgent,
+            features: ["data_listener", "command_execute"],
+          },
+          token: token,
+        });
+
         this.sendRequest({
           id: generateUUID(),
           event: "GET_SETTINGS",
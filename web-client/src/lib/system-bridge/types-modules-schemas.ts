@@ -172,6 +172,36 @@ export const DiskPartitionSchema = z.object({
 
 export type DiskPartition = z.infer<typeof DiskPartitionSchema>;
 
+// Media Player
+export const MediaPlayerSchema = z.object({
+  name: z.string(),
+  is_active: z.boolean(),
+  album_artist: z.string().nullish(),
+  album_title: z.string().nullish(),
+  artist: z.string().nullish(),
+  duration: z.number().nullish(),
+  is_fast_forward_enabled: z.boolean().nullish(),
+  is_next_enabled: z.boolean().nullish(),
+  is_pause_enabled: z.boolean().nullish(),
+  is_play_enabled: z.boolean().nullish(),
+  is_previous_enabled: z.boolean().nullish(),
+  is_rewind_enabled: z.boolean().nullish(),
+  is_stop_enabled: z.boolean().nullish(),
+  playback_rate: z.number().nullish(),
+  position: z.number().nullish(),
+  repeat: z.string().nullish(),
+  shuffle: z.boolean().nullish(),
+  status: z.string().nullish(),
+  subtitle: z.string().nullish(),
+  thumbnail: z.string().nullish(),
+  title: z.string().nullish(),
+  track_number: z.number().nullish(),
+  type: z.string().nullish(),
+  volume: z.number().nullish(),
+});
+
+export type MediaPlayer = z.infer<typeof MediaPlayerSchema>;
+
 // Network Connection
 export const NetworkConnectionSchema = z.object({
   fd: z.number().nullish(),
@@ -438,6 +468,8 @@ export const MediaDataSchema = z.object({
   type: z.string().nullish(),
   updated_at: z.number().nullish(),
   volume: z.number().nullish(),
+  player: z.string().nullish(),
+  players: z.array(MediaPlayerSchema),
 });
 
 export type MediaData = z.infer<typeof MediaDataSchema>;
@@ -3,6 +3,10 @@ import { z } from "zod";
 export const SettingsHotkeySchema = z.object({
   name: z.string(),
   key: z.string(),
+  event: z.string().optional(),
+  data: z.unknown().optional(),
+  macroID: z.string().optional(),
+  commandID: z.string().optional(),
 });
 
 export type SettingsHotkey = z.infer<typeof SettingsHotkeySchema>;
@@ -18,16 +22,43 @@ export type SettingsMediaDirectory = z.infer<
 
 export const SettingsMediaSchema = z.object({
   directories: z.array(SettingsMediaDirectorySchema),
+  playerPolicy: z.enum(["playing", "last_active", "preferred"]).optional(),
+  preferredPlayers: z.array(z.string()).optional(),
 });
 
 export type SettingsMedia = z.infer<typeof SettingsMediaSchema>;
 
+export const SettingsCommandParameterSchema = z.object({
+  name: z.string().min(1),
+  type: z.enum(["string", "int", "enum", "path"]),
+  description: z.string().optional(),
+  required: z.boolean().optional(),
+  default: z.string().optional(),
+  pattern: z.string().optional(),
+  min: z.number().int().optional(),
+  max: z.number().int().optional(),
+  values: z.array(z.string()).optional(),
+  directory: z.string().optional(),
+});
+
+export type SettingsCommandParameter = z.infer<
+  typeof SettingsCommandParameterSchema
+>;
+
 export const SettingsCommandDefinitionSchema = z.object({
   id: z.string(),
   name: z.string().min(1),
   command: z.string().min(1),
   workingDir: z.string(),
   arguments: z.array(z.string()),
+  maxConcurrent: z.number().int().min(0).optional(),
+  parameters: z.array(SettingsCommandParameterSchema).optional(),
+  environment: z.array(z.string()).optional(),
+  inheritEnvironment: z.boolean().optional(),
+  timeoutSeconds: z.number().int().min(0).optional(),
+  stdin: z.string().optional(),
+  maxOutputSize: z.number().int().min(0).optional(),
+  runAs: z.string().optional(),
 });
 
 export type SettingsCommandDefinition = z.infer<
@@ -40,12 +71,76 @@ export const SettingsCommandsSchema = z.object({
 
 export type SettingsCommands = z.infer<typeof SettingsCommandsSchema>;
 
+export const SettingsGRPCSchema = z.object({
+  enabled: z.boolean(),
+  port: z.number().int(),
+});
+
+export type SettingsGRPC = z.infer<typeof SettingsGRPCSchema>;
+
+export const SettingsMCPSchema = z.object({
+  tools: z.record(z.string(), z.boolean()).nullable(),
+});
+
+export type SettingsMCP = z.infer<typeof SettingsMCPSchema>;
+
+export const SettingsScheduleSchema = z.object({
+  id: z.string(),
+  name: z.string(),
+  cron: z.string().optional(),
+  at: z.string().optional(),
+  event: z.string(),
+  data: z.unknown().optional(),
+  disabled: z.boolean().optional(),
+});
+
+export type SettingsSchedule = z.infer<typeof SettingsScheduleSchema>;
+
+export const MacroErrorPolicySchema = z.enum(["stop", "continue"]);
+
+export const SettingsMacroConditionSchema = z.object({
+  module: z.string(),
+  path: z.string().optional(),
+  operator: z.enum(["eq", "ne", "gt", "gte", "lt", "lte", "contains", "exists"]),
+  value: z.unknown().optional(),
+});
+
+export type SettingsMacroCondition = z.infer<
+  typeof SettingsMacroConditionSchema
+>;
+
+export const SettingsMacroStepSchema = z.object({
+  event: z.string(),
+  data: z.unknown().optional(),
+  delayMs: z.number().int().min(0).optional(),
+  conditions: z.array(SettingsMacroConditionSchema).optional(),
+  onError: MacroErrorPolicySchema.optional(),
+});
+
+export type SettingsMacroStep = z.infer<typeof SettingsMacroStepSchema>;
+
+export const SettingsMacroSchema = z.object({
+  id: z.string(),
+  name: z.string(),
+  steps: z.array(SettingsMacroStepSchema),
+  onError: MacroErrorPolicySchema.optional(),
+  showInTray: z.boolean().optional(),
+});
+
+export type SettingsMacro = z.infer<typeof SettingsMacroSchema>;
+
+// Sections the settings pages do not edit are optional, so they are only sent
+// back as they were received
 export const SettingsSchema = z.object({
   autostart: z.boolean(),
   hotkeys: z.array(SettingsHotkeySchema),
   logLevel: z.enum(["DEBUG", "INFO", "WARN", "ERROR"]),
   commands: SettingsCommandsSchema,
   media: SettingsMediaSchema,
+  grpc: SettingsGRPCSchema.optional(),
+  mcp: SettingsMCPSchema.optional(),
+  schedules: z.array(SettingsScheduleSchema).nullable().optional(),
+  macros: z.array(SettingsMacroSchema).nullable().optional(),
 });
 
 export type Settings = z.infer<typeof SettingsSchema>;
@@ -3,17 +3,35 @@ import { z } from "zod";
 import { ModuleNameSchema } from "~/lib/system-bridge/types-modules";
 
 export const EventTypeSchema = z.enum([
+  "COMMAND_CANCEL",
   "COMMAND_EXECUTE",
+  "COMMAND_GET_JOB",
+  "COMMAND_HISTORY",
+  "COMMAND_LIST_RUNNING",
+  "DISCONNECT_CLIENT",
   "EXIT_APPLICATION",
+  "GET_CLIENTS",
   "GET_DATA",
   "GET_DIRECTORIES",
   "GET_DIRECTORY",
   "GET_FILES",
   "GET_FILE",
+  "GET_MEDIA_FILE_URL",
   "GET_SETTINGS",
+  "HELLO",
   "KEYBOARD_KEYPRESS",
   "KEYBOARD_TEXT",
+  "KEYBOARD_SEQUENCE",
+  "GET_KEYBOARD_KEYS",
+  "MOUSE_MOVE",
+  "MOUSE_CLICK",
+  "MOUSE_SCROLL",
+  "MOUSE_DRAG",
+  "GET_MOUSE_POSITION",
   "MEDIA_CONTROL",
+  "MEDIA_LIBRARY_SEARCH",
+  "GET_MEDIA_LIBRARY_ARTISTS",
+  "GET_MEDIA_LIBRARY_ALBUMS",
   "NOTIFICATION",
   "OPEN",
   "POWER_HIBERNATE",
@@ -24,6 +42,8 @@ export const EventTypeSchema = z.enum([
   "POWER_SLEEP",
   "REGISTER_DATA_LISTENER",
   "UNREGISTER_DATA_LISTENER",
+  "REGISTER_HOTKEY_LISTENER",
+  "UNREGISTER_HOTKEY_LISTENER",
   "DATA_UPDATE",
   "UPDATE_SETTINGS",
   "VALIDATE_DIRECTORY",
@@ -36,14 +56,30 @@ export const ResponseTypeSchema = z.enum([
   "APPLICATION_EXITING",
   "COMMAND_EXECUTING",
   "COMMAND_COMPLETED",
+  "COMMAND_OUTPUT",
+  "COMMAND_CANCELED",
+  "COMMAND_RUNNING",
+  "COMMAND_HISTORY",
+  "COMMAND_JOB",
   "DATA_GET",
   "DIRECTORIES",
   "DIRECTORY",
   "FILES",
   "FILE",
+  "MEDIA_FILE_URL",
   "KEYBOARD_KEY_PRESSED",
   "KEYBOARD_TEXT_SENT",
+  "KEYBOARD_SEQUENCE_SENT",
+  "KEYBOARD_KEYS",
+  "MOUSE_MOVED",
+  "MOUSE_CLICKED",
+  "MOUSE_SCROLLED",
+  "MOUSE_DRAGGED",
+  "MOUSE_POSITION",
   "MEDIA_CONTROLLED",
+  "MEDIA_LIBRARY_ITEMS",
+  "MEDIA_LIBRARY_ARTISTS",
+  "MEDIA_LIBRARY_ALBUMS",
   "NOTIFICATION_SENT",
   "OPENED",
   "POWER_HIBERNATING",
@@ -55,9 +91,15 @@ export const ResponseTypeSchema = z.enum([
   "DATA_LISTENER_REGISTERED",
   "DATA_LISTENER_UNREGISTERED",
   "DATA_UPDATE",
+  "HOTKEY_LISTENER_REGISTERED",
+  "HOTKEY_LISTENER_UNREGISTERED",
+  "HOTKEY_PRESSED",
   "SETTINGS_RESULT",
   "SETTINGS_UPDATED",
   "DIRECTORY_VALIDATED",
+  "HELLO",
+  "CLIENTS",
+  "CLIENT_DISCONNECTED",
 ]);
 
 export type ResponseType = z.infer<typeof ResponseTypeSchema>;
@@ -70,8 +112,11 @@ export const ResponseSubtypeSchema = z.enum([
   "BAD_DIRECTORY",
   "BAD_FILE",
   "BAD_PATH",
+  "CLIENT_NOT_FOUND",
   "COMMAND_NOT_FOUND",
   "INVALID_ACTION",
+  "INVALID_KEY",
+  "INVALID_POSITION",
   "LISTENER_ALREADY_REGISTERED",
   "LISTENER_NOT_REGISTERED",
   "MISSING_ACTION",
@@ -85,6 +130,7 @@ export const ResponseSubtypeSchema = z.enum([
   "MISSING_TITLE",
   "MISSING_TOKEN",
   "MISSING_VALUE",
+  "PLAYER_NOT_FOUND",
   "UNKNOWN_EVENT",
 ]);
 
@@ -22,7 +22,8 @@ type MediaAction =
   | "PREVIOUS"
   | "VOLUME_UP"
   | "VOLUME_DOWN"
-  | "MUTE";
+  | "MUTE"
+  | "SEEK";
 
 interface ActionResult {
   success: boolean;
@@ -122,7 +123,10 @@ export class PageMedia extends PageElement {
     this.navigate("/connection");
   };
 
-  private sendMediaAction(action: MediaAction): void {
+  private sendMediaAction(
+    action: MediaAction,
+    data: Record<string, unknown> = {},
+  ): void {
     if (!this.connection?.token || !this.websocket?.sendRequest) {
       return;
     }
@@ -135,7 +139,7 @@ export class PageMedia extends PageElement {
       this.websocket.sendRequest({
         id: requestId,
         event: "MEDIA_CONTROL",
-        data: { action },
+        data: { ...data, action },
         token: this.connection.token,
       });
 
@@ -162,6 +166,20 @@ export class PageMedia extends PageElement {
   private handleVolumeDown = (): void => this.sendMediaAction("VOLUME_DOWN");
   private handleMute = (): void => this.sendMediaAction("MUTE");
 
+  private handleSeek = (event: MouseEvent): void => {
+    const duration = this.mediaData?.duration;
+    if (duration == null || duration <= 0) {
+      return;
+    }
+    const bar = event.currentTarget as HTMLElement;
+    const rect = bar.getBoundingClientRect();
+    const fraction = Math.min(
+      Math.max((event.clientX - rect.left) / rect.width, 0),
+      1,
+    );
+    this.sendMediaAction("SEEK", { position: fraction * duration });
+  };
+
   private get mediaData(): MediaData | null {
     return (this.websocket?.data?.media as MediaData) ?? null;
   }
@@ -228,8 +246,21 @@ export class PageMedia extends PageElement {
     `;
   }
 
-  private renderAlbumArt(): TemplateResult {
+  private get thumbnailUrl(): string | null {
     const thumbnail = this.mediaData?.thumbnail;
+    if (!thumbnail) {
+      return null;
+    }
+    // Artwork served by the artwork cache is relative to the server
+    if (thumbnail.startsWith("/") && this.connection) {
+      const { host, port, ssl } = this.connection;
+      return `${ssl ? "https" : "http"}://${host}:${port}${thumbnail}?size=128`;
+    }
+    return thumbnail;
+  }
+
+  private renderAlbumArt(): TemplateResult {
+    const thumbnail = this.thumbnailUrl;
 
     if (thumbnail) {
       return html`
@@ -264,10 +295,17 @@ export class PageMedia extends PageElement {
       position != null && duration != null && duration > 0
         ? Math.min((position / duration) * 100, 100)
         : 0;
+    const canSeek =
+      duration != null && duration > 0 && this.pendingAction === null;
 
     return html`
       <div class="space-y-1.5">
-        <div class="h-1.5 bg-muted rounded-full overflow-hidden">
+        <div
+          class="h-1.5 bg-muted rounded-full overflow-hidden ${canSeek
+            ? "cursor-pointer"
+            : ""}"
+          @click=${canSeek ? this.handleSeek : null}
+        >
           <div
             class="h-full bg-primary rounded-full transition-all duration-300"
             style="width: ${progressPercent}%"
@@ -496,7 +534,7 @@ export class PageMedia extends PageElement {
             <li>No media player is active</li>
             <li>The player does not support this action</li>
             <li>The action is not supported on this platform</li>
-            <li>Required tools (e.g., playerctl on Linux) are not installed</li>
+            <li>The D-Bus session bus is unavailable (Linux)</li>
           </ul>
         </div>`
       : "";
@@ -188,6 +188,7 @@ export class PageSettingsMedia extends PageElement {
       const updatedSettings: Settings = {
         ...this.websocket.settings,
         media: {
+          ...this.websocket.settings.media,
           directories: this.mediaDirectories,
         },
       };